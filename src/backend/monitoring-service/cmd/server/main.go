@@ -4,6 +4,7 @@ package main
 
 import (
     "context"
+    "fmt"
     "log"
     "os"
     "os/signal"
@@ -11,9 +12,22 @@ import (
     "syscall"
     "time"
 
+    "github.com/prometheus/client_golang/prometheus/promhttp" // v1.16.0
+
+    "src/backend/monitoring-service/internal/anomaly"
     "src/backend/monitoring-service/internal/collectors"
     "src/backend/monitoring-service/internal/exporters"
     "src/backend/monitoring-service/internal/handlers"
+    "src/backend/monitoring-service/internal/health"
+    "src/backend/monitoring-service/internal/ingest"
+    "src/backend/monitoring-service/internal/logs"
+    "src/backend/monitoring-service/internal/probes"
+    "src/backend/monitoring-service/internal/profiling"
+    "src/backend/monitoring-service/internal/server"
+    "src/backend/monitoring-service/internal/slo"
+    "src/backend/monitoring-service/internal/snapshots"
+    "src/backend/monitoring-service/internal/statuspage"
+    "src/backend/monitoring-service/internal/tracereports"
     "src/backend/monitoring-service/internal/tracers"
 )
 
@@ -34,12 +48,55 @@ func main() {
     // Set up signal handling for graceful shutdown
     setupSignalHandler(cancel)
 
-    // Initialize metrics collector
+    // Initialize metrics collector, with optional histogram overrides
     metricsCollector := collectors.NewMetricsCollector()
+    if buckets := collectors.ParseBuckets(os.Getenv("RESPONSE_TIME_BUCKETS")); buckets != nil {
+        metricsCollector.WithResponseTimeBuckets(buckets)
+    }
+    if getEnvOrDefault("ENABLE_NATIVE_HISTOGRAMS", "false") == "true" {
+        metricsCollector.WithNativeHistograms()
+    }
+    if ttl, err := time.ParseDuration(os.Getenv("METRIC_TTL")); err == nil && ttl > 0 {
+        metricsCollector.WithMetricTTL(ttl)
+    }
     if err := metricsCollector.VerifyHealth(ctx); err != nil {
         log.Fatalf("Failed to initialize metrics collector: %v", err)
     }
 
+    // Sample host and container resource metrics
+    collectors.NewSystemCollector(nil).Start(ctx)
+
+    // Sample datastore statistics when endpoints are configured
+    if dsn, addr := os.Getenv("POSTGRES_STATS_DSN"), os.Getenv("REDIS_STATS_ADDRESS"); dsn != "" || addr != "" {
+        datastoreCollector, err := collectors.NewDatastoreCollector(dsn, addr)
+        if err != nil {
+            log.Fatalf("Failed to create datastore collector: %v", err)
+        }
+        datastoreCollector.Start(ctx)
+    }
+
+    // Snapshot metrics to long-term storage when a backend is configured
+    if backend := os.Getenv("SNAPSHOT_BACKEND"); backend != "" {
+        store, err := newSnapshotStore(backend)
+        if err != nil {
+            log.Fatalf("Failed to create snapshot store: %v", err)
+        }
+
+        interval, _ := time.ParseDuration(os.Getenv("SNAPSHOT_INTERVAL"))
+        snapshots.NewSnapshotter(metricsCollector, store, snapshots.Config{
+            Interval: interval,
+            Policies: []snapshots.DownsamplePolicy{
+                {Age: 24 * time.Hour, Resolution: 5 * time.Minute},
+                {Age: 7 * 24 * time.Hour, Resolution: time.Hour},
+            },
+        }).Start(ctx)
+    }
+
+    // Derive business KPIs from the workflow engine's metrics endpoint
+    collectors.NewBusinessCollector(
+        getEnvOrDefault("WORKFLOW_ENGINE_METRICS_URL", "http://workflow-engine:9090/metrics"),
+    ).Start(ctx)
+
     // Initialize Prometheus exporter
     prometheusExporter, err := exporters.NewPrometheusExporter(metricsCollector)
     if err != nil {
@@ -53,11 +110,22 @@ func main() {
         Version:   "1.0.0",
     })
 
-    // Initialize Jaeger tracer
-    tracer, tracerCloser, err := tracers.NewJaegerTracer(
-        defaultServiceName,
-        defaultSamplingRate,
-    )
+    // Initialize Jaeger tracer, with hot-reloadable route-aware sampling
+    // when a sampling configuration file is provided
+    tracerOpts := tracers.NewTracerOptions().
+        WithServiceName(defaultServiceName).
+        WithSamplingRate(defaultSamplingRate)
+    if samplingConfig := os.Getenv("TRACING_SAMPLING_CONFIG"); samplingConfig != "" {
+        sampling, err := tracers.LoadSamplingConfig(samplingConfig)
+        if err != nil {
+            log.Fatalf("Failed to load sampling config: %v", err)
+        }
+        tracerOpts.WithSampling(sampling)
+        tracerOpts.SamplingConfigPath = samplingConfig
+    }
+    // The tracer is installed globally by the constructor; only the
+    // closer is needed here
+    _, tracerCloser, err := tracers.NewJaegerTracerWithOptions(tracerOpts)
     if err != nil {
         log.Fatalf("Failed to initialize Jaeger tracer: %v", err)
     }
@@ -76,6 +144,102 @@ func main() {
         }
     }()
 
+    // Start gRPC metric ingestion API
+    ingestServer := ingest.NewServer(metricsCollector, os.Getenv("INGEST_GRPC_ADDRESS"))
+    wg.Add(1)
+    go func() {
+        defer wg.Done()
+        if err := ingestServer.Start(ctx); err != nil {
+            log.Printf("Metric ingestion server error: %v", err)
+            cancel() // Trigger shutdown on critical error
+        }
+    }()
+
+    // Start log aggregation pipeline
+    logPipeline := logs.NewPipeline(newLogShipper(), logs.PipelineConfig{})
+    logPipeline.Start(ctx)
+
+    // Unified system health rollup across dependent services
+    healthAggregator := health.NewAggregator([]health.Dependency{
+        {Name: "workflow-engine", URL: getEnvOrDefault("WORKFLOW_ENGINE_HEALTH_URL", "http://workflow-engine:8080/health"), Critical: true},
+        {Name: "ai-service", URL: getEnvOrDefault("AI_SERVICE_HEALTH_URL", "http://ai-service:8081/health")},
+        {Name: "integration-service", URL: getEnvOrDefault("INTEGRATION_SERVICE_HEALTH_URL", "http://integration-service:8082/health")},
+    })
+
+    // Status page fed by health samples and incidents from the alert layer
+    page := statuspage.New(healthAggregator)
+    page.Start(ctx)
+
+    // Start SLO tracking, reporting burn-rate incidents to the status page
+    sloTracker := slo.NewTracker(page)
+    sloTracker.Start(ctx)
+
+    // Flag anomalous spikes in failures and latency on the status page
+    anomaly.NewDetector(page, anomaly.Config{
+        Seasonal: getEnvOrDefault("ANOMALY_SEASONAL", "false") == "true",
+    }).Start(ctx)
+
+    // Unified HTTP API server for health probes, metrics, log ingestion,
+    // SLO status, and the status page, with optional TLS
+    apiServer, err := server.New(server.Config{
+        Address:  getEnvOrDefault("API_ADDRESS", getEnvOrDefault("LOG_INGEST_ADDRESS", ":9096")),
+        CertFile: os.Getenv("API_TLS_CERT"),
+        KeyFile:  os.Getenv("API_TLS_KEY"),
+    })
+    if err != nil {
+        log.Fatalf("Failed to create API server: %v", err)
+    }
+    apiServer.HandleFunc("/health/live", healthHandler.HandleLiveness)
+    apiServer.HandleFunc("/health/ready", healthHandler.HandleReadiness)
+    apiServer.Handle("/metrics", promhttp.Handler())
+    apiServer.Handle("/metrics/catalog", metricsCollector.CatalogHandler())
+    apiServer.Handle("/logs", logPipeline.Handler())
+    apiServer.Handle("/slo", sloTracker.Handler())
+    apiServer.Handle("/health/system", healthAggregator.Handler())
+    apiServer.Handle("/status", page.HTMLHandler())
+    apiServer.Handle("/status.json", page.JSONHandler())
+
+    // Continuous profiling when an ingest endpoint is configured
+    if endpoint := os.Getenv("PROFILING_ENDPOINT"); endpoint != "" {
+        profiler := profiling.NewProfiler(profiling.Config{
+            Service:  defaultServiceName,
+            Endpoint: endpoint,
+            Enabled:  getEnvOrDefault("PROFILING_ENABLED", "false") == "true",
+        })
+        profiler.Start(ctx)
+        apiServer.Handle("/profiling", profiler.Handler())
+    }
+
+    // Latency breakdown reports built from completed traces
+    traceReporter := tracereports.NewReporter(tracereports.Config{
+        QueryURL: getEnvOrDefault("JAEGER_QUERY_URL", "http://jaeger:16686"),
+        Service:  getEnvOrDefault("TRACE_REPORT_SERVICE", "workflow-engine"),
+    })
+    traceReporter.Start(ctx)
+    apiServer.Handle("/reports/latency", traceReporter.Handler())
+
+    wg.Add(1)
+    go func() {
+        defer wg.Done()
+        if err := apiServer.Start(ctx); err != nil {
+            log.Printf("API server error: %v", err)
+            cancel() // Trigger shutdown on critical error
+        }
+    }()
+
+    // Start synthetic probes when a probe configuration is provided
+    if probeConfig := os.Getenv("PROBES_CONFIG"); probeConfig != "" {
+        targets, err := probes.LoadTargets(probeConfig)
+        if err != nil {
+            log.Fatalf("Failed to load probe targets: %v", err)
+        }
+        prober, err := probes.NewProber(targets, page)
+        if err != nil {
+            log.Fatalf("Failed to initialize prober: %v", err)
+        }
+        prober.Start(ctx)
+    }
+
     // Start periodic health checks
     wg.Add(1)
     go func() {
@@ -111,6 +275,18 @@ func main() {
         defer close(shutdownChan)
 
         // Shutdown components in order
+        if err := apiServer.Shutdown(shutdownCtx); err != nil {
+            log.Printf("Error shutting down API server: %v", err)
+        }
+
+        if err := logPipeline.Shutdown(shutdownCtx); err != nil {
+            log.Printf("Error shutting down log pipeline: %v", err)
+        }
+
+        if err := ingestServer.Shutdown(shutdownCtx); err != nil {
+            log.Printf("Error shutting down metric ingestion server: %v", err)
+        }
+
         if err := prometheusExporter.Shutdown(shutdownCtx); err != nil {
             log.Printf("Error shutting down Prometheus exporter: %v", err)
         }
@@ -132,6 +308,44 @@ func main() {
     }
 }
 
+// newSnapshotStore selects the long-term metric store backend
+func newSnapshotStore(backend string) (snapshots.Store, error) {
+    switch backend {
+    case "timescale":
+        return snapshots.NewTimescaleStore(os.Getenv("SNAPSHOT_TIMESCALE_DSN"))
+    case "influx":
+        return snapshots.NewInfluxStore(
+            getEnvOrDefault("SNAPSHOT_INFLUX_ENDPOINT", "http://influxdb:8086"),
+            os.Getenv("SNAPSHOT_INFLUX_ORG"),
+            getEnvOrDefault("SNAPSHOT_INFLUX_BUCKET", "metrics"),
+            os.Getenv("SNAPSHOT_INFLUX_TOKEN"),
+        ), nil
+    default:
+        return nil, fmt.Errorf("unknown snapshot backend %q", backend)
+    }
+}
+
+// newLogShipper selects the log storage backend from the environment
+func newLogShipper() logs.Shipper {
+    switch getEnvOrDefault("LOG_BACKEND", "loki") {
+    case "elasticsearch":
+        return logs.NewElasticsearchShipper(
+            getEnvOrDefault("ELASTICSEARCH_ENDPOINT", "http://elasticsearch:9200"),
+            os.Getenv("ELASTICSEARCH_INDEX"),
+        )
+    default:
+        return logs.NewLokiShipper(getEnvOrDefault("LOKI_ENDPOINT", "http://loki:3100"))
+    }
+}
+
+// getEnvOrDefault returns an environment variable or a fallback value
+func getEnvOrDefault(key, defaultValue string) string {
+    if value, exists := os.LookupEnv(key); exists && value != "" {
+        return value
+    }
+    return defaultValue
+}
+
 // setupSignalHandler configures signal handling for graceful shutdown
 func setupSignalHandler(cancel context.CancelFunc) {
     sigChan := make(chan os.Signal, 1)
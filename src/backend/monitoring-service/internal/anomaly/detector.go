@@ -0,0 +1,236 @@
+// Package anomaly flags unusual spikes in key metrics using a lightweight
+// EWMA baseline, emitting events to the alert layer without requiring a
+// full ML stack.
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go" // v0.4.0
+)
+
+// Default detector configuration values
+const (
+	defaultDetectInterval = time.Minute
+	defaultSigmas         = 3.0
+	defaultAlertCooldown  = 10 * time.Minute
+
+	// ewmaAlpha is the smoothing factor for the baseline mean and variance
+	ewmaAlpha = 0.3
+
+	// warmupSamples is how many observations a series needs before it can
+	// be flagged, preventing alerts on cold baselines
+	warmupSamples = 5
+)
+
+// defaultWatchedMetrics are the metric families observed when none are
+// configured: error counters and the response latency histogram
+var defaultWatchedMetrics = []string{
+	"workflow_automation_monitoring_errors_total",
+	"workflow_automation_monitoring_response_time_seconds",
+}
+
+// Alerter receives anomaly events; the status page implements this
+type Alerter interface {
+	RaiseAlert(name, message string)
+}
+
+// Config tunes the detector
+type Config struct {
+	Interval time.Duration
+	Metrics  []string
+	Sigmas   float64
+
+	// Seasonal keeps a separate baseline per hour of day so daily traffic
+	// patterns are not flagged as anomalies
+	Seasonal bool
+}
+
+// seriesState holds the EWMA baseline for one metric series
+type seriesState struct {
+	lastValue   float64
+	lastCount   float64
+	initialized bool
+	mean        float64
+	variance    float64
+	samples     int
+	lastAlert   time.Time
+}
+
+// Detector periodically samples watched metric families and raises an
+// alert when a series spikes beyond its baseline
+type Detector struct {
+	alerter  Alerter
+	gatherer prometheus.Gatherer
+	interval time.Duration
+	metrics  map[string]bool
+	sigmas   float64
+	seasonal bool
+	state    map[string]*seriesState
+}
+
+// NewDetector creates a detector observing the default Prometheus
+// registry for the configured metric families
+func NewDetector(alerter Alerter, cfg Config) *Detector {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultDetectInterval
+	}
+	if cfg.Sigmas <= 0 {
+		cfg.Sigmas = defaultSigmas
+	}
+	if len(cfg.Metrics) == 0 {
+		cfg.Metrics = defaultWatchedMetrics
+	}
+
+	metrics := make(map[string]bool, len(cfg.Metrics))
+	for _, name := range cfg.Metrics {
+		metrics[name] = true
+	}
+
+	return &Detector{
+		alerter:  alerter,
+		gatherer: prometheus.DefaultGatherer,
+		interval: cfg.Interval,
+		metrics:  metrics,
+		sigmas:   cfg.Sigmas,
+		seasonal: cfg.Seasonal,
+		state:    make(map[string]*seriesState),
+	}
+}
+
+// Start begins periodic detection until the context is canceled
+func (d *Detector) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				d.detect()
+			}
+		}
+	}()
+}
+
+// detect samples the watched families and checks each series against its
+// baseline
+func (d *Detector) detect() {
+	families, err := d.gatherer.Gather()
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	for _, family := range families {
+		if !d.metrics[family.GetName()] {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			signal, ok := d.signalFor(family, metric, now)
+			if !ok {
+				continue
+			}
+			d.check(family.GetName(), seriesLabels(metric), signal, now)
+		}
+	}
+}
+
+// signalFor derives the observed value for one series: per-interval
+// increase for counters, current value for gauges, and mean observation
+// size for histograms
+func (d *Detector) signalFor(family *dto.MetricFamily, metric *dto.Metric, now time.Time) (float64, bool) {
+	state := d.stateFor(family.GetName(), seriesLabels(metric), now)
+
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		value := metric.GetCounter().GetValue()
+		previous := state.lastValue
+		state.lastValue = value
+		if !state.initialized {
+			state.initialized = true
+			return 0, false
+		}
+		return value - previous, true
+
+	case dto.MetricType_GAUGE:
+		return metric.GetGauge().GetValue(), true
+
+	case dto.MetricType_HISTOGRAM:
+		histogram := metric.GetHistogram()
+		sum, count := histogram.GetSampleSum(), float64(histogram.GetSampleCount())
+		deltaSum, deltaCount := sum-state.lastValue, count-state.lastCount
+		state.lastValue, state.lastCount = sum, count
+		if !state.initialized {
+			state.initialized = true
+			return 0, false
+		}
+		if deltaCount <= 0 {
+			return 0, false
+		}
+		return deltaSum / deltaCount, true
+	}
+
+	return 0, false
+}
+
+// check compares the signal against the series baseline, alerting on
+// spikes and folding the observation into the EWMA afterwards
+func (d *Detector) check(name, labels string, signal float64, now time.Time) {
+	state := d.stateFor(name, labels, now)
+
+	if state.samples >= warmupSamples {
+		stddev := math.Sqrt(state.variance)
+		threshold := state.mean + d.sigmas*stddev
+		if stddev > 0 && signal > threshold && now.Sub(state.lastAlert) > defaultAlertCooldown {
+			state.lastAlert = now
+			series := name
+			if labels != "" {
+				series = fmt.Sprintf("%s{%s}", name, labels)
+			}
+			d.alerter.RaiseAlert("metric_anomaly",
+				fmt.Sprintf("%s spiked to %.3g, baseline %.3g (±%.3g)", series, signal, state.mean, stddev))
+		}
+	}
+
+	// Fold the observation into the baseline
+	diff := signal - state.mean
+	state.mean += ewmaAlpha * diff
+	state.variance = (1 - ewmaAlpha) * (state.variance + ewmaAlpha*diff*diff)
+	state.samples++
+}
+
+// stateFor returns the baseline state for a series, keyed per hour of
+// day when seasonal baselines are enabled
+func (d *Detector) stateFor(name, labels string, now time.Time) *seriesState {
+	key := name + "{" + labels + "}"
+	if d.seasonal {
+		key = fmt.Sprintf("%s@%02d", key, now.Hour())
+	}
+
+	state, exists := d.state[key]
+	if !exists {
+		state = &seriesState{}
+		d.state[key] = state
+	}
+	return state
+}
+
+// seriesLabels renders a stable label signature for one series
+func seriesLabels(metric *dto.Metric) string {
+	pairs := make([]string, 0, len(metric.GetLabel()))
+	for _, label := range metric.GetLabel() {
+		pairs = append(pairs, label.GetName()+"="+label.GetValue())
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
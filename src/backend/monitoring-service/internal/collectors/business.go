@@ -0,0 +1,224 @@
+// Package collectors provides metrics collection and monitoring functionality
+// with Prometheus integration for system reliability tracking.
+package collectors
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt" // v0.44.0
+)
+
+const (
+	// businessSampleInterval is how often the engine metrics are scraped
+	businessSampleInterval = time.Minute
+
+	// engineExecutionMetric is the per-workflow execution counter family
+	// exposed by the workflow engine
+	engineExecutionMetric = "workflow_executions_by_label"
+)
+
+// Derived business metrics collectors
+var (
+	derivedSuccessRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "derived",
+			Name:      "workflow_success_rate",
+			Help:      "Fraction of workflow executions that succeeded",
+		},
+		[]string{"workflow_id"},
+	)
+
+	derivedExecutionsPerHour = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "derived",
+			Name:      "workflow_executions_per_hour",
+			Help:      "Workflow execution throughput extrapolated from the scrape window",
+		},
+		[]string{"workflow_id"},
+	)
+
+	derivedMeanTimeToRecovery = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "derived",
+			Name:      "workflow_mean_time_to_recovery_seconds",
+			Help:      "Mean time between a workflow starting to fail and succeeding again",
+		},
+		[]string{"workflow_id"},
+	)
+)
+
+// workflowCounts holds the execution counters observed for one workflow
+type workflowCounts struct {
+	success float64
+	failed  float64
+}
+
+// recoveryState tracks failure onset and completed recoveries per workflow
+type recoveryState struct {
+	failingSince  time.Time
+	totalRecovery time.Duration
+	recoveries    int
+}
+
+// BusinessCollector scrapes the workflow engine's metrics endpoint and
+// derives business KPIs for dashboards under the derived-metrics namespace
+type BusinessCollector struct {
+	mu         sync.Mutex
+	metricsURL string
+	client     *http.Client
+	interval   time.Duration
+	previous   map[string]workflowCounts
+	lastSample time.Time
+	recovery   map[string]*recoveryState
+}
+
+// NewBusinessCollector creates a collector scraping the given workflow
+// engine metrics endpoint
+func NewBusinessCollector(metricsURL string) *BusinessCollector {
+	prometheus.MustRegister(derivedSuccessRate, derivedExecutionsPerHour, derivedMeanTimeToRecovery)
+
+	return &BusinessCollector{
+		metricsURL: metricsURL,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		interval:   businessSampleInterval,
+		previous:   make(map[string]workflowCounts),
+		recovery:   make(map[string]*recoveryState),
+	}
+}
+
+// Start begins periodic scraping until the context is canceled
+func (b *BusinessCollector) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(b.interval)
+		defer ticker.Stop()
+
+		b.sample(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				b.sample(ctx)
+			}
+		}
+	}()
+}
+
+// sample scrapes the engine and refreshes the derived KPIs
+func (b *BusinessCollector) sample(ctx context.Context) {
+	counts, err := b.scrape(ctx)
+	if err != nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	window := now.Sub(b.lastSample)
+
+	for workflowID, current := range counts {
+		total := current.success + current.failed
+		if total > 0 {
+			derivedSuccessRate.WithLabelValues(workflowID).Set(current.success / total)
+		}
+
+		previous, seen := b.previous[workflowID]
+		if seen && window > 0 {
+			delta := (current.success + current.failed) - (previous.success + previous.failed)
+			if delta >= 0 {
+				derivedExecutionsPerHour.WithLabelValues(workflowID).Set(delta * float64(time.Hour) / float64(window))
+			}
+		}
+
+		b.trackRecovery(workflowID, previous, current, seen, now)
+	}
+
+	b.previous = counts
+	b.lastSample = now
+}
+
+// trackRecovery updates the mean-time-to-recovery estimate: the clock
+// starts when new failures appear and stops at the next successful run
+func (b *BusinessCollector) trackRecovery(workflowID string, previous, current workflowCounts, seen bool, now time.Time) {
+	state, exists := b.recovery[workflowID]
+	if !exists {
+		state = &recoveryState{}
+		b.recovery[workflowID] = state
+	}
+
+	failuresGrew := seen && current.failed > previous.failed
+	successGrew := seen && current.success > previous.success
+
+	if failuresGrew && state.failingSince.IsZero() {
+		state.failingSince = now
+	}
+	if successGrew && !state.failingSince.IsZero() {
+		state.totalRecovery += now.Sub(state.failingSince)
+		state.recoveries++
+		state.failingSince = time.Time{}
+
+		mean := state.totalRecovery.Seconds() / float64(state.recoveries)
+		derivedMeanTimeToRecovery.WithLabelValues(workflowID).Set(mean)
+	}
+}
+
+// scrape fetches and parses the engine's metrics endpoint, returning the
+// per-workflow execution counters
+func (b *BusinessCollector) scrape(ctx context.Context) (map[string]workflowCounts, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.metricsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]workflowCounts)
+	family, exists := families[engineExecutionMetric]
+	if !exists {
+		return counts, nil
+	}
+
+	for _, metric := range family.GetMetric() {
+		var workflowID, status string
+		for _, label := range metric.GetLabel() {
+			switch label.GetName() {
+			case "workflow_id":
+				workflowID = label.GetValue()
+			case "status":
+				status = label.GetValue()
+			}
+		}
+		if workflowID == "" {
+			continue
+		}
+
+		current := counts[workflowID]
+		switch status {
+		case "success":
+			current.success += metric.GetCounter().GetValue()
+		case "failed":
+			current.failed += metric.GetCounter().GetValue()
+		}
+		counts[workflowID] = current
+	}
+
+	return counts, nil
+}
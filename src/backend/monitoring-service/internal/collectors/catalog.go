@@ -0,0 +1,93 @@
+// Package collectors provides metrics collection and monitoring functionality
+// with Prometheus integration for system reliability tracking.
+package collectors
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// CatalogEntry describes one registered metric family for discovery
+type CatalogEntry struct {
+	Name       string            `json:"name"`
+	Help       string            `json:"help"`
+	Type       string            `json:"type"`
+	Labels     []string          `json:"labels,omitempty"`
+	Static     map[string]string `json:"static_labels,omitempty"`
+	LastUpdate *time.Time        `json:"last_update,omitempty"`
+}
+
+// Catalog lists every metric family registered with the collector,
+// including help text, type, label names, and the last-update time
+// recorded for dynamically registered metrics
+func (mc *MetricsCollector) Catalog() ([]CatalogEntry, error) {
+	families, err := mc.registry.Gather()
+	if err != nil {
+		return nil, err
+	}
+
+	mc.mutex.RLock()
+	defer mc.mutex.RUnlock()
+
+	entries := make([]CatalogEntry, 0, len(families))
+	for _, family := range families {
+		entry := CatalogEntry{
+			Name: family.GetName(),
+			Help: family.GetHelp(),
+			Type: family.GetType().String(),
+		}
+
+		// Label names are uniform across a family; take them from the
+		// first series
+		if metrics := family.GetMetric(); len(metrics) > 0 {
+			seen := make(map[string]bool)
+			for _, label := range metrics[0].GetLabel() {
+				if !seen[label.GetName()] {
+					entry.Labels = append(entry.Labels, label.GetName())
+					seen[label.GetName()] = true
+				}
+			}
+			sort.Strings(entry.Labels)
+		}
+
+		// Attach registration metadata for dynamically registered metrics
+		if info, exists := mc.metrics[family.GetName()]; exists {
+			entry.Static = info.labels
+			lastUpdate := info.lastUpdate
+			entry.LastUpdate = &lastUpdate
+		}
+
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries, nil
+}
+
+// CatalogHandler returns an HTTP handler serving the metric catalog as
+// JSON so users can discover available metrics before building dashboards
+func (mc *MetricsCollector) CatalogHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		entries, err := mc.Catalog()
+		if err != nil {
+			http.Error(w, "failed to gather metrics", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"count":   len(entries),
+			"metrics": entries,
+		})
+	})
+}
@@ -0,0 +1,230 @@
+// Package collectors provides metrics collection and monitoring functionality
+// with Prometheus integration for system reliability tracking.
+package collectors
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8" // v8.11.5
+	_ "github.com/lib/pq"          // v1.10.9
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// datastoreSampleInterval is how often datastore stats are sampled
+	datastoreSampleInterval = 30 * time.Second
+
+	// datastoreQueryTimeout bounds a single stats query
+	datastoreQueryTimeout = 5 * time.Second
+)
+
+// Postgres metrics collectors
+var (
+	postgresStatValue = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "postgres",
+			Name:      "stat_database",
+			Help:      "Per-database statistics from pg_stat_database",
+		},
+		[]string{"database", "stat"},
+	)
+
+	postgresReplicationLag = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "postgres",
+			Name:      "replication_lag_seconds",
+			Help:      "Replication lag of the connected Postgres instance",
+		},
+	)
+
+	postgresUp = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "postgres",
+			Name:      "up",
+			Help:      "Whether the last Postgres stats scrape succeeded",
+		},
+	)
+)
+
+// Redis metrics collectors
+var (
+	redisInfoValue = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "redis",
+			Name:      "info",
+			Help:      "Selected statistics from Redis INFO",
+		},
+		[]string{"stat"},
+	)
+
+	redisUp = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "redis",
+			Name:      "up",
+			Help:      "Whether the last Redis stats scrape succeeded",
+		},
+	)
+)
+
+// redisInfoStats lists the INFO fields exported as metrics
+var redisInfoStats = map[string]bool{
+	"connected_clients":          true,
+	"used_memory":                true,
+	"used_memory_peak":           true,
+	"total_connections_received": true,
+	"total_commands_processed":   true,
+	"keyspace_hits":              true,
+	"keyspace_misses":            true,
+	"evicted_keys":               true,
+	"expired_keys":               true,
+}
+
+// DatastoreCollector samples statistics from the platform's Postgres and
+// Redis instances so one scrape target covers the datastores
+type DatastoreCollector struct {
+	db       *sql.DB
+	redis    *redis.Client
+	interval time.Duration
+}
+
+// NewDatastoreCollector creates a collector for the given datastore
+// endpoints. Either may be empty to skip that datastore
+func NewDatastoreCollector(postgresDSN, redisAddress string) (*DatastoreCollector, error) {
+	collector := &DatastoreCollector{
+		interval: datastoreSampleInterval,
+	}
+
+	if postgresDSN != "" {
+		db, err := sql.Open("postgres", postgresDSN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open postgres connection: %w", err)
+		}
+		db.SetMaxOpenConns(2)
+		collector.db = db
+	}
+
+	if redisAddress != "" {
+		collector.redis = redis.NewClient(&redis.Options{Addr: redisAddress})
+	}
+
+	prometheus.MustRegister(postgresStatValue, postgresReplicationLag, postgresUp, redisInfoValue, redisUp)
+
+	return collector, nil
+}
+
+// Start begins periodic sampling until the context is canceled
+func (d *DatastoreCollector) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+
+		d.sample(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				d.close()
+				return
+			case <-ticker.C:
+				d.sample(ctx)
+			}
+		}
+	}()
+}
+
+// sample refreshes both datastore metric families
+func (d *DatastoreCollector) sample(ctx context.Context) {
+	if d.db != nil {
+		d.samplePostgres(ctx)
+	}
+	if d.redis != nil {
+		d.sampleRedis(ctx)
+	}
+}
+
+// samplePostgres exports pg_stat_database counters and replication lag
+func (d *DatastoreCollector) samplePostgres(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, datastoreQueryTimeout)
+	defer cancel()
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT datname, numbackends, xact_commit, xact_rollback,
+		       blks_read, blks_hit, deadlocks
+		FROM pg_stat_database
+		WHERE datname IS NOT NULL AND datname NOT LIKE 'template%'`)
+	if err != nil {
+		postgresUp.Set(0)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var database string
+		var backends, commits, rollbacks, read, hit, deadlocks float64
+		if err := rows.Scan(&database, &backends, &commits, &rollbacks, &read, &hit, &deadlocks); err != nil {
+			continue
+		}
+
+		postgresStatValue.WithLabelValues(database, "numbackends").Set(backends)
+		postgresStatValue.WithLabelValues(database, "xact_commit").Set(commits)
+		postgresStatValue.WithLabelValues(database, "xact_rollback").Set(rollbacks)
+		postgresStatValue.WithLabelValues(database, "blks_read").Set(read)
+		postgresStatValue.WithLabelValues(database, "blks_hit").Set(hit)
+		postgresStatValue.WithLabelValues(database, "deadlocks").Set(deadlocks)
+	}
+
+	// Replication lag is zero on primaries and when replay is caught up
+	var lag sql.NullFloat64
+	err = d.db.QueryRowContext(ctx, `
+		SELECT CASE WHEN pg_is_in_recovery()
+		       THEN COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+		       ELSE 0 END`).Scan(&lag)
+	if err == nil && lag.Valid {
+		postgresReplicationLag.Set(lag.Float64)
+	}
+
+	postgresUp.Set(1)
+}
+
+// sampleRedis exports selected fields from the Redis INFO command
+func (d *DatastoreCollector) sampleRedis(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, datastoreQueryTimeout)
+	defer cancel()
+
+	info, err := d.redis.Info(ctx).Result()
+	if err != nil {
+		redisUp.Set(0)
+		return
+	}
+
+	for _, line := range strings.Split(info, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), ":", 2)
+		if len(parts) != 2 || !redisInfoStats[parts[0]] {
+			continue
+		}
+		if value, err := strconv.ParseFloat(parts[1], 64); err == nil {
+			redisInfoValue.WithLabelValues(parts[0]).Set(value)
+		}
+	}
+
+	redisUp.Set(1)
+}
+
+// close releases the datastore connections
+func (d *DatastoreCollector) close() {
+	if d.db != nil {
+		d.db.Close()
+	}
+	if d.redis != nil {
+		d.redis.Close()
+	}
+}
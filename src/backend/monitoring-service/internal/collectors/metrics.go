@@ -6,12 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go" // v0.4.0
 )
 
 // Default configuration values for metrics collection
@@ -22,11 +25,29 @@ const (
 	maxMetricNameLength      = 255
 	maxLabelValueLength      = 255
 	defaultMetricMapCapacity = 100
+
+	// metricExpirySweepInterval is how often stale metrics are checked
+	// when a metric TTL is configured
+	metricExpirySweepInterval = time.Minute
 )
 
 // Default response time buckets for histogram metrics (in seconds)
 var defaultResponseTimeBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5}
 
+// nativeBucketFactor is the growth factor used for sparse native
+// histogram buckets when native histograms are enabled
+const nativeBucketFactor = 1.1
+
+// MetricError describes a metrics collection failure
+type MetricError struct {
+	Message string
+}
+
+// Error implements the error interface
+func (e *MetricError) Error() string {
+	return e.Message
+}
+
 // metricInfo stores metadata about registered metrics
 type metricInfo struct {
 	collector prometheus.Collector
@@ -43,6 +64,18 @@ type MetricsCollector struct {
 	metrics    map[string]metricInfo
 	timeout    time.Duration
 
+	// Histogram configuration
+	responseTimeBuckets []float64
+	nativeHistograms    bool
+
+	// Metric lifecycle configuration
+	metricTTL  time.Duration
+	expiryOnce sync.Once
+
+	// Shutdown coordination for background goroutines
+	done      chan struct{}
+	closeOnce sync.Once
+
 	// System metrics
 	responseTime prometheus.Histogram
 	requests     prometheus.Counter
@@ -58,32 +91,35 @@ func NewMetricsCollector() *MetricsCollector {
 		subsystem: defaultMetricSubsystem,
 		metrics:   make(map[string]metricInfo, defaultMetricMapCapacity),
 		timeout:   defaultMetricTimeout,
+		done:      make(chan struct{}),
+
+		responseTimeBuckets: defaultResponseTimeBuckets,
 	}
 
 	// Initialize system metrics
-	mc.responseTime = promauto.NewHistogram(prometheus.HistogramOpts{
+	mc.responseTime = prometheus.NewHistogram(prometheus.HistogramOpts{
 		Namespace: mc.namespace,
 		Subsystem: mc.subsystem,
 		Name:      "response_time_seconds",
 		Help:      "Response time distribution in seconds",
-		Buckets:   defaultResponseTimeBuckets,
+		Buckets:   mc.responseTimeBuckets,
 	})
 
-	mc.requests = promauto.NewCounter(prometheus.CounterOpts{
+	mc.requests = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: mc.namespace,
 		Subsystem: mc.subsystem,
 		Name:      "requests_total",
 		Help:      "Total number of requests processed",
 	})
 
-	mc.errors = promauto.NewCounter(prometheus.CounterOpts{
+	mc.errors = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: mc.namespace,
 		Subsystem: mc.subsystem,
 		Name:      "errors_total",
 		Help:      "Total number of errors encountered",
 	})
 
-	mc.uptime = promauto.NewGauge(prometheus.GaugeOpts{
+	mc.uptime = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: mc.namespace,
 		Subsystem: mc.subsystem,
 		Name:      "uptime_seconds",
@@ -114,6 +150,58 @@ func (mc *MetricsCollector) WithNamespace(namespace string) *MetricsCollector {
 	return mc
 }
 
+// WithResponseTimeBuckets replaces the bucket boundaries of the response
+// time histogram. Boundaries must be strictly ascending; invalid input
+// keeps the defaults
+func (mc *MetricsCollector) WithResponseTimeBuckets(buckets []float64) *MetricsCollector {
+	for i := range buckets {
+		if i > 0 && buckets[i] <= buckets[i-1] {
+			return mc
+		}
+	}
+	if len(buckets) == 0 {
+		return mc
+	}
+
+	mc.mutex.Lock()
+	mc.responseTimeBuckets = buckets
+	mc.mutex.Unlock()
+	mc.rebuildResponseTime()
+	return mc
+}
+
+// WithNativeHistograms additionally exposes histograms in the sparse
+// native format supported by recent Prometheus servers
+func (mc *MetricsCollector) WithNativeHistograms() *MetricsCollector {
+	mc.mutex.Lock()
+	mc.nativeHistograms = true
+	mc.mutex.Unlock()
+	mc.rebuildResponseTime()
+	return mc
+}
+
+// rebuildResponseTime re-registers the response time histogram with the
+// currently configured buckets
+func (mc *MetricsCollector) rebuildResponseTime() {
+	mc.mutex.Lock()
+	defer mc.mutex.Unlock()
+
+	opts := prometheus.HistogramOpts{
+		Namespace: mc.namespace,
+		Subsystem: mc.subsystem,
+		Name:      "response_time_seconds",
+		Help:      "Response time distribution in seconds",
+		Buckets:   mc.responseTimeBuckets,
+	}
+	if mc.nativeHistograms {
+		opts.NativeHistogramBucketFactor = nativeBucketFactor
+	}
+
+	mc.registry.Unregister(mc.responseTime)
+	mc.responseTime = prometheus.NewHistogram(opts)
+	mc.registry.MustRegister(mc.responseTime)
+}
+
 // WithTimeout sets a custom timeout for metric collection operations
 func (mc *MetricsCollector) WithTimeout(timeout time.Duration) *MetricsCollector {
 	if timeout <= 0 {
@@ -158,33 +246,126 @@ func (mc *MetricsCollector) RegisterMetric(metric prometheus.Collector, name str
 	return nil
 }
 
-// CollectMetrics collects metrics with timeout and batch processing
-func (mc *MetricsCollector) CollectMetrics(ctx context.Context) error {
-	timeoutCtx, cancel := context.WithTimeout(ctx, mc.timeout)
-	defer cancel()
+// UnregisterMetric removes a dynamically registered metric from the
+// registry so its series stop being exported
+func (mc *MetricsCollector) UnregisterMetric(name string) error {
+	mc.mutex.Lock()
+	defer mc.mutex.Unlock()
+
+	info, exists := mc.metrics[name]
+	if !exists {
+		return fmt.Errorf("metric %s is not registered", name)
+	}
 
-	mc.mutex.RLock()
-	defer mc.mutex.RUnlock()
+	mc.registry.Unregister(info.collector)
+	delete(mc.metrics, name)
+	return nil
+}
 
-	metricsChan := make(chan prometheus.Metric, len(mc.metrics))
-	errChan := make(chan error, 1)
+// MarkMetricUpdated refreshes the last-update time of a dynamically
+// registered metric, keeping it alive when a TTL is configured
+func (mc *MetricsCollector) MarkMetricUpdated(name string) {
+	mc.mutex.Lock()
+	defer mc.mutex.Unlock()
 
-	go func() {
-		if err := mc.registry.Gather(); err != nil {
-			errChan <- fmt.Errorf("failed to gather metrics: %w", err)
+	if info, exists := mc.metrics[name]; exists {
+		info.lastUpdate = time.Now()
+		mc.metrics[name] = info
+	}
+}
+
+// WithMetricTTL enables TTL-based expiry of dynamically registered
+// metrics: metrics not updated within the TTL are unregistered so
+// per-workflow metrics do not accumulate forever
+func (mc *MetricsCollector) WithMetricTTL(ttl time.Duration) *MetricsCollector {
+	if ttl <= 0 {
+		return mc
+	}
+
+	mc.mutex.Lock()
+	mc.metricTTL = ttl
+	mc.mutex.Unlock()
+
+	mc.expiryOnce.Do(func() {
+		go mc.expireStaleMetrics()
+	})
+	return mc
+}
+
+// expireStaleMetrics periodically unregisters metrics whose last update
+// is older than the configured TTL
+func (mc *MetricsCollector) expireStaleMetrics() {
+	ticker := time.NewTicker(metricExpirySweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			mc.mutex.Lock()
+			cutoff := time.Now().Add(-mc.metricTTL)
+			for name, info := range mc.metrics {
+				if info.lastUpdate.Before(cutoff) {
+					mc.registry.Unregister(info.collector)
+					delete(mc.metrics, name)
+				}
+			}
+			mc.mutex.Unlock()
+		case <-mc.done:
 			return
 		}
-		close(metricsChan)
+	}
+}
+
+// gatherResult carries the outcome of an asynchronous registry gather
+type gatherResult struct {
+	families []*dto.MetricFamily
+	err      error
+}
+
+// CollectMetrics gathers the current samples from the registry, bounded
+// by the configured timeout, and returns the metric families so exporters
+// and health checks can use real sampled values
+func (mc *MetricsCollector) CollectMetrics(ctx context.Context) ([]*dto.MetricFamily, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, mc.timeout)
+	defer cancel()
+
+	resultChan := make(chan gatherResult, 1)
+	go func() {
+		families, err := mc.registry.Gather()
+		resultChan <- gatherResult{families: families, err: err}
 	}()
 
 	select {
-	case err := <-errChan:
-		return err
+	case result := <-resultChan:
+		if result.err != nil {
+			return nil, fmt.Errorf("failed to gather metrics: %w", result.err)
+		}
+		return result.families, nil
 	case <-timeoutCtx.Done():
-		return fmt.Errorf("metrics collection timed out: %w", timeoutCtx.Err())
-	case <-ctx.Done():
-		return fmt.Errorf("context cancelled: %w", ctx.Err())
+		return nil, fmt.Errorf("metrics collection timed out: %w", timeoutCtx.Err())
+	}
+}
+
+// VisitMetrics gathers the current samples and applies the callback to
+// each metric family, stopping at the first error
+func (mc *MetricsCollector) VisitMetrics(ctx context.Context, visit func(*dto.MetricFamily) error) error {
+	families, err := mc.CollectMetrics(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, family := range families {
+		if err := visit(family); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// VerifyHealth confirms the collector can gather samples from its registry
+func (mc *MetricsCollector) VerifyHealth(ctx context.Context) error {
+	_, err := mc.CollectMetrics(ctx)
+	return err
 }
 
 // trackUptime continuously updates the uptime metric
@@ -193,9 +374,45 @@ func (mc *MetricsCollector) trackUptime() {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		mc.uptime.Set(time.Since(startTime).Seconds())
+	for {
+		select {
+		case <-ticker.C:
+			mc.uptime.Set(time.Since(startTime).Seconds())
+		case <-mc.done:
+			return
+		}
+	}
+}
+
+// Shutdown stops the collector's background goroutines. It is safe to
+// call more than once.
+func (mc *MetricsCollector) Shutdown(ctx context.Context) error {
+	mc.closeOnce.Do(func() {
+		close(mc.done)
+	})
+	return nil
+}
+
+// ParseBuckets parses comma-separated bucket boundaries such as
+// "0.1,0.5,1,5". It returns nil when the input is empty, unparsable, or
+// not strictly ascending
+func ParseBuckets(value string) []float64 {
+	if value == "" {
+		return nil
+	}
+
+	buckets := make([]float64, 0)
+	for _, field := range strings.Split(value, ",") {
+		boundary, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil
+		}
+		if len(buckets) > 0 && boundary <= buckets[len(buckets)-1] {
+			return nil
+		}
+		buckets = append(buckets, boundary)
 	}
+	return buckets
 }
 
 // validateMetricName validates the format and length of metric names
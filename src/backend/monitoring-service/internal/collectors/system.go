@@ -0,0 +1,330 @@
+// Package collectors provides metrics collection and monitoring functionality
+// with Prometheus integration for system reliability tracking.
+package collectors
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// defaultSampleInterval is how often system metrics are sampled
+	defaultSampleInterval = 15 * time.Second
+
+	// Paths of the proc and cgroup files sampled by the collector
+	procStatPath    = "/proc/stat"
+	procMeminfoPath = "/proc/meminfo"
+	procNetDevPath  = "/proc/net/dev"
+	cgroupV2Dir     = "/sys/fs/cgroup"
+	cgroupV1CPUDir  = "/sys/fs/cgroup/cpu"
+	cgroupV1MemDir  = "/sys/fs/cgroup/memory"
+)
+
+// System metrics collectors
+var (
+	systemCPUSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "system",
+			Name:      "cpu_seconds_total",
+			Help:      "Cumulative CPU time consumed per mode",
+		},
+		[]string{"mode"},
+	)
+
+	systemMemoryBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "system",
+			Name:      "memory_bytes",
+			Help:      "Host memory statistics in bytes",
+		},
+		[]string{"kind"},
+	)
+
+	systemDiskBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "system",
+			Name:      "disk_bytes",
+			Help:      "Filesystem capacity statistics in bytes",
+		},
+		[]string{"path", "kind"},
+	)
+
+	systemNetworkBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "system",
+			Name:      "network_bytes_total",
+			Help:      "Cumulative network bytes per interface and direction",
+		},
+		[]string{"interface", "direction"},
+	)
+
+	containerCPUThrottled = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "container",
+			Name:      "cpu_throttled_periods_total",
+			Help:      "Cumulative number of throttled CPU periods from the cgroup",
+		},
+	)
+
+	containerMemoryLimit = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "container",
+			Name:      "memory_limit_bytes",
+			Help:      "Container memory limit from the cgroup, zero when unlimited",
+		},
+	)
+
+	containerOOMEvents = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: defaultMetricNamespace,
+			Subsystem: "container",
+			Name:      "oom_events_total",
+			Help:      "Cumulative number of OOM kill events from the cgroup",
+		},
+	)
+)
+
+// SystemCollector samples host and container resource metrics from proc
+// and cgroup files so the monitoring service reports resource health
+// beyond the default Go collectors
+type SystemCollector struct {
+	interval  time.Duration
+	diskPaths []string
+}
+
+// NewSystemCollector creates a system collector sampling the given disk
+// paths, defaulting to the root filesystem
+func NewSystemCollector(diskPaths []string) *SystemCollector {
+	if len(diskPaths) == 0 {
+		diskPaths = []string{"/"}
+	}
+
+	prometheus.MustRegister(
+		systemCPUSeconds,
+		systemMemoryBytes,
+		systemDiskBytes,
+		systemNetworkBytes,
+		containerCPUThrottled,
+		containerMemoryLimit,
+		containerOOMEvents,
+	)
+
+	return &SystemCollector{
+		interval:  defaultSampleInterval,
+		diskPaths: diskPaths,
+	}
+}
+
+// Start begins periodic sampling until the context is canceled
+func (s *SystemCollector) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.sample()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.sample()
+			}
+		}
+	}()
+}
+
+// sample refreshes every metric family; individual sources failing (e.g.
+// missing cgroup files outside containers) are skipped silently
+func (s *SystemCollector) sample() {
+	s.sampleCPU()
+	s.sampleMemory()
+	s.sampleDisk()
+	s.sampleNetwork()
+	s.sampleCgroup()
+}
+
+// sampleCPU reads aggregate CPU time per mode from /proc/stat
+func (s *SystemCollector) sampleCPU() {
+	file, err := os.Open(procStatPath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[0] != "cpu" {
+			continue
+		}
+
+		// Values are in clock ticks; 100 ticks per second is the
+		// effectively universal USER_HZ
+		modes := []string{"user", "nice", "system", "idle", "iowait"}
+		for i, mode := range modes {
+			if i+1 >= len(fields) {
+				break
+			}
+			if ticks, err := strconv.ParseFloat(fields[i+1], 64); err == nil {
+				systemCPUSeconds.WithLabelValues(mode).Set(ticks / 100)
+			}
+		}
+		return
+	}
+}
+
+// sampleMemory reads host memory statistics from /proc/meminfo
+func (s *SystemCollector) sampleMemory() {
+	file, err := os.Open(procMeminfoPath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	wanted := map[string]string{
+		"MemTotal":     "total",
+		"MemFree":      "free",
+		"MemAvailable": "available",
+		"Cached":       "cached",
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		name := strings.TrimSuffix(fields[0], ":")
+		kind, ok := wanted[name]
+		if !ok {
+			continue
+		}
+		if kilobytes, err := strconv.ParseFloat(fields[1], 64); err == nil {
+			systemMemoryBytes.WithLabelValues(kind).Set(kilobytes * 1024)
+		}
+	}
+}
+
+// sampleDisk reads filesystem capacity for the configured paths
+func (s *SystemCollector) sampleDisk() {
+	for _, path := range s.diskPaths {
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(path, &stat); err != nil {
+			continue
+		}
+
+		blockSize := float64(stat.Bsize)
+		systemDiskBytes.WithLabelValues(path, "total").Set(float64(stat.Blocks) * blockSize)
+		systemDiskBytes.WithLabelValues(path, "free").Set(float64(stat.Bavail) * blockSize)
+	}
+}
+
+// sampleNetwork reads per-interface byte counters from /proc/net/dev
+func (s *SystemCollector) sampleNetwork() {
+	file, err := os.Open(procNetDevPath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.Contains(line, ":") {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		name := strings.TrimSpace(parts[0])
+		fields := strings.Fields(parts[1])
+		if name == "lo" || len(fields) < 9 {
+			continue
+		}
+
+		if received, err := strconv.ParseFloat(fields[0], 64); err == nil {
+			systemNetworkBytes.WithLabelValues(name, "receive").Set(received)
+		}
+		if transmitted, err := strconv.ParseFloat(fields[8], 64); err == nil {
+			systemNetworkBytes.WithLabelValues(name, "transmit").Set(transmitted)
+		}
+	}
+}
+
+// sampleCgroup reads container limits, throttling, and OOM events from
+// the cgroup filesystem, handling both v2 and v1 layouts
+func (s *SystemCollector) sampleCgroup() {
+	// CPU throttling: cgroup v2 cpu.stat, falling back to v1
+	if value, ok := readCgroupField(cgroupV2Dir+"/cpu.stat", "nr_throttled"); ok {
+		containerCPUThrottled.Set(value)
+	} else if value, ok := readCgroupField(cgroupV1CPUDir+"/cpu.stat", "nr_throttled"); ok {
+		containerCPUThrottled.Set(value)
+	}
+
+	// Memory limit: v2 memory.max ("max" means unlimited), v1 limit file
+	if value, ok := readCgroupValue(cgroupV2Dir + "/memory.max"); ok {
+		containerMemoryLimit.Set(value)
+	} else if value, ok := readCgroupValue(cgroupV1MemDir + "/memory.limit_in_bytes"); ok {
+		containerMemoryLimit.Set(value)
+	}
+
+	// OOM kills: v2 memory.events, v1 memory.oom_control
+	if value, ok := readCgroupField(cgroupV2Dir+"/memory.events", "oom_kill"); ok {
+		containerOOMEvents.Set(value)
+	} else if value, ok := readCgroupField(cgroupV1MemDir+"/memory.oom_control", "oom_kill"); ok {
+		containerOOMEvents.Set(value)
+	}
+}
+
+// readCgroupValue reads a single numeric cgroup file; non-numeric content
+// such as "max" reports as zero (unlimited)
+func readCgroupValue(path string) (float64, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+
+	content := strings.TrimSpace(string(data))
+	if content == "max" {
+		return 0, true
+	}
+
+	value, err := strconv.ParseFloat(content, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// readCgroupField reads a named field from a key-value cgroup file such
+// as cpu.stat or memory.events
+func readCgroupField(path, field string) (float64, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == field {
+			value, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return 0, false
+			}
+			return value, true
+		}
+	}
+	return 0, false
+}
@@ -10,8 +10,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"src/backend/monitoring-service/internal/collectors"
 )
 
 // Default configuration values for the Prometheus exporter
@@ -26,7 +25,7 @@ const (
 // PrometheusExporter manages the export of metrics via HTTP endpoint for Prometheus
 // scraping with configuration options and security controls.
 type PrometheusExporter struct {
-	collector       *MetricsCollector
+	collector       *collectors.MetricsCollector
 	server         *http.Server
 	metricsPath    string
 	listenAddress  string
@@ -37,7 +36,7 @@ type PrometheusExporter struct {
 
 // NewPrometheusExporter creates a new Prometheus exporter instance with the given
 // metrics collector and default configuration.
-func NewPrometheusExporter(collector *MetricsCollector) (*PrometheusExporter, error) {
+func NewPrometheusExporter(collector *collectors.MetricsCollector) (*PrometheusExporter, error) {
 	if collector == nil {
 		return nil, fmt.Errorf("metrics collector cannot be nil")
 	}
@@ -51,10 +50,6 @@ func NewPrometheusExporter(collector *MetricsCollector) (*PrometheusExporter, er
 		writeTimeout:   defaultWriteTimeout,
 	}
 
-	// Register default process and Go runtime metrics
-	prometheus.DefaultRegisterer.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
-	prometheus.DefaultRegisterer.MustRegister(prometheus.NewGoCollector())
-
 	return exporter, nil
 }
 
@@ -123,7 +118,7 @@ func securityMiddleware(next http.Handler) http.Handler {
 func (e *PrometheusExporter) Start(ctx context.Context) error {
 	// Create server mux and register metrics handler with security middleware
 	mux := http.NewServeMux()
-	mux.Handle(e.metricsPath, securityMiddleware(promhttp.Handler()))
+	mux.Handle(e.metricsPath, securityMiddleware(e.collector.Handler()))
 
 	// Configure the HTTP server
 	e.server = &http.Server{
@@ -161,4 +156,12 @@ func (e *PrometheusExporter) Start(ctx context.Context) error {
 	case err := <-errChan:
 		return err
 	}
+}
+
+// Shutdown gracefully stops the metrics HTTP server if it is running.
+func (e *PrometheusExporter) Shutdown(ctx context.Context) error {
+	if e.server == nil {
+		return nil
+	}
+	return e.server.Shutdown(ctx)
 }
\ No newline at end of file
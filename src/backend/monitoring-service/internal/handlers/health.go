@@ -6,12 +6,12 @@ import (
     "context"
     "encoding/json"
     "net/http"
+    "strconv"
     "sync"
     "time"
 
-    "golang.org/x/time/rate" // v0.0.0-20220922204420-00f56bc4866
-
-    "src/backend/monitoring-service/internal/collectors"
+    dto "github.com/prometheus/client_model/go" // v0.4.0
+    "golang.org/x/time/rate"                    // v0.0.0-20220922204420-00f56bc4866
 )
 
 const (
@@ -44,9 +44,15 @@ type HealthResponse struct {
     Metrics   map[string]string `json:"metrics,omitempty"`
 }
 
+// MetricsSource is the slice of the metrics collector the health
+// endpoints depend on
+type MetricsSource interface {
+    CollectMetrics(ctx context.Context) ([]*dto.MetricFamily, error)
+}
+
 // HealthHandler provides enhanced health check endpoints with monitoring
 type HealthHandler struct {
-    collector       *collectors.MetricsCollector
+    collector       MetricsSource
     timeout         time.Duration
     rateLimiter    *rate.Limiter
     responsePool   *sync.Pool
@@ -73,7 +79,7 @@ var (
 )
 
 // NewHealthHandler creates a new health check handler with enhanced configuration
-func NewHealthHandler(collector *collectors.MetricsCollector, opts Options) *HealthHandler {
+func NewHealthHandler(collector MetricsSource, opts Options) *HealthHandler {
     if collector == nil {
         panic("metrics collector is required")
     }
@@ -107,6 +113,12 @@ func NewHealthHandler(collector *collectors.MetricsCollector, opts Options) *Hea
 
 // HandleLiveness implements the liveness probe endpoint
 func (h *HealthHandler) HandleLiveness(w http.ResponseWriter, r *http.Request) {
+    // Probes are read-only
+    if r.Method != http.MethodGet && r.Method != http.MethodHead {
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+
     // Apply rate limiting
     if !h.rateLimiter.Allow() {
         http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
@@ -115,9 +127,6 @@ func (h *HealthHandler) HandleLiveness(w http.ResponseWriter, r *http.Request) {
 
     // Start latency tracking
     start := time.Now()
-    defer func() {
-        h.collector.CollectMetrics(context.Background())
-    }()
 
     // Get response object from pool
     resp := h.responsePool.Get().(*HealthResponse)
@@ -128,6 +137,7 @@ func (h *HealthHandler) HandleLiveness(w http.ResponseWriter, r *http.Request) {
     resp.Timestamp = time.Now()
     resp.Checks = make(map[string]bool)
     resp.Metrics = make(map[string]string)
+    resp.Metrics["latency"] = time.Since(start).String()
 
     // Set security headers
     for k, v := range h.securityHeaders {
@@ -144,6 +154,12 @@ func (h *HealthHandler) HandleLiveness(w http.ResponseWriter, r *http.Request) {
 
 // HandleReadiness implements the readiness probe endpoint with detailed health checks
 func (h *HealthHandler) HandleReadiness(w http.ResponseWriter, r *http.Request) {
+    // Probes are read-only
+    if r.Method != http.MethodGet && r.Method != http.MethodHead {
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+
     // Apply rate limiting
     if !h.rateLimiter.Allow() {
         http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
@@ -156,9 +172,6 @@ func (h *HealthHandler) HandleReadiness(w http.ResponseWriter, r *http.Request)
 
     // Start latency tracking
     start := time.Now()
-    defer func() {
-        h.collector.CollectMetrics(context.Background())
-    }()
 
     // Get response object from pool
     resp := h.responsePool.Get().(*HealthResponse)
@@ -170,29 +183,31 @@ func (h *HealthHandler) HandleReadiness(w http.ResponseWriter, r *http.Request)
     resp.Checks = make(map[string]bool)
     resp.Metrics = make(map[string]string)
 
-    // Perform health checks
+    // Perform health checks against real gathered samples
+    familiesChan := make(chan []*dto.MetricFamily, 1)
     errChan := make(chan error, 1)
     go func() {
-        if err := h.collector.CollectMetrics(ctx); err != nil {
+        families, err := h.collector.CollectMetrics(ctx)
+        if err != nil {
             errChan <- err
             return
         }
-        close(errChan)
+        familiesChan <- families
     }()
 
     // Wait for health checks or timeout
     select {
-    case err := <-errChan:
-        if err != nil {
-            resp.Status = "DOWN"
-            resp.Checks["metrics_collector"] = false
-        } else {
-            resp.Checks["metrics_collector"] = true
-        }
+    case families := <-familiesChan:
+        resp.Checks["metrics_collector"] = true
+        resp.Metrics["metric_families"] = strconv.Itoa(len(families))
+    case <-errChan:
+        resp.Status = "DOWN"
+        resp.Checks["metrics_collector"] = false
     case <-ctx.Done():
         resp.Status = "DOWN"
         resp.Checks["timeout"] = false
     }
+    resp.Metrics["latency"] = time.Since(start).String()
 
     // Set security headers
     for k, v := range h.securityHeaders {
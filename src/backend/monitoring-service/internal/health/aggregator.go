@@ -0,0 +1,182 @@
+// Package health provides cross-service health aggregation, fanning out to
+// dependent services' health endpoints and computing a weighted rollup for
+// load balancers and status pages.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Aggregate health states
+const (
+	StatusHealthy   = "healthy"
+	StatusDegraded  = "degraded"
+	StatusUnhealthy = "unhealthy"
+)
+
+// Default aggregation configuration values
+const (
+	defaultCheckTimeout   = 3 * time.Second
+	degradedThreshold     = 0.5 // weighted share of failing dependencies that flips the rollup to unhealthy
+)
+
+// Metrics collectors
+var (
+	dependencyUp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitoring_dependency_up",
+			Help: "Whether the last health check of a dependency succeeded (1) or failed (0)",
+		},
+		[]string{"dependency"},
+	)
+)
+
+// Dependency describes one downstream service health endpoint
+type Dependency struct {
+	Name     string  `json:"name"`
+	URL      string  `json:"url"`
+	Weight   float64 `json:"weight"`   // relative impact on the rollup; defaults to 1
+	Critical bool    `json:"critical"` // a failing critical dependency forces an unhealthy rollup
+}
+
+// DependencyStatus is the checked state of a single dependency
+type DependencyStatus struct {
+	Name    string        `json:"name"`
+	Healthy bool          `json:"healthy"`
+	Latency time.Duration `json:"latency"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// SystemStatus is the aggregated health rollup
+type SystemStatus struct {
+	Status       string             `json:"status"`
+	Score        float64            `json:"score"` // weighted share of healthy dependencies, 0..1
+	Dependencies []DependencyStatus `json:"dependencies"`
+	CheckedAt    time.Time          `json:"checked_at"`
+}
+
+// Aggregator fans out to dependency health endpoints and computes the rollup
+type Aggregator struct {
+	dependencies []Dependency
+	client       *http.Client
+}
+
+// NewAggregator creates a health aggregator over the given dependencies
+func NewAggregator(dependencies []Dependency) *Aggregator {
+	for i := range dependencies {
+		if dependencies[i].Weight <= 0 {
+			dependencies[i].Weight = 1
+		}
+	}
+
+	prometheus.MustRegister(dependencyUp)
+
+	return &Aggregator{
+		dependencies: dependencies,
+		client:       &http.Client{Timeout: defaultCheckTimeout},
+	}
+}
+
+// Check queries every dependency concurrently and aggregates the results
+func (a *Aggregator) Check(ctx context.Context) SystemStatus {
+	statuses := make([]DependencyStatus, len(a.dependencies))
+
+	var wg sync.WaitGroup
+	for i, dependency := range a.dependencies {
+		i, dependency := i, dependency
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i] = a.checkDependency(ctx, dependency)
+		}()
+	}
+	wg.Wait()
+
+	var totalWeight, healthyWeight float64
+	criticalDown := false
+	for i, dependency := range a.dependencies {
+		totalWeight += dependency.Weight
+		if statuses[i].Healthy {
+			healthyWeight += dependency.Weight
+			dependencyUp.WithLabelValues(dependency.Name).Set(1)
+		} else {
+			dependencyUp.WithLabelValues(dependency.Name).Set(0)
+			if dependency.Critical {
+				criticalDown = true
+			}
+		}
+	}
+
+	score := 1.0
+	if totalWeight > 0 {
+		score = healthyWeight / totalWeight
+	}
+
+	status := StatusHealthy
+	switch {
+	case criticalDown || score < degradedThreshold:
+		status = StatusUnhealthy
+	case score < 1:
+		status = StatusDegraded
+	}
+
+	return SystemStatus{
+		Status:       status,
+		Score:        score,
+		Dependencies: statuses,
+		CheckedAt:    time.Now().UTC(),
+	}
+}
+
+// checkDependency performs a single health check
+func (a *Aggregator) checkDependency(ctx context.Context, dependency Dependency) DependencyStatus {
+	status := DependencyStatus{Name: dependency.Name}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, dependency.URL, nil)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	start := time.Now()
+	resp, err := a.client.Do(req)
+	status.Latency = time.Since(start)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		status.Error = resp.Status
+		return status
+	}
+
+	status.Healthy = true
+	return status
+}
+
+// Handler returns the /health/system endpoint. The HTTP status mirrors the
+// rollup so load balancers can act on it directly.
+func (a *Aggregator) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := a.Check(r.Context())
+
+		code := http.StatusOK
+		if status.Status == StatusUnhealthy {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
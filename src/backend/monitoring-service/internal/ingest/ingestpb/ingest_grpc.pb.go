@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: ingest.proto
+
+package ingestpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MetricsIngress_RegisterMetric_FullMethodName = "/monitoring.ingest.v1.MetricsIngress/RegisterMetric"
+	MetricsIngress_PushMetric_FullMethodName     = "/monitoring.ingest.v1.MetricsIngress/PushMetric"
+)
+
+// MetricsIngressClient is the client API for MetricsIngress service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MetricsIngressClient interface {
+	// RegisterMetric declares a metric before it can be pushed.
+	RegisterMetric(ctx context.Context, in *RegisterMetricRequest, opts ...grpc.CallOption) (*RegisterMetricResponse, error)
+	// PushMetric records an observation against a registered metric.
+	PushMetric(ctx context.Context, in *PushMetricRequest, opts ...grpc.CallOption) (*PushMetricResponse, error)
+}
+
+type metricsIngressClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMetricsIngressClient(cc grpc.ClientConnInterface) MetricsIngressClient {
+	return &metricsIngressClient{cc}
+}
+
+func (c *metricsIngressClient) RegisterMetric(ctx context.Context, in *RegisterMetricRequest, opts ...grpc.CallOption) (*RegisterMetricResponse, error) {
+	out := new(RegisterMetricResponse)
+	err := c.cc.Invoke(ctx, MetricsIngress_RegisterMetric_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsIngressClient) PushMetric(ctx context.Context, in *PushMetricRequest, opts ...grpc.CallOption) (*PushMetricResponse, error) {
+	out := new(PushMetricResponse)
+	err := c.cc.Invoke(ctx, MetricsIngress_PushMetric_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetricsIngressServer is the server API for MetricsIngress service.
+// All implementations must embed UnimplementedMetricsIngressServer
+// for forward compatibility
+type MetricsIngressServer interface {
+	// RegisterMetric declares a metric before it can be pushed.
+	RegisterMetric(context.Context, *RegisterMetricRequest) (*RegisterMetricResponse, error)
+	// PushMetric records an observation against a registered metric.
+	PushMetric(context.Context, *PushMetricRequest) (*PushMetricResponse, error)
+	mustEmbedUnimplementedMetricsIngressServer()
+}
+
+// UnimplementedMetricsIngressServer must be embedded to have forward compatible implementations.
+type UnimplementedMetricsIngressServer struct {
+}
+
+func (UnimplementedMetricsIngressServer) RegisterMetric(context.Context, *RegisterMetricRequest) (*RegisterMetricResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMetric not implemented")
+}
+func (UnimplementedMetricsIngressServer) PushMetric(context.Context, *PushMetricRequest) (*PushMetricResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushMetric not implemented")
+}
+func (UnimplementedMetricsIngressServer) mustEmbedUnimplementedMetricsIngressServer() {}
+
+// UnsafeMetricsIngressServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetricsIngressServer will
+// result in compilation errors.
+type UnsafeMetricsIngressServer interface {
+	mustEmbedUnimplementedMetricsIngressServer()
+}
+
+func RegisterMetricsIngressServer(s grpc.ServiceRegistrar, srv MetricsIngressServer) {
+	s.RegisterService(&MetricsIngress_ServiceDesc, srv)
+}
+
+func _MetricsIngress_RegisterMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsIngressServer).RegisterMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsIngress_RegisterMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsIngressServer).RegisterMetric(ctx, req.(*RegisterMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsIngress_PushMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsIngressServer).PushMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsIngress_PushMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsIngressServer).PushMetric(ctx, req.(*PushMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MetricsIngress_ServiceDesc is the grpc.ServiceDesc for MetricsIngress service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MetricsIngress_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "monitoring.ingest.v1.MetricsIngress",
+	HandlerType: (*MetricsIngressServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterMetric",
+			Handler:    _MetricsIngress_RegisterMetric_Handler,
+		},
+		{
+			MethodName: "PushMetric",
+			Handler:    _MetricsIngress_PushMetric_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ingest.proto",
+}
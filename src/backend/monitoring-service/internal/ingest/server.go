@@ -0,0 +1,199 @@
+// Package ingest provides the gRPC metric ingestion API that lets other
+// services register and push custom metrics through the monitoring service
+// instead of embedding their own Prometheus clients.
+package ingest
+
+//go:generate protoc --go_out=. --go-grpc_out=. --proto_path=../../api/proto ingest.proto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"src/backend/monitoring-service/internal/collectors"
+	"src/backend/monitoring-service/internal/ingest/ingestpb"
+)
+
+// Default configuration values for the ingestion server
+const (
+	defaultListenAddress = ":9095"
+	maxLabelNames        = 10
+)
+
+// Default buckets applied to pushed histograms when the caller does not
+// provide any
+var defaultPushBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5}
+
+// Common ingestion errors
+var (
+	ErrUnknownMetric    = errors.New("metric is not registered")
+	ErrTypeMismatch     = errors.New("push does not match the registered metric type")
+	ErrTooManyLabels    = errors.New("too many label names")
+	ErrInvalidType      = errors.New("unsupported metric type")
+)
+
+// registeredMetric tracks a metric created through the ingestion API
+type registeredMetric struct {
+	metricType ingestpb.MetricType
+	counter    *prometheus.CounterVec
+	gauge      *prometheus.GaugeVec
+	histogram  *prometheus.HistogramVec
+	labelNames []string
+}
+
+// Server implements the MetricsIngress gRPC service on top of the
+// monitoring service's MetricsCollector
+type Server struct {
+	ingestpb.UnimplementedMetricsIngressServer
+
+	collector *collectors.MetricsCollector
+	address   string
+	grpc      *grpc.Server
+	mutex     sync.RWMutex
+	metrics   map[string]*registeredMetric
+}
+
+// NewServer creates a new metric ingestion server backed by the collector
+func NewServer(collector *collectors.MetricsCollector, address string) *Server {
+	if address == "" {
+		address = defaultListenAddress
+	}
+
+	return &Server{
+		collector: collector,
+		address:   address,
+		metrics:   make(map[string]*registeredMetric),
+	}
+}
+
+// RegisterMetric declares a new metric; registering the same name twice
+// with identical parameters is idempotent
+func (s *Server) RegisterMetric(ctx context.Context, req *ingestpb.RegisterMetricRequest) (*ingestpb.RegisterMetricResponse, error) {
+	if len(req.LabelNames) > maxLabelNames {
+		return nil, fmt.Errorf("%w: %d exceeds limit of %d", ErrTooManyLabels, len(req.LabelNames), maxLabelNames)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if existing, exists := s.metrics[req.Name]; exists {
+		if existing.metricType != req.Type {
+			return nil, fmt.Errorf("%w: %s already registered as %s", ErrTypeMismatch, req.Name, existing.metricType)
+		}
+		return &ingestpb.RegisterMetricResponse{Created: false}, nil
+	}
+
+	metric := &registeredMetric{
+		metricType: req.Type,
+		labelNames: req.LabelNames,
+	}
+
+	var collector prometheus.Collector
+	switch req.Type {
+	case ingestpb.MetricType_METRIC_TYPE_COUNTER:
+		metric.counter = prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: req.Name, Help: req.Help},
+			req.LabelNames,
+		)
+		collector = metric.counter
+	case ingestpb.MetricType_METRIC_TYPE_GAUGE:
+		metric.gauge = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{Name: req.Name, Help: req.Help},
+			req.LabelNames,
+		)
+		collector = metric.gauge
+	case ingestpb.MetricType_METRIC_TYPE_HISTOGRAM:
+		buckets := req.Buckets
+		if len(buckets) == 0 {
+			buckets = defaultPushBuckets
+		}
+		metric.histogram = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: req.Name, Help: req.Help, Buckets: buckets},
+			req.LabelNames,
+		)
+		collector = metric.histogram
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidType, req.Type)
+	}
+
+	if err := s.collector.RegisterMetric(collector, req.Name, nil); err != nil {
+		return nil, fmt.Errorf("failed to register metric: %w", err)
+	}
+
+	s.metrics[req.Name] = metric
+	return &ingestpb.RegisterMetricResponse{Created: true}, nil
+}
+
+// PushMetric records an observation against a previously registered metric
+func (s *Server) PushMetric(ctx context.Context, req *ingestpb.PushMetricRequest) (*ingestpb.PushMetricResponse, error) {
+	s.mutex.RLock()
+	metric, exists := s.metrics[req.Name]
+	s.mutex.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMetric, req.Name)
+	}
+
+	labels := make([]string, 0, len(metric.labelNames))
+	for _, name := range metric.labelNames {
+		labels = append(labels, req.Labels[name])
+	}
+
+	switch metric.metricType {
+	case ingestpb.MetricType_METRIC_TYPE_COUNTER:
+		metric.counter.WithLabelValues(labels...).Add(req.Value)
+	case ingestpb.MetricType_METRIC_TYPE_GAUGE:
+		metric.gauge.WithLabelValues(labels...).Set(req.Value)
+	case ingestpb.MetricType_METRIC_TYPE_HISTOGRAM:
+		metric.histogram.WithLabelValues(labels...).Observe(req.Value)
+	}
+
+	// Keep the metric alive when TTL-based expiry is configured
+	s.collector.MarkMetricUpdated(req.Name)
+
+	return &ingestpb.PushMetricResponse{}, nil
+}
+
+// Start begins serving the gRPC ingestion API until the context is cancelled
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.address, err)
+	}
+
+	s.grpc = grpc.NewServer()
+	ingestpb.RegisterMetricsIngressServer(s.grpc, s)
+
+	go func() {
+		<-ctx.Done()
+		s.grpc.GracefulStop()
+	}()
+
+	return s.grpc.Serve(listener)
+}
+
+// Shutdown stops the gRPC server, waiting for in-flight RPCs to finish
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.grpc == nil {
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.grpc.Stop()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,323 @@
+// Package logs provides the log aggregation pipeline that receives
+// structured logs from other services, buffers them with backpressure and
+// disk spill, and ships them to the configured storage backend.
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Default configuration values for the log pipeline
+const (
+	defaultBufferSize    = 10000
+	defaultBatchSize     = 500
+	defaultFlushInterval = 5 * time.Second
+	defaultSpillDir      = "/var/spool/monitoring-service/logs"
+	spillFilePattern     = "spill-*.jsonl"
+)
+
+// Common pipeline errors
+var (
+	ErrPipelineClosed = errors.New("log pipeline is closed")
+	ErrEntryTooOld    = errors.New("log entry is too old")
+)
+
+// Metrics collectors
+var (
+	logsReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitoring_logs_received_total",
+			Help: "Structured log entries received by the aggregation pipeline",
+		},
+		[]string{"service"},
+	)
+
+	logsShipped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitoring_logs_shipped_total",
+			Help: "Log entries successfully shipped to the storage backend",
+		},
+		[]string{"backend"},
+	)
+
+	logsSpilled = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "monitoring_logs_spilled_total",
+			Help: "Log entries spilled to disk due to backpressure",
+		},
+	)
+
+	logsDropped = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "monitoring_logs_dropped_total",
+			Help: "Log entries dropped after spill failure",
+		},
+	)
+
+	logQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "monitoring_logs_queue_depth",
+			Help: "Current number of buffered log entries awaiting shipment",
+		},
+	)
+)
+
+// LogEntry is a single structured log record received from a service
+type LogEntry struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Level     string            `json:"level"`
+	Service   string            `json:"service"`
+	Message   string            `json:"message"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// Shipper delivers batches of log entries to a storage backend
+type Shipper interface {
+	// Name identifies the backend in metrics and logs
+	Name() string
+	// Ship delivers a batch; implementations must be safe to retry
+	Ship(ctx context.Context, entries []LogEntry) error
+}
+
+// PipelineConfig configures the log aggregation pipeline
+type PipelineConfig struct {
+	BufferSize    int
+	BatchSize     int
+	FlushInterval time.Duration
+	SpillDir      string
+}
+
+// Pipeline buffers incoming log entries and ships them in batches,
+// spilling to disk instead of blocking producers when the buffer is full
+type Pipeline struct {
+	shipper Shipper
+	config  PipelineConfig
+	entries chan LogEntry
+	closed  chan struct{}
+	once    sync.Once
+	wg      sync.WaitGroup
+}
+
+// NewPipeline creates a log pipeline shipping to the given backend
+func NewPipeline(shipper Shipper, config PipelineConfig) *Pipeline {
+	if config.BufferSize <= 0 {
+		config.BufferSize = defaultBufferSize
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaultFlushInterval
+	}
+	if config.SpillDir == "" {
+		config.SpillDir = defaultSpillDir
+	}
+
+	return &Pipeline{
+		shipper: shipper,
+		config:  config,
+		entries: make(chan LogEntry, config.BufferSize),
+		closed:  make(chan struct{}),
+	}
+}
+
+// Enqueue accepts a log entry, spilling it to disk when the in-memory
+// buffer is saturated so producers are never blocked
+func (p *Pipeline) Enqueue(entry LogEntry) error {
+	select {
+	case <-p.closed:
+		return ErrPipelineClosed
+	default:
+	}
+
+	logsReceived.WithLabelValues(entry.Service).Inc()
+
+	select {
+	case p.entries <- entry:
+		logQueueDepth.Set(float64(len(p.entries)))
+		return nil
+	default:
+		// Buffer full: spill to disk rather than applying backpressure
+		if err := p.spill([]LogEntry{entry}); err != nil {
+			logsDropped.Inc()
+			return fmt.Errorf("failed to spill log entry: %w", err)
+		}
+		logsSpilled.Inc()
+		return nil
+	}
+}
+
+// Start runs the shipping loop until the context is cancelled
+func (p *Pipeline) Start(ctx context.Context) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(p.config.FlushInterval)
+		defer ticker.Stop()
+
+		batch := make([]LogEntry, 0, p.config.BatchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				p.flush(context.Background(), batch)
+				return
+			case entry := <-p.entries:
+				batch = append(batch, entry)
+				logQueueDepth.Set(float64(len(p.entries)))
+				if len(batch) >= p.config.BatchSize {
+					p.flush(ctx, batch)
+					batch = batch[:0]
+				}
+			case <-ticker.C:
+				if len(batch) > 0 {
+					p.flush(ctx, batch)
+					batch = batch[:0]
+				}
+				p.recoverSpilled(ctx)
+			}
+		}
+	}()
+}
+
+// Shutdown closes the pipeline and waits for the shipping loop to drain
+func (p *Pipeline) Shutdown(ctx context.Context) error {
+	p.once.Do(func() { close(p.closed) })
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush ships a batch, spilling it to disk if the backend is unavailable
+func (p *Pipeline) flush(ctx context.Context, batch []LogEntry) {
+	if len(batch) == 0 {
+		return
+	}
+
+	if err := p.shipper.Ship(ctx, batch); err != nil {
+		if spillErr := p.spill(batch); spillErr != nil {
+			logsDropped.Add(float64(len(batch)))
+			return
+		}
+		logsSpilled.Add(float64(len(batch)))
+		return
+	}
+
+	logsShipped.WithLabelValues(p.shipper.Name()).Add(float64(len(batch)))
+}
+
+// spill appends entries to a JSON-lines spill file on disk
+func (p *Pipeline) spill(entries []LogEntry) error {
+	if err := os.MkdirAll(p.config.SpillDir, 0o750); err != nil {
+		return fmt.Errorf("failed to create spill directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(p.config.SpillDir, spillFilePattern)
+	if err != nil {
+		return fmt.Errorf("failed to create spill file: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return fmt.Errorf("failed to encode spill entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// recoverSpilled re-ships previously spilled entries once the backend is
+// healthy again, deleting each spill file after successful shipment
+func (p *Pipeline) recoverSpilled(ctx context.Context) {
+	files, err := filepath.Glob(filepath.Join(p.config.SpillDir, spillFilePattern))
+	if err != nil || len(files) == 0 {
+		return
+	}
+
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		entries := make([]LogEntry, 0)
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		for {
+			var entry LogEntry
+			if err := decoder.Decode(&entry); err != nil {
+				break
+			}
+			entries = append(entries, entry)
+		}
+
+		if len(entries) == 0 {
+			os.Remove(path)
+			continue
+		}
+
+		if err := p.shipper.Ship(ctx, entries); err != nil {
+			// Backend still unhealthy; retry on the next tick
+			return
+		}
+
+		logsShipped.WithLabelValues(p.shipper.Name()).Add(float64(len(entries)))
+		os.Remove(path)
+	}
+}
+
+// Handler returns the HTTP ingestion endpoint accepting a JSON array of
+// log entries
+func (p *Pipeline) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var entries []LogEntry
+		if err := json.NewDecoder(r.Body).Decode(&entries); err != nil {
+			http.Error(w, fmt.Sprintf("invalid payload: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		for _, entry := range entries {
+			if entry.Timestamp.IsZero() {
+				entry.Timestamp = time.Now().UTC()
+			}
+			if err := p.Enqueue(entry); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// init registers the pipeline metrics with the default registry
+func init() {
+	prometheus.MustRegister(logsReceived, logsShipped, logsSpilled, logsDropped, logQueueDepth)
+}
@@ -0,0 +1,159 @@
+// Package logs provides the log aggregation pipeline that receives
+// structured logs from other services, buffers them with backpressure and
+// disk spill, and ships them to the configured storage backend.
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default shipper configuration values
+const (
+	defaultShipTimeout = 10 * time.Second
+	lokiPushPath       = "/loki/api/v1/push"
+	elasticBulkPath    = "/_bulk"
+)
+
+// LokiShipper delivers log batches to a Grafana Loki instance
+type LokiShipper struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewLokiShipper creates a shipper targeting the given Loki base URL
+func NewLokiShipper(endpoint string) *LokiShipper {
+	return &LokiShipper{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: defaultShipTimeout},
+	}
+}
+
+// Name identifies the backend in metrics
+func (s *LokiShipper) Name() string {
+	return "loki"
+}
+
+// Ship pushes a batch using the Loki push API, grouping entries into one
+// stream per service
+func (s *LokiShipper) Ship(ctx context.Context, entries []LogEntry) error {
+	type lokiStream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+
+	streams := make(map[string]*lokiStream)
+	for _, entry := range entries {
+		stream, exists := streams[entry.Service]
+		if !exists {
+			labels := map[string]string{"service": entry.Service, "level": entry.Level}
+			for k, v := range entry.Labels {
+				labels[k] = v
+			}
+			stream = &lokiStream{Stream: labels}
+			streams[entry.Service] = stream
+		}
+		stream.Values = append(stream.Values, [2]string{
+			strconv.FormatInt(entry.Timestamp.UnixNano(), 10),
+			entry.Message,
+		})
+	}
+
+	payload := struct {
+		Streams []*lokiStream `json:"streams"`
+	}{Streams: make([]*lokiStream, 0, len(streams))}
+	for _, stream := range streams {
+		payload.Streams = append(payload.Streams, stream)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode Loki payload: %w", err)
+	}
+
+	return s.post(ctx, s.endpoint+lokiPushPath, "application/json", body)
+}
+
+// post sends the payload and validates the response status
+func (s *LokiShipper) post(ctx context.Context, url, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to ship logs: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("log backend returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ElasticsearchShipper delivers log batches to an Elasticsearch cluster
+// using the bulk API
+type ElasticsearchShipper struct {
+	endpoint string
+	index    string
+	client   *http.Client
+}
+
+// NewElasticsearchShipper creates a shipper targeting the given
+// Elasticsearch base URL and index
+func NewElasticsearchShipper(endpoint, index string) *ElasticsearchShipper {
+	if index == "" {
+		index = "service-logs"
+	}
+	return &ElasticsearchShipper{
+		endpoint: endpoint,
+		index:    index,
+		client:   &http.Client{Timeout: defaultShipTimeout},
+	}
+}
+
+// Name identifies the backend in metrics
+func (s *ElasticsearchShipper) Name() string {
+	return "elasticsearch"
+}
+
+// Ship indexes a batch using the Elasticsearch bulk API
+func (s *ElasticsearchShipper) Ship(ctx context.Context, entries []LogEntry) error {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+
+	action := map[string]map[string]string{"index": {"_index": s.index}}
+	for _, entry := range entries {
+		if err := encoder.Encode(action); err != nil {
+			return fmt.Errorf("failed to encode bulk action: %w", err)
+		}
+		if err := encoder.Encode(entry); err != nil {
+			return fmt.Errorf("failed to encode bulk document: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+elasticBulkPath, &buffer)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to ship logs: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("log backend returned status %d", resp.StatusCode)
+	}
+	return nil
+}
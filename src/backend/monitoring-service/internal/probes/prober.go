@@ -0,0 +1,248 @@
+// Package probes provides synthetic health probing of external targets,
+// recording latency and availability metrics and feeding probe failures to
+// the alerting layer.
+package probes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Probe types
+const (
+	ProbeHTTP = "http"
+	ProbeTCP  = "tcp"
+	ProbeGRPC = "grpc"
+)
+
+// Default probe configuration values
+const (
+	defaultProbeInterval = 30 * time.Second
+	defaultProbeTimeout  = 5 * time.Second
+)
+
+// Common probe errors
+var (
+	ErrInvalidProbeType = errors.New("invalid probe type")
+	ErrProbeFailed      = errors.New("probe failed")
+)
+
+// Metrics collectors
+var (
+	probeLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "monitoring_probe_latency_seconds",
+			Help:    "Latency of synthetic probes by target",
+			Buckets: []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		},
+		[]string{"target", "type"},
+	)
+
+	probeUp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitoring_probe_up",
+			Help: "Whether the last probe of a target succeeded (1) or failed (0)",
+		},
+		[]string{"target"},
+	)
+
+	probeFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitoring_probe_failures_total",
+			Help: "Total synthetic probe failures by target",
+		},
+		[]string{"target", "type"},
+	)
+)
+
+// Alerter receives probe failures; the alert engine implements this
+type Alerter interface {
+	RaiseAlert(name, message string)
+}
+
+// Target describes a single synthetic probe
+type Target struct {
+	Name           string        `json:"name"`
+	Type           string        `json:"type"`
+	Address        string        `json:"address"` // URL for http, host:port for tcp/grpc
+	Interval       time.Duration `json:"interval"`
+	Timeout        time.Duration `json:"timeout"`
+	ExpectedStatus int           `json:"expected_status,omitempty"` // http only
+}
+
+// Prober periodically runs synthetic probes against configured targets
+type Prober struct {
+	targets []Target
+	alerter Alerter
+	client  *http.Client
+	wg      sync.WaitGroup
+}
+
+// NewProber creates a prober over the given targets; alerter may be nil
+func NewProber(targets []Target, alerter Alerter) (*Prober, error) {
+	for i := range targets {
+		switch targets[i].Type {
+		case ProbeHTTP, ProbeTCP, ProbeGRPC:
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrInvalidProbeType, targets[i].Type)
+		}
+		if targets[i].Interval <= 0 {
+			targets[i].Interval = defaultProbeInterval
+		}
+		if targets[i].Timeout <= 0 {
+			targets[i].Timeout = defaultProbeTimeout
+		}
+	}
+
+	prometheus.MustRegister(probeLatency, probeUp, probeFailures)
+
+	return &Prober{
+		targets: targets,
+		alerter: alerter,
+		client:  &http.Client{},
+	}, nil
+}
+
+// LoadTargets reads probe targets from a JSON configuration file
+func LoadTargets(path string) ([]Target, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read probe config: %w", err)
+	}
+
+	var targets []Target
+	if err := json.Unmarshal(data, &targets); err != nil {
+		return nil, fmt.Errorf("failed to parse probe config: %w", err)
+	}
+
+	return targets, nil
+}
+
+// Start launches one probing loop per target until the context is cancelled
+func (p *Prober) Start(ctx context.Context) {
+	for _, target := range p.targets {
+		target := target
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+
+			ticker := time.NewTicker(target.Interval)
+			defer ticker.Stop()
+
+			// Probe immediately so metrics exist before the first tick
+			p.probe(ctx, target)
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					p.probe(ctx, target)
+				}
+			}
+		}()
+	}
+}
+
+// Wait blocks until all probing loops have stopped
+func (p *Prober) Wait() {
+	p.wg.Wait()
+}
+
+// probe runs a single probe and records its outcome
+func (p *Prober) probe(ctx context.Context, target Target) {
+	ctx, cancel := context.WithTimeout(ctx, target.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	var err error
+	switch target.Type {
+	case ProbeHTTP:
+		err = p.probeHTTP(ctx, target)
+	case ProbeTCP:
+		err = p.probeTCP(ctx, target)
+	case ProbeGRPC:
+		err = p.probeGRPC(ctx, target)
+	}
+	latency := time.Since(start).Seconds()
+
+	probeLatency.WithLabelValues(target.Name, target.Type).Observe(latency)
+	if err != nil {
+		probeUp.WithLabelValues(target.Name).Set(0)
+		probeFailures.WithLabelValues(target.Name, target.Type).Inc()
+		if p.alerter != nil {
+			p.alerter.RaiseAlert("probe_failed", fmt.Sprintf("probe %s failed: %v", target.Name, err))
+		}
+		return
+	}
+
+	probeUp.WithLabelValues(target.Name).Set(1)
+}
+
+// probeHTTP performs an HTTP GET and validates the response status
+func (p *Prober) probeHTTP(ctx context.Context, target Target) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.Address, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrProbeFailed, err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrProbeFailed, err)
+	}
+	defer resp.Body.Close()
+
+	expected := target.ExpectedStatus
+	if expected == 0 {
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("%w: unexpected status %d", ErrProbeFailed, resp.StatusCode)
+		}
+		return nil
+	}
+	if resp.StatusCode != expected {
+		return fmt.Errorf("%w: status %d, expected %d", ErrProbeFailed, resp.StatusCode, expected)
+	}
+	return nil
+}
+
+// probeTCP verifies that a TCP connection can be established
+func (p *Prober) probeTCP(ctx context.Context, target Target) error {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", target.Address)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrProbeFailed, err)
+	}
+	return conn.Close()
+}
+
+// probeGRPC performs a standard gRPC health check
+func (p *Prober) probeGRPC(ctx context.Context, target Target) error {
+	conn, err := grpc.DialContext(ctx, target.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrProbeFailed, err)
+	}
+	defer conn.Close()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrProbeFailed, err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("%w: health status %s", ErrProbeFailed, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Package profiling captures periodic CPU and heap profiles and ships
+// them to a Pyroscope-compatible ingest endpoint, with a runtime toggle
+// exposed over the service's HTTP API.
+package profiling
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime/pprof"
+	"sync/atomic"
+	"time"
+)
+
+// Default profiler configuration values
+const (
+	defaultCaptureInterval = time.Minute
+	defaultCPUDuration     = 10 * time.Second
+	defaultShipTimeout     = 10 * time.Second
+)
+
+// Config configures the continuous profiler
+type Config struct {
+	Service     string
+	Endpoint    string
+	Interval    time.Duration
+	CPUDuration time.Duration
+	Enabled     bool
+}
+
+// Profiler periodically captures and ships profiles while enabled
+type Profiler struct {
+	service     string
+	endpoint    string
+	interval    time.Duration
+	cpuDuration time.Duration
+	client      *http.Client
+	enabled     atomic.Bool
+}
+
+// NewProfiler creates a profiler for the given ingest endpoint
+func NewProfiler(cfg Config) *Profiler {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultCaptureInterval
+	}
+	if cfg.CPUDuration <= 0 {
+		cfg.CPUDuration = defaultCPUDuration
+	}
+
+	profiler := &Profiler{
+		service:     cfg.Service,
+		endpoint:    cfg.Endpoint,
+		interval:    cfg.Interval,
+		cpuDuration: cfg.CPUDuration,
+		client:      &http.Client{Timeout: defaultShipTimeout},
+	}
+	profiler.enabled.Store(cfg.Enabled)
+	return profiler
+}
+
+// Start begins the capture loop until the context is canceled
+func (p *Profiler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if p.enabled.Load() {
+					p.capture(ctx)
+				}
+			}
+		}
+	}()
+}
+
+// Handler exposes the runtime toggle: GET reports the current state and
+// PUT {"enabled": bool} switches capture on or off
+func (p *Profiler) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var req struct {
+				Enabled bool `json:"enabled"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request payload", http.StatusBadRequest)
+				return
+			}
+			p.enabled.Store(req.Enabled)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]bool{"enabled": p.enabled.Load()})
+	})
+}
+
+// capture records one CPU and one heap profile and ships them
+func (p *Profiler) capture(ctx context.Context) {
+	from := time.Now()
+
+	var cpu bytes.Buffer
+	if err := pprof.StartCPUProfile(&cpu); err == nil {
+		select {
+		case <-time.After(p.cpuDuration):
+		case <-ctx.Done():
+		}
+		pprof.StopCPUProfile()
+		p.ship(ctx, "cpu", &cpu, from)
+	}
+
+	var heap bytes.Buffer
+	if profile := pprof.Lookup("heap"); profile != nil {
+		if err := profile.WriteTo(&heap, 0); err == nil {
+			p.ship(ctx, "heap", &heap, from)
+		}
+	}
+}
+
+// ship uploads one pprof-format profile to the ingest endpoint
+func (p *Profiler) ship(ctx context.Context, profileType string, body *bytes.Buffer, from time.Time) {
+	url := fmt.Sprintf("%s/ingest?name=%s.%s&format=pprof&from=%d&until=%d",
+		p.endpoint, p.service, profileType, from.Unix(), time.Now().Unix())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,122 @@
+// Package server provides the monitoring service's HTTP API server,
+// mounting the health, metrics, and alerting surfaces on a single listener
+// with graceful shutdown and optional TLS.
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultListenAddress is used when no address is configured
+	defaultListenAddress = ":9096"
+
+	// Default timeouts applied when the config leaves them zero
+	defaultReadTimeout  = 10 * time.Second
+	defaultWriteTimeout = 30 * time.Second
+	defaultIdleTimeout  = 60 * time.Second
+)
+
+// Common server errors
+var (
+	ErrIncompleteTLSConfig = errors.New("both certificate and key files are required for TLS")
+)
+
+// Config holds configuration for the HTTP API server. TLS is enabled when
+// both CertFile and KeyFile are set
+type Config struct {
+	Address      string
+	CertFile     string
+	KeyFile      string
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+}
+
+// Server is the monitoring service's HTTP API server
+type Server struct {
+	config     Config
+	mux        *http.ServeMux
+	httpServer *http.Server
+}
+
+// New creates a new API server with the provided configuration
+func New(config Config) (*Server, error) {
+	if config.Address == "" {
+		config.Address = defaultListenAddress
+	}
+	if config.ReadTimeout <= 0 {
+		config.ReadTimeout = defaultReadTimeout
+	}
+	if config.WriteTimeout <= 0 {
+		config.WriteTimeout = defaultWriteTimeout
+	}
+	if config.IdleTimeout <= 0 {
+		config.IdleTimeout = defaultIdleTimeout
+	}
+
+	if (config.CertFile == "") != (config.KeyFile == "") {
+		return nil, ErrIncompleteTLSConfig
+	}
+
+	mux := http.NewServeMux()
+	httpServer := &http.Server{
+		Addr:         config.Address,
+		Handler:      mux,
+		ReadTimeout:  config.ReadTimeout,
+		WriteTimeout: config.WriteTimeout,
+		IdleTimeout:  config.IdleTimeout,
+	}
+
+	if config.CertFile != "" {
+		httpServer.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &Server{
+		config:     config,
+		mux:        mux,
+		httpServer: httpServer,
+	}, nil
+}
+
+// Handle mounts a handler on the server's mux
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// HandleFunc mounts a handler function on the server's mux
+func (s *Server) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// Start runs the server until it is shut down or fails. It blocks, so
+// callers typically run it in a goroutine
+func (s *Server) Start(ctx context.Context) error {
+	var err error
+	if s.config.CertFile != "" {
+		log.Printf("Starting API server with TLS on %s", s.config.Address)
+		err = s.httpServer.ListenAndServeTLS(s.config.CertFile, s.config.KeyFile)
+	} else {
+		log.Printf("Starting API server on %s", s.config.Address)
+		err = s.httpServer.ListenAndServe()
+	}
+
+	if err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("api server failed: %w", err)
+	}
+	return nil
+}
+
+// Shutdown gracefully stops the server, waiting for in-flight requests
+// up to the context deadline
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
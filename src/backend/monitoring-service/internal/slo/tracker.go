@@ -0,0 +1,271 @@
+// Package slo provides service level objective tracking, computing rolling
+// compliance and error-budget burn rates over recorded events and exposing
+// them as metrics and an HTTP API.
+package slo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SLO kinds
+const (
+	KindAvailability = "availability"
+	KindLatency      = "latency"
+)
+
+// Default tracker configuration values
+const (
+	bucketGranularity     = time.Minute
+	defaultWindow         = 28 * 24 * time.Hour
+	defaultEvalInterval   = time.Minute
+	defaultBurnAlertRatio = 14.4 // page-worthy burn: budget exhausted in ~2 days of a 28-day window
+)
+
+// Common SLO errors
+var (
+	ErrUnknownSLO   = errors.New("unknown SLO")
+	ErrDuplicateSLO = errors.New("SLO already defined")
+)
+
+// Metrics collectors
+var (
+	sloCompliance = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitoring_slo_compliance_ratio",
+			Help: "Rolling compliance ratio per SLO over its window",
+		},
+		[]string{"slo"},
+	)
+
+	sloBurnRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitoring_slo_error_budget_burn_rate",
+			Help: "Error budget burn rate per SLO (1.0 consumes the budget exactly over the window)",
+		},
+		[]string{"slo"},
+	)
+)
+
+// Alerter receives burn-rate alerts; the alert engine implements this
+type Alerter interface {
+	RaiseAlert(name, message string)
+}
+
+// Definition describes a service level objective
+type Definition struct {
+	Name             string        `json:"name"`
+	Kind             string        `json:"kind"`
+	Objective        float64       `json:"objective"` // e.g. 0.999
+	Window           time.Duration `json:"window"`
+	LatencyThreshold time.Duration `json:"latency_threshold,omitempty"` // latency SLOs only
+	BurnAlertRatio   float64       `json:"burn_alert_ratio,omitempty"`
+}
+
+// Status is the computed state of an SLO, served by the /slo API
+type Status struct {
+	Definition      Definition `json:"definition"`
+	Compliance      float64    `json:"compliance"`
+	BurnRate        float64    `json:"burn_rate"`
+	BudgetRemaining float64    `json:"budget_remaining"`
+	TotalEvents     int64      `json:"total_events"`
+}
+
+// bucket aggregates events within one granularity interval
+type bucket struct {
+	start time.Time
+	good  int64
+	total int64
+}
+
+// trackedSLO holds the rolling event window for one SLO
+type trackedSLO struct {
+	definition Definition
+	buckets    []*bucket
+}
+
+// Tracker records request outcomes against defined SLOs and periodically
+// publishes compliance and burn-rate metrics
+type Tracker struct {
+	mutex   sync.RWMutex
+	slos    map[string]*trackedSLO
+	alerter Alerter
+}
+
+// NewTracker creates an empty SLO tracker; alerter may be nil
+func NewTracker(alerter Alerter) *Tracker {
+	prometheus.MustRegister(sloCompliance, sloBurnRate)
+
+	return &Tracker{
+		slos:    make(map[string]*trackedSLO),
+		alerter: alerter,
+	}
+}
+
+// Define registers a new SLO
+func (t *Tracker) Define(definition Definition) error {
+	if definition.Objective <= 0 || definition.Objective >= 1 {
+		return fmt.Errorf("objective must be between 0 and 1, got %f", definition.Objective)
+	}
+	if definition.Window <= 0 {
+		definition.Window = defaultWindow
+	}
+	if definition.BurnAlertRatio <= 0 {
+		definition.BurnAlertRatio = defaultBurnAlertRatio
+	}
+	if definition.Kind == "" {
+		definition.Kind = KindAvailability
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, exists := t.slos[definition.Name]; exists {
+		return fmt.Errorf("%w: %s", ErrDuplicateSLO, definition.Name)
+	}
+
+	t.slos[definition.Name] = &trackedSLO{definition: definition}
+	return nil
+}
+
+// Record registers a request outcome against an SLO. For latency SLOs an
+// event is good when it succeeded within the latency threshold.
+func (t *Tracker) Record(name string, success bool, latency time.Duration) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	tracked, exists := t.slos[name]
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrUnknownSLO, name)
+	}
+
+	good := success
+	if tracked.definition.Kind == KindLatency {
+		good = success && latency <= tracked.definition.LatencyThreshold
+	}
+
+	now := time.Now().UTC()
+	current := t.currentBucket(tracked, now)
+	current.total++
+	if good {
+		current.good++
+	}
+
+	return nil
+}
+
+// currentBucket returns the bucket covering now, creating it and expiring
+// old buckets as needed; callers must hold the lock
+func (t *Tracker) currentBucket(tracked *trackedSLO, now time.Time) *bucket {
+	start := now.Truncate(bucketGranularity)
+
+	if n := len(tracked.buckets); n > 0 && tracked.buckets[n-1].start.Equal(start) {
+		return tracked.buckets[n-1]
+	}
+
+	// Expire buckets that fell out of the rolling window
+	cutoff := now.Add(-tracked.definition.Window)
+	kept := tracked.buckets[:0]
+	for _, b := range tracked.buckets {
+		if b.start.After(cutoff) {
+			kept = append(kept, b)
+		}
+	}
+	tracked.buckets = kept
+
+	current := &bucket{start: start}
+	tracked.buckets = append(tracked.buckets, current)
+	return current
+}
+
+// Start runs the periodic evaluation loop until the context is cancelled
+func (t *Tracker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(defaultEvalInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.evaluate()
+			}
+		}
+	}()
+}
+
+// evaluate recomputes compliance and burn rate for every SLO and raises
+// burn-rate alerts when the configured threshold is exceeded
+func (t *Tracker) evaluate() {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	for name, tracked := range t.slos {
+		status := computeStatus(tracked)
+		sloCompliance.WithLabelValues(name).Set(status.Compliance)
+		sloBurnRate.WithLabelValues(name).Set(status.BurnRate)
+
+		if t.alerter != nil && status.BurnRate >= tracked.definition.BurnAlertRatio {
+			t.alerter.RaiseAlert("slo_burn_rate",
+				fmt.Sprintf("SLO %s burning error budget at %.1fx (compliance %.4f, objective %.4f)",
+					name, status.BurnRate, status.Compliance, tracked.definition.Objective))
+		}
+	}
+}
+
+// computeStatus derives the current status of a tracked SLO; callers must
+// hold at least a read lock
+func computeStatus(tracked *trackedSLO) Status {
+	var good, total int64
+	for _, b := range tracked.buckets {
+		good += b.good
+		total += b.total
+	}
+
+	status := Status{
+		Definition:  tracked.definition,
+		Compliance:  1,
+		TotalEvents: total,
+	}
+	if total == 0 {
+		status.BudgetRemaining = 1
+		return status
+	}
+
+	status.Compliance = float64(good) / float64(total)
+
+	budget := 1 - tracked.definition.Objective
+	errorRate := 1 - status.Compliance
+	status.BurnRate = errorRate / budget
+	status.BudgetRemaining = 1 - status.BurnRate
+	if status.BudgetRemaining < 0 {
+		status.BudgetRemaining = 0
+	}
+
+	return status
+}
+
+// Handler returns the /slo API handler listing the status of every SLO
+func (t *Tracker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.mutex.RLock()
+		statuses := make([]Status, 0, len(t.slos))
+		for _, tracked := range t.slos {
+			statuses = append(statuses, computeStatus(tracked))
+		}
+		t.mutex.RUnlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(statuses); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+// Package snapshots periodically writes metric samples to a long-term
+// time-series store so history survives beyond Prometheus scrape
+// retention, with downsampling policies for aging data.
+package snapshots
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// InfluxStore ships metric samples to an InfluxDB bucket using the v2
+// line protocol write API
+type InfluxStore struct {
+	endpoint string
+	org      string
+	bucket   string
+	token    string
+	client   *http.Client
+}
+
+// NewInfluxStore creates a store writing to the given InfluxDB endpoint
+func NewInfluxStore(endpoint, org, bucket, token string) *InfluxStore {
+	return &InfluxStore{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		org:      org,
+		bucket:   bucket,
+		token:    token,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// WriteSamples posts the batch as line protocol
+func (i *InfluxStore) WriteSamples(ctx context.Context, samples []Sample) error {
+	var body bytes.Buffer
+	for _, sample := range samples {
+		body.WriteString(encodeLine(sample))
+		body.WriteByte('\n')
+	}
+
+	url := fmt.Sprintf("%s/api/v2/write?org=%s&bucket=%s&precision=ns", i.endpoint, i.org, i.bucket)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if i.token != "" {
+		req.Header.Set("Authorization", "Token "+i.token)
+	}
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to write samples to influx: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("influx write returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Downsample is a no-op: InfluxDB handles downsampling through its own
+// retention and task configuration
+func (i *InfluxStore) Downsample(ctx context.Context, policies []DownsamplePolicy) error {
+	return nil
+}
+
+// Close releases the HTTP client's idle connections
+func (i *InfluxStore) Close() error {
+	i.client.CloseIdleConnections()
+	return nil
+}
+
+// encodeLine renders one sample in InfluxDB line protocol
+func encodeLine(sample Sample) string {
+	var line strings.Builder
+	line.WriteString(escapeLineComponent(sample.Name))
+
+	// Tags must be sorted for optimal ingestion
+	tags := make([]string, 0, len(sample.Labels))
+	for name := range sample.Labels {
+		tags = append(tags, name)
+	}
+	sort.Strings(tags)
+
+	for _, name := range tags {
+		line.WriteByte(',')
+		line.WriteString(escapeLineComponent(name))
+		line.WriteByte('=')
+		line.WriteString(escapeLineComponent(sample.Labels[name]))
+	}
+
+	fmt.Fprintf(&line, " value=%g %d", sample.Value, sample.Time.UnixNano())
+	return line.String()
+}
+
+// escapeLineComponent escapes the characters special to line protocol
+func escapeLineComponent(value string) string {
+	replacer := strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,153 @@
+// Package snapshots periodically writes metric samples to a long-term
+// time-series store so history survives beyond Prometheus scrape
+// retention, with downsampling policies for aging data.
+package snapshots
+
+import (
+	"context"
+	"log"
+	"time"
+
+	dto "github.com/prometheus/client_model/go" // v0.4.0
+
+	"src/backend/monitoring-service/internal/collectors"
+)
+
+// Default snapshot configuration values
+const (
+	defaultSnapshotInterval   = time.Minute
+	defaultDownsampleInterval = time.Hour
+)
+
+// Sample is one flattened metric observation written to the store
+type Sample struct {
+	Time   time.Time
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// DownsamplePolicy reduces samples older than Age to one point per
+// Resolution bucket
+type DownsamplePolicy struct {
+	Age        time.Duration
+	Resolution time.Duration
+}
+
+// Store is a long-term time-series backend for metric samples
+type Store interface {
+	WriteSamples(ctx context.Context, samples []Sample) error
+	Downsample(ctx context.Context, policies []DownsamplePolicy) error
+	Close() error
+}
+
+// Config tunes snapshot frequency and retention
+type Config struct {
+	Interval time.Duration
+	Policies []DownsamplePolicy
+}
+
+// Snapshotter periodically flattens the collector's gathered metric
+// families into samples and ships them to the configured store
+type Snapshotter struct {
+	collector *collectors.MetricsCollector
+	store     Store
+	interval  time.Duration
+	policies  []DownsamplePolicy
+}
+
+// NewSnapshotter creates a snapshotter for the given collector and store
+func NewSnapshotter(collector *collectors.MetricsCollector, store Store, cfg Config) *Snapshotter {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultSnapshotInterval
+	}
+
+	return &Snapshotter{
+		collector: collector,
+		store:     store,
+		interval:  cfg.Interval,
+		policies:  cfg.Policies,
+	}
+}
+
+// Start begins periodic snapshotting and downsampling until the context
+// is canceled
+func (s *Snapshotter) Start(ctx context.Context) {
+	go func() {
+		snapshotTicker := time.NewTicker(s.interval)
+		defer snapshotTicker.Stop()
+
+		downsampleTicker := time.NewTicker(defaultDownsampleInterval)
+		defer downsampleTicker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.store.Close()
+				return
+			case <-snapshotTicker.C:
+				s.snapshot(ctx)
+			case <-downsampleTicker.C:
+				if len(s.policies) > 0 {
+					if err := s.store.Downsample(ctx, s.policies); err != nil {
+						log.Printf("Failed to downsample metric snapshots: %v", err)
+					}
+				}
+			}
+		}
+	}()
+}
+
+// snapshot gathers the current samples and writes them to the store
+func (s *Snapshotter) snapshot(ctx context.Context) {
+	families, err := s.collector.CollectMetrics(ctx)
+	if err != nil {
+		log.Printf("Failed to gather metrics for snapshot: %v", err)
+		return
+	}
+
+	samples := flatten(families, time.Now())
+	if len(samples) == 0 {
+		return
+	}
+
+	if err := s.store.WriteSamples(ctx, samples); err != nil {
+		log.Printf("Failed to write metric snapshot: %v", err)
+	}
+}
+
+// flatten converts gathered metric families into storable samples.
+// Histograms and summaries are reduced to their sum and count series
+func flatten(families []*dto.MetricFamily, now time.Time) []Sample {
+	samples := make([]Sample, 0, len(families))
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string, len(metric.GetLabel()))
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				samples = append(samples, Sample{now, family.GetName(), labels, metric.GetCounter().GetValue()})
+			case dto.MetricType_GAUGE:
+				samples = append(samples, Sample{now, family.GetName(), labels, metric.GetGauge().GetValue()})
+			case dto.MetricType_HISTOGRAM:
+				histogram := metric.GetHistogram()
+				samples = append(samples,
+					Sample{now, family.GetName() + "_sum", labels, histogram.GetSampleSum()},
+					Sample{now, family.GetName() + "_count", labels, float64(histogram.GetSampleCount())},
+				)
+			case dto.MetricType_SUMMARY:
+				summary := metric.GetSummary()
+				samples = append(samples,
+					Sample{now, family.GetName() + "_sum", labels, summary.GetSampleSum()},
+					Sample{now, family.GetName() + "_count", labels, float64(summary.GetSampleCount())},
+				)
+			}
+		}
+	}
+
+	return samples
+}
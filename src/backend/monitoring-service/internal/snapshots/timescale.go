@@ -0,0 +1,128 @@
+// Package snapshots periodically writes metric samples to a long-term
+// time-series store so history survives beyond Prometheus scrape
+// retention, with downsampling policies for aging data.
+package snapshots
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	_ "github.com/lib/pq" // v1.10.9
+)
+
+// TimescaleStore persists metric samples in a TimescaleDB hypertable
+type TimescaleStore struct {
+	db *sql.DB
+}
+
+// NewTimescaleStore opens the given DSN and ensures the samples table
+// exists, converting it to a hypertable when the extension is available
+func NewTimescaleStore(dsn string) (*TimescaleStore, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open timescale connection: %w", err)
+	}
+	db.SetMaxOpenConns(2)
+
+	store := &TimescaleStore{db: db}
+	if err := store.ensureSchema(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// ensureSchema creates the samples table and hypertable
+func (t *TimescaleStore) ensureSchema() error {
+	_, err := t.db.Exec(`
+		CREATE TABLE IF NOT EXISTS metric_samples (
+			time   TIMESTAMPTZ NOT NULL,
+			name   TEXT NOT NULL,
+			labels JSONB NOT NULL DEFAULT '{}',
+			value  DOUBLE PRECISION NOT NULL
+		)`)
+	if err != nil {
+		return fmt.Errorf("failed to create samples table: %w", err)
+	}
+
+	// Hypertable conversion fails harmlessly when TimescaleDB is not
+	// installed or the table is already converted
+	t.db.Exec(`SELECT create_hypertable('metric_samples', 'time', if_not_exists => TRUE)`)
+
+	return nil
+}
+
+// WriteSamples inserts a batch of samples in one transaction
+func (t *TimescaleStore) WriteSamples(ctx context.Context, samples []Sample) error {
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin snapshot transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx,
+		`INSERT INTO metric_samples (time, name, labels, value) VALUES ($1, $2, $3, $4)`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare snapshot insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, sample := range samples {
+		labels, err := json.Marshal(sample.Labels)
+		if err != nil {
+			continue
+		}
+		if _, err := stmt.ExecContext(ctx, sample.Time, sample.Name, labels, sample.Value); err != nil {
+			return fmt.Errorf("failed to insert sample: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Downsample replaces samples older than each policy's age with one
+// averaged point per resolution bucket
+func (t *TimescaleStore) Downsample(ctx context.Context, policies []DownsamplePolicy) error {
+	for _, policy := range policies {
+		tx, err := t.db.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin downsample transaction: %w", err)
+		}
+
+		resolution := fmt.Sprintf("%d seconds", int(policy.Resolution.Seconds()))
+		age := fmt.Sprintf("%d seconds", int(policy.Age.Seconds()))
+
+		_, err = tx.ExecContext(ctx, `
+			CREATE TEMP TABLE downsampled ON COMMIT DROP AS
+			SELECT date_bin($1::interval, time, 'epoch'::timestamptz) AS time,
+			       name, labels, avg(value) AS value
+			FROM metric_samples
+			WHERE time < now() - $2::interval
+			GROUP BY 1, 2, 3`, resolution, age)
+		if err == nil {
+			_, err = tx.ExecContext(ctx,
+				`DELETE FROM metric_samples WHERE time < now() - $1::interval`, age)
+		}
+		if err == nil {
+			_, err = tx.ExecContext(ctx,
+				`INSERT INTO metric_samples SELECT time, name, labels, value FROM downsampled`)
+		}
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to downsample samples older than %s: %w", policy.Age, err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit downsample: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Close releases the database connection
+func (t *TimescaleStore) Close() error {
+	return t.db.Close()
+}
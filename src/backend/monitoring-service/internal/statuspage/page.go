@@ -0,0 +1,210 @@
+// Package statuspage renders current component health, recent incidents,
+// and uptime percentages as JSON and a simple embeddable HTML page.
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"sync"
+	"time"
+
+	"src/backend/monitoring-service/internal/health"
+)
+
+// Default status page configuration values
+const (
+	defaultSampleInterval = 30 * time.Second
+	maxIncidents          = 50
+)
+
+// pageTemplate is the embeddable HTML rendering of the status page
+var pageTemplate = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Service Status</title></head>
+<body>
+<h1>Service Status: {{.Status}}</h1>
+<p>Last checked: {{.CheckedAt.Format "2006-01-02 15:04:05 UTC"}}</p>
+<h2>Components</h2>
+<table border="1" cellpadding="4">
+<tr><th>Component</th><th>Status</th><th>Uptime</th></tr>
+{{range .Components}}<tr><td>{{.Name}}</td><td>{{if .Healthy}}operational{{else}}down{{end}}</td><td>{{printf "%.3f%%" .UptimePercent}}</td></tr>
+{{end}}</table>
+<h2>Recent Incidents</h2>
+{{if .Incidents}}<ul>
+{{range .Incidents}}<li><b>{{.Name}}</b> — {{.Message}} ({{.StartedAt.Format "2006-01-02 15:04 UTC"}}{{if not .ResolvedAt.IsZero}}, resolved {{.ResolvedAt.Format "15:04 UTC"}}{{end}})</li>
+{{end}}</ul>{{else}}<p>No recent incidents.</p>{{end}}
+</body>
+</html>
+`))
+
+// Incident is a recorded alert shown on the status page
+type Incident struct {
+	Name       string    `json:"name"`
+	Message    string    `json:"message"`
+	StartedAt  time.Time `json:"started_at"`
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+}
+
+// Component is the rendered state of one dependency
+type Component struct {
+	Name          string  `json:"name"`
+	Healthy       bool    `json:"healthy"`
+	UptimePercent float64 `json:"uptime_percent"`
+}
+
+// Page is the full status page payload
+type Page struct {
+	Status     string      `json:"status"`
+	CheckedAt  time.Time   `json:"checked_at"`
+	Components []Component `json:"components"`
+	Incidents  []Incident  `json:"incidents"`
+}
+
+// uptimeCounter tracks availability samples for one component
+type uptimeCounter struct {
+	total   int64
+	healthy int64
+}
+
+// StatusPage samples the health aggregator, records incidents from the
+// alerting layer, and renders the combined state
+type StatusPage struct {
+	aggregator *health.Aggregator
+	mutex      sync.RWMutex
+	latest     health.SystemStatus
+	uptime     map[string]*uptimeCounter
+	incidents  []Incident
+}
+
+// New creates a status page backed by the given health aggregator
+func New(aggregator *health.Aggregator) *StatusPage {
+	return &StatusPage{
+		aggregator: aggregator,
+		uptime:     make(map[string]*uptimeCounter),
+	}
+}
+
+// Start begins periodic health sampling until the context is cancelled
+func (s *StatusPage) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(defaultSampleInterval)
+		defer ticker.Stop()
+
+		s.sample(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.sample(ctx)
+			}
+		}
+	}()
+}
+
+// sample records one aggregated health check into the uptime counters
+func (s *StatusPage) sample(ctx context.Context) {
+	status := s.aggregator.Check(ctx)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.latest = status
+	for _, dependency := range status.Dependencies {
+		counter, exists := s.uptime[dependency.Name]
+		if !exists {
+			counter = &uptimeCounter{}
+			s.uptime[dependency.Name] = counter
+		}
+		counter.total++
+		if dependency.Healthy {
+			counter.healthy++
+		}
+	}
+}
+
+// RaiseAlert records an incident from the alerting layer; it satisfies the
+// Alerter interfaces used by the probe and SLO subsystems
+func (s *StatusPage) RaiseAlert(name, message string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Coalesce repeats of an ongoing incident
+	for i := range s.incidents {
+		if s.incidents[i].Name == name && s.incidents[i].ResolvedAt.IsZero() {
+			s.incidents[i].Message = message
+			return
+		}
+	}
+
+	s.incidents = append([]Incident{{
+		Name:      name,
+		Message:   message,
+		StartedAt: time.Now().UTC(),
+	}}, s.incidents...)
+	if len(s.incidents) > maxIncidents {
+		s.incidents = s.incidents[:maxIncidents]
+	}
+}
+
+// ResolveAlert marks an ongoing incident as resolved
+func (s *StatusPage) ResolveAlert(name string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i := range s.incidents {
+		if s.incidents[i].Name == name && s.incidents[i].ResolvedAt.IsZero() {
+			s.incidents[i].ResolvedAt = time.Now().UTC()
+			return
+		}
+	}
+}
+
+// render assembles the current page payload
+func (s *StatusPage) render() Page {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	page := Page{
+		Status:     s.latest.Status,
+		CheckedAt:  s.latest.CheckedAt,
+		Components: make([]Component, 0, len(s.latest.Dependencies)),
+		Incidents:  append([]Incident(nil), s.incidents...),
+	}
+
+	for _, dependency := range s.latest.Dependencies {
+		component := Component{
+			Name:          dependency.Name,
+			Healthy:       dependency.Healthy,
+			UptimePercent: 100,
+		}
+		if counter, exists := s.uptime[dependency.Name]; exists && counter.total > 0 {
+			component.UptimePercent = float64(counter.healthy) / float64(counter.total) * 100
+		}
+		page.Components = append(page.Components, component)
+	}
+
+	return page
+}
+
+// JSONHandler serves the status page as JSON
+func (s *StatusPage) JSONHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(s.render()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// HTMLHandler serves the embeddable HTML status page
+func (s *StatusPage) HTMLHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := pageTemplate.Execute(w, s.render()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
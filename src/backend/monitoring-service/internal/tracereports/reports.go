@@ -0,0 +1,288 @@
+// Package tracereports builds per-node-type latency breakdown reports
+// from completed workflow traces so users can see where workflow time
+// goes across many runs.
+package tracereports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Default reporter configuration values
+const (
+	defaultLookback        = time.Hour
+	defaultRefreshInterval = 5 * time.Minute
+	defaultTraceLimit      = 500
+	defaultQueryTimeout    = 15 * time.Second
+
+	// nodeOperationName is the span operation emitted by the workflow
+	// engine for each node execution
+	nodeOperationName = "ExecuteNode"
+)
+
+// Config tunes the trace report builder
+type Config struct {
+	// QueryURL is the base URL of the Jaeger query API
+	QueryURL string
+
+	// Service is the traced service name to query
+	Service string
+
+	Lookback        time.Duration
+	RefreshInterval time.Duration
+}
+
+// NodeTypeBreakdown summarizes latency for one node type
+type NodeTypeBreakdown struct {
+	NodeType     string  `json:"node_type"`
+	Spans        int     `json:"spans"`
+	TotalSeconds float64 `json:"total_seconds"`
+	MeanSeconds  float64 `json:"mean_seconds"`
+	MaxSeconds   float64 `json:"max_seconds"`
+	Share        float64 `json:"share"`
+}
+
+// WorkflowBreakdown is the latency breakdown for one workflow
+type WorkflowBreakdown struct {
+	WorkflowID string              `json:"workflow_id"`
+	NodeTypes  []NodeTypeBreakdown `json:"node_types"`
+}
+
+// Report is a cached latency breakdown across recent traces
+type Report struct {
+	GeneratedAt time.Time           `json:"generated_at"`
+	Lookback    string              `json:"lookback"`
+	Traces      int                 `json:"traces"`
+	Workflows   []WorkflowBreakdown `json:"workflows"`
+}
+
+// spanTag is one key/value tag on a Jaeger span
+type spanTag struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// jaegerSpan mirrors the span fields the reporter consumes
+type jaegerSpan struct {
+	OperationName string    `json:"operationName"`
+	Duration      int64     `json:"duration"` // microseconds
+	Tags          []spanTag `json:"tags"`
+}
+
+// jaegerResponse mirrors the subset of the Jaeger query API response the
+// reporter consumes
+type jaegerResponse struct {
+	Data []struct {
+		Spans []jaegerSpan `json:"spans"`
+	} `json:"data"`
+}
+
+// Reporter periodically queries completed traces and caches the latency
+// breakdown report
+type Reporter struct {
+	queryURL string
+	service  string
+	lookback time.Duration
+	interval time.Duration
+	client   *http.Client
+
+	mutex  sync.RWMutex
+	report *Report
+}
+
+// NewReporter creates a reporter querying the given Jaeger endpoint
+func NewReporter(cfg Config) *Reporter {
+	if cfg.Lookback <= 0 {
+		cfg.Lookback = defaultLookback
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+
+	return &Reporter{
+		queryURL: cfg.QueryURL,
+		service:  cfg.Service,
+		lookback: cfg.Lookback,
+		interval: cfg.RefreshInterval,
+		client:   &http.Client{Timeout: defaultQueryTimeout},
+	}
+}
+
+// Start begins periodic report refresh until the context is canceled
+func (r *Reporter) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		r.refresh(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.refresh(ctx)
+			}
+		}
+	}()
+}
+
+// Handler serves the cached report as JSON
+func (r *Reporter) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mutex.RLock()
+		report := r.report
+		r.mutex.RUnlock()
+
+		if report == nil {
+			http.Error(w, "report not yet available", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(report)
+	})
+}
+
+// refresh queries recent traces and rebuilds the cached report
+func (r *Reporter) refresh(ctx context.Context) {
+	traces, err := r.queryTraces(ctx)
+	if err != nil {
+		return
+	}
+
+	type aggregate struct {
+		spans int
+		total float64
+		max   float64
+	}
+
+	// workflow id -> node type -> aggregate
+	workflows := make(map[string]map[string]*aggregate)
+	for _, trace := range traces.Data {
+		workflowID := "unknown"
+		for _, span := range trace.Spans {
+			if id := tagString(span.Tags, "workflow_id"); id != "" {
+				workflowID = id
+				break
+			}
+		}
+
+		for _, span := range trace.Spans {
+			if span.OperationName != nodeOperationName {
+				continue
+			}
+			nodeType := tagString(span.Tags, "node_type")
+			if nodeType == "" {
+				nodeType = "unknown"
+			}
+
+			nodeTypes, exists := workflows[workflowID]
+			if !exists {
+				nodeTypes = make(map[string]*aggregate)
+				workflows[workflowID] = nodeTypes
+			}
+			agg, exists := nodeTypes[nodeType]
+			if !exists {
+				agg = &aggregate{}
+				nodeTypes[nodeType] = agg
+			}
+
+			seconds := float64(span.Duration) / 1e6
+			agg.spans++
+			agg.total += seconds
+			if seconds > agg.max {
+				agg.max = seconds
+			}
+		}
+	}
+
+	report := &Report{
+		GeneratedAt: time.Now(),
+		Lookback:    r.lookback.String(),
+		Traces:      len(traces.Data),
+	}
+
+	for workflowID, nodeTypes := range workflows {
+		var workflowTotal float64
+		for _, agg := range nodeTypes {
+			workflowTotal += agg.total
+		}
+
+		breakdown := WorkflowBreakdown{WorkflowID: workflowID}
+		for nodeType, agg := range nodeTypes {
+			entry := NodeTypeBreakdown{
+				NodeType:     nodeType,
+				Spans:        agg.spans,
+				TotalSeconds: agg.total,
+				MeanSeconds:  agg.total / float64(agg.spans),
+				MaxSeconds:   agg.max,
+			}
+			if workflowTotal > 0 {
+				entry.Share = agg.total / workflowTotal
+			}
+			breakdown.NodeTypes = append(breakdown.NodeTypes, entry)
+		}
+
+		// Largest consumers first
+		sort.Slice(breakdown.NodeTypes, func(i, j int) bool {
+			return breakdown.NodeTypes[i].TotalSeconds > breakdown.NodeTypes[j].TotalSeconds
+		})
+		report.Workflows = append(report.Workflows, breakdown)
+	}
+
+	sort.Slice(report.Workflows, func(i, j int) bool {
+		return report.Workflows[i].WorkflowID < report.Workflows[j].WorkflowID
+	})
+
+	r.mutex.Lock()
+	r.report = report
+	r.mutex.Unlock()
+}
+
+// queryTraces fetches recent traces from the Jaeger query API
+func (r *Reporter) queryTraces(ctx context.Context) (*jaegerResponse, error) {
+	params := url.Values{}
+	params.Set("service", r.service)
+	params.Set("lookback", r.lookback.String())
+	params.Set("limit", fmt.Sprintf("%d", defaultTraceLimit))
+
+	endpoint := fmt.Sprintf("%s/api/traces?%s", r.queryURL, params.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jaeger query returned status %d", resp.StatusCode)
+	}
+
+	var traces jaegerResponse
+	if err := json.NewDecoder(resp.Body).Decode(&traces); err != nil {
+		return nil, fmt.Errorf("failed to decode jaeger response: %w", err)
+	}
+	return &traces, nil
+}
+
+// tagString returns the string value of a span tag
+func tagString(tags []spanTag, key string) string {
+	for _, tag := range tags {
+		if tag.Key == key {
+			if value, ok := tag.Value.(string); ok {
+				return value
+			}
+		}
+	}
+	return ""
+}
@@ -2,8 +2,10 @@
 package tracers
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"time"
 
@@ -27,6 +29,8 @@ const (
 type TracerOptions struct {
 	ServiceName       string
 	SamplingRate     float64
+	Sampling         *SamplingConfig // overrides SamplingRate when set
+	SamplingConfigPath string        // when set, sampling is hot-reloaded from this file
 	CollectorEndpoint string
 	LogSpans         bool
 	BufferSize       int
@@ -69,6 +73,13 @@ func (o *TracerOptions) WithSamplingRate(rate float64) *TracerOptions {
 	return o
 }
 
+// WithSampling sets a full sampling configuration, enabling per-route
+// overrides and hot reload in place of the fixed sampling rate
+func (o *TracerOptions) WithSampling(sampling SamplingConfig) *TracerOptions {
+	o.Sampling = &sampling
+	return o
+}
+
 // WithBufferSize sets a custom buffer size for the reporter
 func (o *TracerOptions) WithBufferSize(size int) *TracerOptions {
 	if size <= 0 {
@@ -104,10 +115,25 @@ func NewJaegerTracerWithOptions(opts *TracerOptions) (opentracing.Tracer, io.Clo
 	}
 
 	// Initialize tracer
-	tracer, closer, err := cfg.NewTracer(
+	tracerOptions := []config.Option{
 		config.Logger(jaeger.StdLogger),
 		config.Metrics(metricsFactory),
-	)
+	}
+
+	// A full sampling configuration replaces the declarative sampler with
+	// a route-aware, hot-reloadable one
+	if opts.Sampling != nil {
+		sampler, err := NewRouteSampler(*opts.Sampling)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to build sampler: %w", err)
+		}
+		if opts.SamplingConfigPath != "" {
+			sampler.WatchFile(context.Background(), opts.SamplingConfigPath)
+		}
+		tracerOptions = append(tracerOptions, config.Sampler(sampler))
+	}
+
+	tracer, closer, err := cfg.NewTracer(tracerOptions...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to initialize Jaeger tracer: %w", err)
 	}
@@ -124,18 +150,26 @@ func createJaegerConfig(opts *TracerOptions) (*config.Configuration, error) {
 		return nil, errors.New("service name cannot be empty")
 	}
 
+	samplerType := jaeger.SamplerTypeConst
+	samplerParam := opts.SamplingRate
+	if opts.Sampling != nil {
+		// The declarative sampler is replaced by the RouteSampler at
+		// tracer construction; keep the config in sync for visibility
+		samplerType = opts.Sampling.Type
+		samplerParam = opts.Sampling.Param
+	}
+
 	cfg := &config.Configuration{
 		ServiceName: opts.ServiceName,
 		Sampler: &config.SamplerConfig{
-			Type:  jaeger.SamplerTypeConst,
-			Param: opts.SamplingRate,
+			Type:  samplerType,
+			Param: samplerParam,
 		},
 		Reporter: &config.ReporterConfig{
 			LogSpans:            opts.LogSpans,
 			BufferFlushInterval: opts.FlushInterval,
 			LocalAgentHostPort:  opts.CollectorEndpoint,
 			QueueSize:           opts.QueueSize,
-			BufferSize:          opts.BufferSize,
 		},
 		Tags: []opentracing.Tag{
 			{Key: "service.version", Value: "1.0.0"},
@@ -143,10 +177,5 @@ func createJaegerConfig(opts *TracerOptions) (*config.Configuration, error) {
 		},
 	}
 
-	// Validate configuration
-	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid Jaeger configuration: %w", err)
-	}
-
 	return cfg, nil
 }
\ No newline at end of file
@@ -0,0 +1,194 @@
+// Package tracers provides distributed tracing functionality using Jaeger
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/uber/jaeger-client-go"
+)
+
+// Supported sampler types
+const (
+	SamplerConst        = "const"
+	SamplerProbabilistic = "probabilistic"
+	SamplerRateLimiting  = "ratelimiting"
+	SamplerAdaptive      = "adaptive"
+)
+
+// Default sampling configuration values
+const (
+	defaultReloadInterval  = 30 * time.Second
+	defaultMaxOperations   = 200
+)
+
+// SamplingConfig describes the sampling strategy, including per-route
+// overrides (e.g. always sample /execute, rarely sample /health). It is
+// hot-reloadable from a JSON file.
+type SamplingConfig struct {
+	Type           string             `json:"type"`  // const, probabilistic, ratelimiting, adaptive
+	Param          float64            `json:"param"` // rate or probability depending on type
+	RouteOverrides map[string]float64 `json:"route_overrides,omitempty"`
+}
+
+// RouteSampler is a jaeger.Sampler with per-operation probability
+// overrides on top of a configurable default strategy. Its configuration
+// can be swapped at runtime without recreating the tracer.
+type RouteSampler struct {
+	mutex     sync.RWMutex
+	config    SamplingConfig
+	fallback  jaeger.Sampler
+	overrides map[string]jaeger.Sampler
+}
+
+// NewRouteSampler builds a sampler from the given configuration
+func NewRouteSampler(config SamplingConfig) (*RouteSampler, error) {
+	sampler := &RouteSampler{}
+	if err := sampler.Reload(config); err != nil {
+		return nil, err
+	}
+	return sampler, nil
+}
+
+// Reload atomically swaps in a new sampling configuration
+func (s *RouteSampler) Reload(config SamplingConfig) error {
+	fallback, err := buildSampler(config.Type, config.Param)
+	if err != nil {
+		return err
+	}
+
+	overrides := make(map[string]jaeger.Sampler, len(config.RouteOverrides))
+	for route, probability := range config.RouteOverrides {
+		override, err := jaeger.NewProbabilisticSampler(probability)
+		if err != nil {
+			return fmt.Errorf("invalid override for route %s: %w", route, err)
+		}
+		overrides[route] = override
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.fallback != nil {
+		s.fallback.Close()
+	}
+	for _, old := range s.overrides {
+		old.Close()
+	}
+
+	s.config = config
+	s.fallback = fallback
+	s.overrides = overrides
+	return nil
+}
+
+// IsSampled implements jaeger.Sampler, consulting the per-route override
+// before the default strategy
+func (s *RouteSampler) IsSampled(id jaeger.TraceID, operation string) (bool, []jaeger.Tag) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if override, exists := s.overrides[operation]; exists {
+		return override.IsSampled(id, operation)
+	}
+	return s.fallback.IsSampled(id, operation)
+}
+
+// Close implements jaeger.Sampler
+func (s *RouteSampler) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.fallback != nil {
+		s.fallback.Close()
+	}
+	for _, override := range s.overrides {
+		override.Close()
+	}
+}
+
+// Equal implements jaeger.Sampler
+func (s *RouteSampler) Equal(other jaeger.Sampler) bool {
+	otherSampler, ok := other.(*RouteSampler)
+	if !ok {
+		return false
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.config.Type == otherSampler.config.Type && s.config.Param == otherSampler.config.Param
+}
+
+// WatchFile reloads the sampling configuration whenever the file changes,
+// until the context is cancelled
+func (s *RouteSampler) WatchFile(ctx context.Context, path string) {
+	go func() {
+		ticker := time.NewTicker(defaultReloadInterval)
+		defer ticker.Stop()
+
+		var lastModified time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				info, err := os.Stat(path)
+				if err != nil || !info.ModTime().After(lastModified) {
+					continue
+				}
+
+				config, err := LoadSamplingConfig(path)
+				if err != nil {
+					log.Printf("Warning: failed to load sampling config from %s: %v", path, err)
+					continue
+				}
+				if err := s.Reload(config); err != nil {
+					log.Printf("Warning: failed to apply sampling config: %v", err)
+					continue
+				}
+
+				lastModified = info.ModTime()
+				log.Printf("Reloaded sampling configuration from %s", path)
+			}
+		}
+	}()
+}
+
+// LoadSamplingConfig reads a sampling configuration from a JSON file
+func LoadSamplingConfig(path string) (SamplingConfig, error) {
+	var config SamplingConfig
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config, fmt.Errorf("failed to read sampling config: %w", err)
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse sampling config: %w", err)
+	}
+
+	return config, nil
+}
+
+// buildSampler constructs the default sampling strategy for a config
+func buildSampler(samplerType string, param float64) (jaeger.Sampler, error) {
+	switch samplerType {
+	case SamplerConst, "":
+		return jaeger.NewConstSampler(param >= 1), nil
+	case SamplerProbabilistic:
+		return jaeger.NewProbabilisticSampler(param)
+	case SamplerRateLimiting:
+		return jaeger.NewRateLimitingSampler(param), nil
+	case SamplerAdaptive:
+		// Guaranteed-throughput sampling: at least one trace per second
+		// per operation, probabilistic beyond that
+		return jaeger.NewGuaranteedThroughputProbabilisticSampler(1, param)
+	default:
+		return nil, fmt.Errorf("unsupported sampler type: %s", samplerType)
+	}
+}
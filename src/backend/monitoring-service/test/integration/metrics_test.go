@@ -14,10 +14,9 @@ import (
     "time"
 
     "github.com/prometheus/client_golang/prometheus"
-    "github.com/prometheus/client_golang/prometheus/promhttp"
 
-    "../../internal/collectors"
-    "../../internal/exporters"
+    "src/backend/monitoring-service/internal/collectors"
+    "src/backend/monitoring-service/internal/exporters"
 )
 
 const (
@@ -67,7 +66,7 @@ func TestMetricsCollection(t *testing.T) {
         wg.Wait()
 
         // Verify counter value
-        if err := mc.CollectMetrics(ctx); err != nil {
+        if _, err := mc.CollectMetrics(ctx); err != nil {
             t.Fatalf("Failed to collect metrics: %v", err)
         }
     })
@@ -91,7 +90,7 @@ func TestMetricsCollection(t *testing.T) {
         gauge.Inc()
         gauge.Dec()
 
-        if err := mc.CollectMetrics(ctx); err != nil {
+        if _, err := mc.CollectMetrics(ctx); err != nil {
             t.Fatalf("Failed to collect metrics: %v", err)
         }
     })
@@ -116,7 +115,7 @@ func TestMetricsCollection(t *testing.T) {
             histogram.Observe(float64(i) / 1000)
         }
 
-        if err := mc.CollectMetrics(ctx); err != nil {
+        if _, err := mc.CollectMetrics(ctx); err != nil {
             t.Fatalf("Failed to collect metrics: %v", err)
         }
     })
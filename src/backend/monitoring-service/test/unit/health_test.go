@@ -11,6 +11,8 @@ import (
     "testing"
     "time"
 
+    dto "github.com/prometheus/client_model/go"
+
     "src/backend/monitoring-service/internal/handlers"
     "src/backend/monitoring-service/internal/collectors"
 )
@@ -40,8 +42,9 @@ func newMockMetricsCollector() *mockMetricsCollector {
     }
 }
 
-// CollectMetrics implements the MetricsCollector interface with configurable behavior
-func (m *mockMetricsCollector) CollectMetrics(ctx context.Context) error {
+// CollectMetrics implements the handlers.MetricsSource interface with
+// configurable behavior
+func (m *mockMetricsCollector) CollectMetrics(ctx context.Context) ([]*dto.MetricFamily, error) {
     m.lock.Lock()
     defer m.lock.Unlock()
 
@@ -50,14 +53,14 @@ func (m *mockMetricsCollector) CollectMetrics(ctx context.Context) error {
         select {
         case <-time.After(m.delay):
         case <-ctx.Done():
-            return ctx.Err()
+            return nil, ctx.Err()
         }
     }
 
     if m.shouldFail {
-        return &collectors.MetricError{Message: "metrics collection failed"}
+        return nil, &collectors.MetricError{Message: "metrics collection failed"}
     }
-    return nil
+    return []*dto.MetricFamily{}, nil
 }
 
 // SetDelay configures artificial delay for timeout testing
@@ -106,7 +109,7 @@ func TestNewHealthHandler(t *testing.T) {
                 }
             }()
 
-            var collector *mockMetricsCollector
+            var collector handlers.MetricsSource
             if !tt.expectPanic {
                 collector = newMockMetricsCollector()
             }
@@ -287,7 +290,7 @@ func TestHandleReadinessTimeout(t *testing.T) {
         t.Errorf("Expected status DOWN, got %s", response.Status)
     }
 
-    if !response.Checks["timeout"] {
+    if passed, reported := response.Checks["timeout"]; !reported || passed {
         t.Error("Expected timeout check to be false")
     }
 }
\ No newline at end of file
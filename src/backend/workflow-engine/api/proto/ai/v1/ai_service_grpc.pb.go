@@ -0,0 +1,227 @@
+// AI service contract used by the workflow engine's ai_task nodes.
+// The engine is the only client; the AI service implements this
+// interface. Regenerate the Go bindings with:
+//
+//   protoc --go_out=. --go-grpc_out=. api/proto/ai/v1/ai_service.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: ai/v1/ai_service.proto
+
+package aipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AIService_ExecuteTask_FullMethodName  = "/ai.v1.AIService/ExecuteTask"
+	AIService_StreamTask_FullMethodName   = "/ai.v1.AIService/StreamTask"
+	AIService_ExecuteBatch_FullMethodName = "/ai.v1.AIService/ExecuteBatch"
+)
+
+// AIServiceClient is the client API for AIService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AIServiceClient interface {
+	// ExecuteTask runs one task to completion and returns the full result
+	ExecuteTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	// StreamTask runs one task and streams partial output as it is
+	// generated; the final chunk carries the token usage
+	StreamTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (AIService_StreamTaskClient, error)
+	// ExecuteBatch runs many small tasks in one call; responses are
+	// returned in request order, one per request
+	ExecuteBatch(ctx context.Context, in *BatchTaskRequest, opts ...grpc.CallOption) (*BatchTaskResponse, error)
+}
+
+type aIServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAIServiceClient(cc grpc.ClientConnInterface) AIServiceClient {
+	return &aIServiceClient{cc}
+}
+
+func (c *aIServiceClient) ExecuteTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, AIService_ExecuteTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) StreamTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (AIService_StreamTaskClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AIService_ServiceDesc.Streams[0], AIService_StreamTask_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aIServiceStreamTaskClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AIService_StreamTaskClient interface {
+	Recv() (*TaskChunk, error)
+	grpc.ClientStream
+}
+
+type aIServiceStreamTaskClient struct {
+	grpc.ClientStream
+}
+
+func (x *aIServiceStreamTaskClient) Recv() (*TaskChunk, error) {
+	m := new(TaskChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aIServiceClient) ExecuteBatch(ctx context.Context, in *BatchTaskRequest, opts ...grpc.CallOption) (*BatchTaskResponse, error) {
+	out := new(BatchTaskResponse)
+	err := c.cc.Invoke(ctx, AIService_ExecuteBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AIServiceServer is the server API for AIService service.
+// All implementations must embed UnimplementedAIServiceServer
+// for forward compatibility
+type AIServiceServer interface {
+	// ExecuteTask runs one task to completion and returns the full result
+	ExecuteTask(context.Context, *TaskRequest) (*TaskResponse, error)
+	// StreamTask runs one task and streams partial output as it is
+	// generated; the final chunk carries the token usage
+	StreamTask(*TaskRequest, AIService_StreamTaskServer) error
+	// ExecuteBatch runs many small tasks in one call; responses are
+	// returned in request order, one per request
+	ExecuteBatch(context.Context, *BatchTaskRequest) (*BatchTaskResponse, error)
+	mustEmbedUnimplementedAIServiceServer()
+}
+
+// UnimplementedAIServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAIServiceServer struct {
+}
+
+func (UnimplementedAIServiceServer) ExecuteTask(context.Context, *TaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteTask not implemented")
+}
+func (UnimplementedAIServiceServer) StreamTask(*TaskRequest, AIService_StreamTaskServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTask not implemented")
+}
+func (UnimplementedAIServiceServer) ExecuteBatch(context.Context, *BatchTaskRequest) (*BatchTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBatch not implemented")
+}
+func (UnimplementedAIServiceServer) mustEmbedUnimplementedAIServiceServer() {}
+
+// UnsafeAIServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AIServiceServer will
+// result in compilation errors.
+type UnsafeAIServiceServer interface {
+	mustEmbedUnimplementedAIServiceServer()
+}
+
+func RegisterAIServiceServer(s grpc.ServiceRegistrar, srv AIServiceServer) {
+	s.RegisterService(&AIService_ServiceDesc, srv)
+}
+
+func _AIService_ExecuteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ExecuteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ExecuteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ExecuteTask(ctx, req.(*TaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_StreamTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AIServiceServer).StreamTask(m, &aIServiceStreamTaskServer{stream})
+}
+
+type AIService_StreamTaskServer interface {
+	Send(*TaskChunk) error
+	grpc.ServerStream
+}
+
+type aIServiceStreamTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *aIServiceStreamTaskServer) Send(m *TaskChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AIService_ExecuteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ExecuteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ExecuteBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ExecuteBatch(ctx, req.(*BatchTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AIService_ServiceDesc is the grpc.ServiceDesc for AIService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AIService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai.v1.AIService",
+	HandlerType: (*AIServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecuteTask",
+			Handler:    _AIService_ExecuteTask_Handler,
+		},
+		{
+			MethodName: "ExecuteBatch",
+			Handler:    _AIService_ExecuteBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTask",
+			Handler:       _AIService_StreamTask_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ai/v1/ai_service.proto",
+}
@@ -0,0 +1,324 @@
+// Package main provides a synthetic load generator for the workflow
+// engine. It creates workflows of configurable shapes against a running
+// instance, drives executions at a configurable concurrency, and reports
+// p50/p95/p99 latencies and throughput per phase
+package main
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "flag"
+    "fmt"
+    "math/rand"
+    "net/http"
+    "os"
+    "os/signal"
+    "sort"
+    "sync"
+    "syscall"
+    "time"
+)
+
+// shapes supported by -shape. "mixed" picks one at random per workflow
+var supportedShapes = []string{"linear", "fanout", "diamond", "mixed"}
+
+// options holds the parsed command-line configuration
+type options struct {
+    baseURL     string
+    token       string
+    concurrency int
+    workflows   int
+    duration    time.Duration
+    shape       string
+    nodes       int
+    timeout     time.Duration
+}
+
+// result records the outcome of one HTTP request
+type result struct {
+    phase    string
+    duration time.Duration
+    err      error
+}
+
+// recorder accumulates request results across workers
+type recorder struct {
+    mu      sync.Mutex
+    results []result
+    start   time.Time
+}
+
+func (r *recorder) record(phase string, duration time.Duration, err error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.results = append(r.results, result{phase: phase, duration: duration, err: err})
+}
+
+// client wraps the engine's HTTP API for load generation
+type client struct {
+    baseURL string
+    token   string
+    http    *http.Client
+}
+
+func (c *client) post(ctx context.Context, path string, payload interface{}, out interface{}) (time.Duration, error) {
+    body, err := json.Marshal(payload)
+    if err != nil {
+        return 0, err
+    }
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(body))
+    if err != nil {
+        return 0, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    if c.token != "" {
+        req.Header.Set("Authorization", "Bearer "+c.token)
+    }
+
+    start := time.Now()
+    resp, err := c.http.Do(req)
+    elapsed := time.Since(start)
+    if err != nil {
+        return elapsed, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode >= 400 {
+        return elapsed, fmt.Errorf("%s %s: unexpected status %d", http.MethodPost, path, resp.StatusCode)
+    }
+    if out != nil {
+        if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+            return elapsed, fmt.Errorf("%s %s: decoding response: %w", http.MethodPost, path, err)
+        }
+    }
+    return elapsed, nil
+}
+
+// createdWorkflow captures the fields loadgen needs from create responses
+type createdWorkflow struct {
+    ID string `json:"id"`
+}
+
+// createdNode captures the fields loadgen needs from node create responses
+type createdNode struct {
+    ID string `json:"id"`
+}
+
+// buildWorkflow creates one workflow with the requested shape and returns
+// its ID, recording each request against the "setup" phase
+func buildWorkflow(ctx context.Context, c *client, rec *recorder, shape string, nodeCount int, index int) (string, error) {
+    var workflow createdWorkflow
+    elapsed, err := c.post(ctx, "/api/v1/workflows", map[string]interface{}{
+        "name":        fmt.Sprintf("loadgen-%s-%d", shape, index),
+        "description": fmt.Sprintf("synthetic %s workflow generated by loadgen", shape),
+        "metadata":    map[string]interface{}{"loadgen": true},
+    }, &workflow)
+    rec.record("setup", elapsed, err)
+    if err != nil {
+        return "", err
+    }
+
+    nodeIDs := make([]string, 0, nodeCount)
+    for i := 0; i < nodeCount; i++ {
+        var node createdNode
+        elapsed, err := c.post(ctx, "/api/v1/workflows/"+workflow.ID+"/nodes", map[string]interface{}{
+            "type": "transform",
+            "name": fmt.Sprintf("node-%d", i),
+            "config": map[string]interface{}{
+                "operation": "passthrough",
+            },
+            "position_x": i * 100,
+            "position_y": 0,
+        }, &node)
+        rec.record("setup", elapsed, err)
+        if err != nil {
+            return "", err
+        }
+        nodeIDs = append(nodeIDs, node.ID)
+    }
+
+    for _, pair := range connectionsFor(shape, nodeIDs) {
+        elapsed, err := c.post(ctx, "/api/v1/workflows/"+workflow.ID+"/connections", map[string]interface{}{
+            "source_node_id": pair[0],
+            "target_node_id": pair[1],
+        }, nil)
+        rec.record("setup", elapsed, err)
+        if err != nil {
+            return "", err
+        }
+    }
+
+    return workflow.ID, nil
+}
+
+// connectionsFor returns source/target node ID pairs forming the shape
+func connectionsFor(shape string, nodeIDs []string) [][2]string {
+    if len(nodeIDs) < 2 {
+        return nil
+    }
+
+    connections := make([][2]string, 0, len(nodeIDs))
+    switch shape {
+    case "fanout":
+        // First node fans out to all others
+        for _, target := range nodeIDs[1:] {
+            connections = append(connections, [2]string{nodeIDs[0], target})
+        }
+    case "diamond":
+        // First node fans out to the middle nodes, which all converge on
+        // the last node
+        last := nodeIDs[len(nodeIDs)-1]
+        for _, middle := range nodeIDs[1 : len(nodeIDs)-1] {
+            connections = append(connections, [2]string{nodeIDs[0], middle})
+            connections = append(connections, [2]string{middle, last})
+        }
+        if len(nodeIDs) == 2 {
+            connections = append(connections, [2]string{nodeIDs[0], last})
+        }
+    default:
+        // Linear chain
+        for i := 0; i < len(nodeIDs)-1; i++ {
+            connections = append(connections, [2]string{nodeIDs[i], nodeIDs[i+1]})
+        }
+    }
+    return connections
+}
+
+// executeLoop repeatedly triggers executions of the given workflows until
+// the context is canceled
+func executeLoop(ctx context.Context, c *client, rec *recorder, workflowIDs []string, rng *rand.Rand) {
+    for {
+        if ctx.Err() != nil {
+            return
+        }
+
+        workflowID := workflowIDs[rng.Intn(len(workflowIDs))]
+        elapsed, err := c.post(ctx, "/api/v1/workflows/"+workflowID+"/execute", map[string]interface{}{
+            "options": map[string]interface{}{"loadgen": true},
+        }, nil)
+        if ctx.Err() != nil {
+            return
+        }
+        rec.record("execute", elapsed, err)
+    }
+}
+
+// percentile returns the pth percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+    if len(sorted) == 0 {
+        return 0
+    }
+    index := int(float64(len(sorted)-1) * p)
+    return sorted[index]
+}
+
+// report prints a latency and throughput summary for each phase
+func report(rec *recorder, elapsed time.Duration) {
+    byPhase := make(map[string][]time.Duration)
+    errorsByPhase := make(map[string]int)
+    for _, r := range rec.results {
+        if r.err != nil {
+            errorsByPhase[r.phase]++
+            continue
+        }
+        byPhase[r.phase] = append(byPhase[r.phase], r.duration)
+    }
+
+    phases := []string{"setup", "execute"}
+    for _, phase := range phases {
+        durations := byPhase[phase]
+        sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+        fmt.Printf("\n%s:\n", phase)
+        fmt.Printf("  requests:   %d ok, %d failed\n", len(durations), errorsByPhase[phase])
+        if len(durations) == 0 {
+            continue
+        }
+        fmt.Printf("  p50:        %v\n", percentile(durations, 0.50))
+        fmt.Printf("  p95:        %v\n", percentile(durations, 0.95))
+        fmt.Printf("  p99:        %v\n", percentile(durations, 0.99))
+        fmt.Printf("  max:        %v\n", durations[len(durations)-1])
+        if phase == "execute" && elapsed > 0 {
+            fmt.Printf("  throughput: %.1f req/s\n", float64(len(durations))/elapsed.Seconds())
+        }
+    }
+}
+
+func main() {
+    opts := options{}
+    flag.StringVar(&opts.baseURL, "base-url", "http://localhost:8080", "base URL of the workflow engine")
+    flag.StringVar(&opts.token, "token", "", "bearer token sent with every request")
+    flag.IntVar(&opts.concurrency, "concurrency", 10, "number of concurrent execution workers")
+    flag.IntVar(&opts.workflows, "workflows", 5, "number of synthetic workflows to create")
+    flag.DurationVar(&opts.duration, "duration", 30*time.Second, "how long to drive executions")
+    flag.StringVar(&opts.shape, "shape", "mixed", "workflow shape: linear, fanout, diamond, or mixed")
+    flag.IntVar(&opts.nodes, "nodes", 5, "number of nodes per workflow")
+    flag.DurationVar(&opts.timeout, "timeout", 30*time.Second, "per-request timeout")
+    flag.Parse()
+
+    validShape := false
+    for _, shape := range supportedShapes {
+        if opts.shape == shape {
+            validShape = true
+            break
+        }
+    }
+    if !validShape {
+        fmt.Fprintf(os.Stderr, "unknown shape %q, expected one of %v\n", opts.shape, supportedShapes)
+        os.Exit(2)
+    }
+    if opts.concurrency < 1 || opts.workflows < 1 || opts.nodes < 2 {
+        fmt.Fprintln(os.Stderr, "concurrency and workflows must be >= 1, nodes must be >= 2")
+        os.Exit(2)
+    }
+
+    c := &client{
+        baseURL: opts.baseURL,
+        token:   opts.token,
+        http:    &http.Client{Timeout: opts.timeout},
+    }
+    rec := &recorder{start: time.Now()}
+    rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+    // Stop on SIGINT/SIGTERM so partial runs still produce a report
+    ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+    defer cancel()
+
+    fmt.Printf("creating %d %s workflow(s) with %d nodes against %s\n",
+        opts.workflows, opts.shape, opts.nodes, opts.baseURL)
+
+    workflowIDs := make([]string, 0, opts.workflows)
+    for i := 0; i < opts.workflows; i++ {
+        shape := opts.shape
+        if shape == "mixed" {
+            shape = supportedShapes[rng.Intn(3)]
+        }
+        workflowID, err := buildWorkflow(ctx, c, rec, shape, opts.nodes, i)
+        if err != nil {
+            fmt.Fprintf(os.Stderr, "failed to build workflow %d: %v\n", i, err)
+            os.Exit(1)
+        }
+        workflowIDs = append(workflowIDs, workflowID)
+    }
+
+    fmt.Printf("driving executions with %d worker(s) for %v\n", opts.concurrency, opts.duration)
+
+    runCtx, cancelRun := context.WithTimeout(ctx, opts.duration)
+    defer cancelRun()
+
+    executeStart := time.Now()
+    var wg sync.WaitGroup
+    for i := 0; i < opts.concurrency; i++ {
+        wg.Add(1)
+        workerRng := rand.New(rand.NewSource(rng.Int63()))
+        go func() {
+            defer wg.Done()
+            executeLoop(runCtx, c, rec, workflowIDs, workerRng)
+        }()
+    }
+    wg.Wait()
+
+    report(rec, time.Since(executeStart))
+}
@@ -0,0 +1,443 @@
+// Package main provides a lightweight controller that reconciles
+// Workflow custom resources into engine workflows through the engine's
+// HTTP API, enabling GitOps management of workflow definitions. It polls
+// the Kubernetes API directly over REST using the pod's service account,
+// avoiding a client-go dependency, and records validation and schedule
+// state as status conditions on each resource
+package main
+
+import (
+    "bytes"
+    "context"
+    "crypto/tls"
+    "crypto/x509"
+    "encoding/json"
+    "errors"
+    "flag"
+    "fmt"
+    "io"
+    "log"
+    "net/http"
+    "os"
+    "os/signal"
+    "strings"
+    "syscall"
+    "time"
+)
+
+// CRD coordinates the controller reconciles
+const (
+    crdGroup   = "workflows.engine.io"
+    crdVersion = "v1alpha1"
+    crdPlural  = "workflows"
+)
+
+// Default in-cluster service account paths
+const (
+    serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+    serviceAccountCAPath    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+    namespacePath           = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// Condition types recorded on Workflow resources
+const (
+    conditionValidated = "Validated"
+    conditionScheduled = "Scheduled"
+)
+
+// options holds the parsed command-line configuration
+type options struct {
+    kubeURL   string
+    engineURL string
+    namespace string
+    interval  time.Duration
+    timeout   time.Duration
+}
+
+// objectMeta is the subset of Kubernetes object metadata the controller
+// needs
+type objectMeta struct {
+    Name            string `json:"name"`
+    Namespace       string `json:"namespace"`
+    ResourceVersion string `json:"resourceVersion"`
+    Generation      int64  `json:"generation"`
+}
+
+// workflowSpec is the desired workflow definition carried by the custom
+// resource. Nodes and connections pass through to the engine untouched
+// so the CRD schema does not have to track the engine's node vocabulary
+type workflowSpec struct {
+    Name        string                   `json:"name"`
+    Description string                   `json:"description,omitempty"`
+    Nodes       []map[string]interface{} `json:"nodes,omitempty"`
+    Connections []map[string]interface{} `json:"connections,omitempty"`
+    Metadata    map[string]interface{}   `json:"metadata,omitempty"`
+}
+
+// condition is one entry in the resource's status conditions
+type condition struct {
+    Type               string `json:"type"`
+    Status             string `json:"status"`
+    Reason             string `json:"reason,omitempty"`
+    Message            string `json:"message,omitempty"`
+    LastTransitionTime string `json:"lastTransitionTime"`
+}
+
+// workflowStatus reflects the engine's view of the resource back onto it
+type workflowStatus struct {
+    WorkflowID         string      `json:"workflowId,omitempty"`
+    ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+    Conditions         []condition `json:"conditions,omitempty"`
+}
+
+// workflowResource is one Workflow custom resource
+type workflowResource struct {
+    APIVersion string         `json:"apiVersion"`
+    Kind       string         `json:"kind"`
+    Metadata   objectMeta     `json:"metadata"`
+    Spec       workflowSpec   `json:"spec"`
+    Status     workflowStatus `json:"status,omitempty"`
+}
+
+// workflowResourceList is the list envelope returned by the API server
+type workflowResourceList struct {
+    Items []workflowResource `json:"items"`
+}
+
+// kubeClient is a minimal REST client for the custom resource endpoints
+type kubeClient struct {
+    baseURL string
+    token   string
+    http    *http.Client
+}
+
+// newKubeClient builds a client from the in-cluster service account,
+// falling back to an insecure connection when no CA bundle is mounted
+// (e.g. when running against a local API proxy)
+func newKubeClient(baseURL string, timeout time.Duration) (*kubeClient, error) {
+    client := &kubeClient{
+        baseURL: strings.TrimRight(baseURL, "/"),
+        http:    &http.Client{Timeout: timeout},
+    }
+
+    if token, err := os.ReadFile(serviceAccountTokenPath); err == nil {
+        client.token = strings.TrimSpace(string(token))
+    }
+
+    if caCert, err := os.ReadFile(serviceAccountCAPath); err == nil {
+        pool := x509.NewCertPool()
+        if !pool.AppendCertsFromPEM(caCert) {
+            return nil, fmt.Errorf("failed to parse service account CA bundle")
+        }
+        client.http.Transport = &http.Transport{
+            TLSClientConfig: &tls.Config{RootCAs: pool},
+        }
+    }
+
+    return client, nil
+}
+
+func (k *kubeClient) do(ctx context.Context, method, path string, payload interface{}, out interface{}) error {
+    var body io.Reader
+    if payload != nil {
+        encoded, err := json.Marshal(payload)
+        if err != nil {
+            return err
+        }
+        body = bytes.NewReader(encoded)
+    }
+
+    req, err := http.NewRequestWithContext(ctx, method, k.baseURL+path, body)
+    if err != nil {
+        return err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    if k.token != "" {
+        req.Header.Set("Authorization", "Bearer "+k.token)
+    }
+
+    resp, err := k.http.Do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode >= 300 {
+        detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+        return fmt.Errorf("%s %s: status %d: %s", method, path, resp.StatusCode, string(detail))
+    }
+    if out != nil {
+        return json.NewDecoder(resp.Body).Decode(out)
+    }
+    return nil
+}
+
+// listWorkflows fetches every Workflow resource in the namespace
+func (k *kubeClient) listWorkflows(ctx context.Context, namespace string) ([]workflowResource, error) {
+    path := fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s", crdGroup, crdVersion, namespace, crdPlural)
+    var list workflowResourceList
+    if err := k.do(ctx, http.MethodGet, path, nil, &list); err != nil {
+        return nil, err
+    }
+    return list.Items, nil
+}
+
+// updateStatus writes the resource's status subresource
+func (k *kubeClient) updateStatus(ctx context.Context, resource *workflowResource) error {
+    path := fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s/%s/status",
+        crdGroup, crdVersion, resource.Metadata.Namespace, crdPlural, resource.Metadata.Name)
+    return k.do(ctx, http.MethodPut, path, resource, nil)
+}
+
+// engineClient wraps the engine's workflow API
+type engineClient struct {
+    baseURL string
+    http    *http.Client
+}
+
+// apiError carries the engine's response body so validation failures can
+// be surfaced in status conditions verbatim
+type apiError struct {
+    status int
+    body   string
+}
+
+func (e *apiError) Error() string {
+    return fmt.Sprintf("status %d: %s", e.status, e.body)
+}
+
+func (e *engineClient) do(ctx context.Context, method, path string, payload interface{}, out interface{}) error {
+    var body io.Reader
+    if payload != nil {
+        encoded, err := json.Marshal(payload)
+        if err != nil {
+            return err
+        }
+        body = bytes.NewReader(encoded)
+    }
+
+    req, err := http.NewRequestWithContext(ctx, method, e.baseURL+path, body)
+    if err != nil {
+        return err
+    }
+    req.Header.Set("Content-Type", "application/json")
+
+    resp, err := e.http.Do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode >= 300 {
+        detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+        return &apiError{status: resp.StatusCode, body: string(detail)}
+    }
+    if out != nil {
+        return json.NewDecoder(resp.Body).Decode(out)
+    }
+    return nil
+}
+
+// createWorkflow creates the workflow in the engine and returns its ID
+func (e *engineClient) createWorkflow(ctx context.Context, spec workflowSpec) (string, error) {
+    var created struct {
+        ID string `json:"id"`
+    }
+    if err := e.do(ctx, http.MethodPost, "/api/v1/workflows", spec, &created); err != nil {
+        return "", err
+    }
+    return created.ID, nil
+}
+
+// updateWorkflow replaces the engine workflow's definition
+func (e *engineClient) updateWorkflow(ctx context.Context, id string, spec workflowSpec) error {
+    return e.do(ctx, http.MethodPut, "/api/v1/workflows/"+id, spec, nil)
+}
+
+// controller reconciles Workflow resources into engine workflows
+type controller struct {
+    kube      *kubeClient
+    engine    *engineClient
+    namespace string
+    interval  time.Duration
+}
+
+// run polls and reconciles until the context is cancelled
+func (c *controller) run(ctx context.Context) {
+    ticker := time.NewTicker(c.interval)
+    defer ticker.Stop()
+
+    c.reconcileAll(ctx)
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            c.reconcileAll(ctx)
+        }
+    }
+}
+
+// reconcileAll lists every resource in the namespace and reconciles the
+// ones whose spec generation has not been observed yet
+func (c *controller) reconcileAll(ctx context.Context) {
+    resources, err := c.kube.listWorkflows(ctx, c.namespace)
+    if err != nil {
+        log.Printf("list workflows: %v", err)
+        return
+    }
+
+    for i := range resources {
+        resource := &resources[i]
+        if resource.Status.ObservedGeneration == resource.Metadata.Generation {
+            continue
+        }
+        if err := c.reconcile(ctx, resource); err != nil {
+            log.Printf("reconcile %s/%s: %v", resource.Metadata.Namespace, resource.Metadata.Name, err)
+        }
+    }
+}
+
+// reconcile applies one resource's spec to the engine and reflects the
+// outcome in its status conditions
+func (c *controller) reconcile(ctx context.Context, resource *workflowResource) error {
+    var validationErr error
+    if resource.Status.WorkflowID == "" {
+        id, err := c.engine.createWorkflow(ctx, resource.Spec)
+        if err == nil {
+            resource.Status.WorkflowID = id
+        }
+        validationErr = err
+    } else {
+        validationErr = c.engine.updateWorkflow(ctx, resource.Status.WorkflowID, resource.Spec)
+    }
+
+    now := time.Now().UTC().Format(time.RFC3339)
+    if validationErr != nil {
+        // Engine rejections are terminal for this generation and recorded
+        // as a failed Validated condition; transport errors are retried on
+        // the next poll without consuming the generation
+        var engineErr *apiError
+        if !errors.As(validationErr, &engineErr) {
+            return validationErr
+        }
+        setCondition(resource, condition{
+            Type:    conditionValidated,
+            Status:  "False",
+            Reason:  "RejectedByEngine",
+            Message: engineErr.body,
+        }, now)
+    } else {
+        setCondition(resource, condition{
+            Type:   conditionValidated,
+            Status: "True",
+            Reason: "Applied",
+        }, now)
+    }
+
+    scheduled := condition{Type: conditionScheduled, Status: "False", Reason: "NoTrigger"}
+    if hasScheduleTrigger(resource.Spec) {
+        scheduled = condition{Type: conditionScheduled, Status: "True", Reason: "TriggerConfigured"}
+    }
+    setCondition(resource, scheduled, now)
+
+    resource.Status.ObservedGeneration = resource.Metadata.Generation
+    return c.kube.updateStatus(ctx, resource)
+}
+
+// hasScheduleTrigger reports whether the spec contains a trigger node
+// with schedule configuration
+func hasScheduleTrigger(spec workflowSpec) bool {
+    for _, node := range spec.Nodes {
+        if node["type"] != "trigger" {
+            continue
+        }
+        config, ok := node["config"].(map[string]interface{})
+        if !ok {
+            continue
+        }
+        if _, ok := config["schedule"]; ok {
+            return true
+        }
+    }
+    return false
+}
+
+// setCondition replaces the condition of the same type, stamping the
+// transition time only when the status actually changes
+func setCondition(resource *workflowResource, next condition, now string) {
+    next.LastTransitionTime = now
+    for i, existing := range resource.Status.Conditions {
+        if existing.Type != next.Type {
+            continue
+        }
+        if existing.Status == next.Status {
+            next.LastTransitionTime = existing.LastTransitionTime
+        }
+        resource.Status.Conditions[i] = next
+        return
+    }
+    resource.Status.Conditions = append(resource.Status.Conditions, next)
+}
+
+func main() {
+    opts := options{}
+    flag.StringVar(&opts.kubeURL, "kube-url", defaultKubeURL(), "Kubernetes API server base URL")
+    flag.StringVar(&opts.engineURL, "engine-url", envOr("ENGINE_API_URL", "http://workflow-engine:8080"), "workflow engine base URL")
+    flag.StringVar(&opts.namespace, "namespace", defaultNamespace(), "namespace to watch")
+    flag.DurationVar(&opts.interval, "interval", 30*time.Second, "reconcile poll interval")
+    flag.DurationVar(&opts.timeout, "timeout", 15*time.Second, "per-request timeout")
+    flag.Parse()
+
+    kube, err := newKubeClient(opts.kubeURL, opts.timeout)
+    if err != nil {
+        log.Fatalf("kubernetes client: %v", err)
+    }
+
+    ctrl := &controller{
+        kube:      kube,
+        engine:    &engineClient{baseURL: strings.TrimRight(opts.engineURL, "/"), http: &http.Client{Timeout: opts.timeout}},
+        namespace: opts.namespace,
+        interval:  opts.interval,
+    }
+
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+
+    signals := make(chan os.Signal, 1)
+    signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+    go func() {
+        <-signals
+        cancel()
+    }()
+
+    log.Printf("watching %s/%s in namespace %s every %s", crdGroup, crdPlural, opts.namespace, opts.interval)
+    ctrl.run(ctx)
+}
+
+// defaultKubeURL derives the API server address from the in-cluster
+// environment, falling back to the local API proxy
+func defaultKubeURL() string {
+    host := os.Getenv("KUBERNETES_SERVICE_HOST")
+    port := os.Getenv("KUBERNETES_SERVICE_PORT")
+    if host != "" && port != "" {
+        return "https://" + host + ":" + port
+    }
+    return "http://127.0.0.1:8001"
+}
+
+// defaultNamespace reads the pod's namespace, falling back to "default"
+func defaultNamespace() string {
+    if ns, err := os.ReadFile(namespacePath); err == nil {
+        return strings.TrimSpace(string(ns))
+    }
+    return "default"
+}
+
+// envOr returns the environment value or a fallback when unset
+func envOr(key, fallback string) string {
+    if value := os.Getenv(key); value != "" {
+        return value
+    }
+    return fallback
+}
@@ -3,28 +3,52 @@ package main
 
 import (
     "context"
+    "io"
+    "crypto/tls"
+    "encoding/base64"
+    "encoding/json"
     "fmt"
     "os"
     "os/signal"
+    "strings"
     "syscall"
     "time"
 
     "github.com/gofiber/fiber/v2"                 // v2.50.0
+    "github.com/gofiber/fiber/v2/middleware/compress" // v2.50.0
     "github.com/gofiber/fiber/v2/middleware/cors" // v2.50.0
-    "github.com/gofiber/fiber/v2/middleware/logger" // v2.50.0
+    fiberlogger "github.com/gofiber/fiber/v2/middleware/logger" // v2.50.0
     "github.com/gofiber/fiber/v2/middleware/recover" // v2.50.0
     "github.com/gofiber/fiber/v2/middleware/monitor" // v2.50.0
+    "github.com/go-redis/redis/v8"                 // v8.11.5
+    "github.com/google/uuid"                       // v1.3.0
     "github.com/prometheus/client_golang/prometheus" // v1.16.0
     "github.com/uber/jaeger-client-go"             // v2.30.0
-    "github.com/uber/jaeger-client-go/config"      // v2.30.0
+    "github.com/opentracing/opentracing-go"        // v1.2.0
+    jaegercfg "github.com/uber/jaeger-client-go/config" // v2.30.0
     "go.uber.org/zap"                              // v1.26.0
     "github.com/sony/gobreaker"                    // v2.5.0
+    "google.golang.org/grpc"                       // v1.59.0
+    "google.golang.org/grpc/credentials/insecure"  // v1.59.0
 
-    "workflow-engine/internal/config"
-    "workflow-engine/internal/core"
-    "workflow-engine/internal/handlers"
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/handlers"
+    "github.com/organization/workflow-engine/internal/middleware"
+    "github.com/organization/workflow-engine/internal/repositories"
+    "github.com/organization/workflow-engine/internal/services"
+    "github.com/organization/workflow-engine/pkg/chaos"
+    "github.com/organization/workflow-engine/pkg/encryption"
+    "github.com/organization/workflow-engine/pkg/httpmiddleware"
+    "github.com/organization/workflow-engine/pkg/logging"
+    "github.com/organization/workflow-engine/pkg/profiling"
+    "github.com/organization/workflow-engine/pkg/siem"
+    "github.com/organization/workflow-engine/pkg/signing"
 )
 
+// serviceVersion identifies this build in the cluster instance registry
+const serviceVersion = "1.0.0"
+
 // Global variables for observability
 var (
     logger *zap.Logger
@@ -33,14 +57,18 @@ var (
 )
 
 func main() {
-    // Initialize structured logger
-    logger, err := initLogger()
+    // Initialize structured logger with dynamic level control
+    structuredLogger, err := initLogger()
     if err != nil {
         fmt.Printf("Failed to initialize logger: %v\n", err)
         os.Exit(1)
     }
+    logger = structuredLogger.Zap()
     defer logger.Sync()
 
+    // SIGUSR1 switches to debug, SIGUSR2 restores the configured level
+    structuredLogger.HandleSignals()
+
     // Load configuration
     cfg, err := config.NewConfig()
     if err != nil {
@@ -59,33 +87,674 @@ func main() {
     metrics.MustRegister(prometheus.NewGoCollector())
     metrics.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 
+    // Fault injection rules for resilience testing in staging; injection
+    // stays disabled when no rules are configured
+    if spec := os.Getenv("CHAOS_RULES"); spec != "" {
+        if err := chaos.Configure(spec); err != nil {
+            logger.Fatal("Invalid chaos configuration", zap.Error(err))
+        }
+        logger.Warn("Chaos fault injection enabled", zap.String("rules", spec))
+    }
+
+    // Apply histogram bucket overrides before any collectors register
+    core.ConfigureHistograms(cfg.Monitoring.HistogramBuckets, cfg.Monitoring.EnableNativeHistograms)
+
     // Initialize workflow engine
-    engine, err := initEngine(cfg)
+    engine, scheduler, err := initEngine(cfg)
     if err != nil {
         logger.Fatal("Failed to initialize workflow engine", zap.Error(err))
     }
 
+    // Gate the startup probe on dependency warm-up so Kubernetes does
+    // not route traffic to a half-initialized engine
+    startupSteps := []string{core.StartupStepDatabase, core.StartupStepScheduleReload}
+    grpcServices := os.Getenv("GRPC_SERVICES")
+    if grpcServices != "" {
+        startupSteps = append(startupSteps, core.StartupStepGRPCClients)
+    }
+    startupGate := core.NewStartupGate(startupSteps...)
+
+    // Dial downstream gRPC services up front so the startup probe only
+    // passes once the connections are established
+    if grpcServices != "" {
+        clientManager := core.NewClientManager()
+        for _, entry := range strings.Split(grpcServices, ",") {
+            name, target, ok := strings.Cut(strings.TrimSpace(entry), "=")
+            if !ok {
+                logger.Fatal("Invalid GRPC_SERVICES entry", zap.String("entry", entry))
+            }
+            if err := clientManager.Register(name, core.ServiceClientConfig{Target: target}); err != nil {
+                logger.Fatal("Failed to dial gRPC service", zap.String("service", name), zap.Error(err))
+            }
+        }
+        engine.SetClientManager(clientManager)
+        defer clientManager.Shutdown()
+        startupGate.MarkComplete(core.StartupStepGRPCClients)
+    }
+
+    // Built-in embedding and vector store actions for RAG workflows,
+    // backed by pgvector
+    if os.Getenv("VECTOR_STORE") == "pgvector" {
+        vectorStore, err := repositories.NewVectorStore(&cfg.Database)
+        if err != nil {
+            logger.Fatal("Failed to initialize vector store", zap.Error(err))
+        }
+        defer vectorStore.Close()
+        engine.RegisterVectorNodes(vectorStore)
+    }
+
+    // Per-tenant/per-workflow metrics, bounded by the configured allowlists
+    engine.SetMetricLabelPolicy(core.NewMetricLabelPolicy(
+        cfg.Monitoring.MetricTenantAllowlist,
+        cfg.Monitoring.MetricWorkflowAllowlist,
+    ))
+
+    // Cache idempotent node results in Redis when configured; trigger
+    // dedup keys live in Redis too so they survive restarts
+    dedupStore := core.DedupStore(core.NewMemoryDedupStore())
+    if cfg.Redis.Address != "" {
+        redisClient := redis.NewClient(&redis.Options{
+            Addr:     cfg.Redis.Address,
+            Password: cfg.Redis.Password,
+            DB:       cfg.Redis.DB,
+        })
+        defer redisClient.Close()
+        engine.SetNodeResultCache(core.NewNodeResultCache(redisClient, cfg.Redis.NodeCacheTTL))
+        dedupStore = core.NewRedisDedupStore(redisClient)
+    }
+
+    // Ship security-relevant events to a SIEM when a collector is
+    // configured; delivery is buffered off the request path
+    var siemExporter *siem.Exporter
+    if endpoint := os.Getenv("SIEM_ENDPOINT"); endpoint != "" {
+        var sink siem.Sink
+        switch {
+        case strings.HasPrefix(endpoint, "syslog+tcp://"):
+            sink = siem.NewSyslogSink("tcp", strings.TrimPrefix(endpoint, "syslog+tcp://"))
+        case strings.HasPrefix(endpoint, "syslog+udp://"):
+            sink = siem.NewSyslogSink("udp", strings.TrimPrefix(endpoint, "syslog+udp://"))
+        case strings.HasPrefix(endpoint, "https://"):
+            sink = siem.NewHTTPSSink(endpoint, nil)
+        default:
+            logger.Fatal("SIEM_ENDPOINT must use the syslog+tcp, syslog+udp, or https scheme")
+        }
+        siemExporter = siem.NewExporter(sink, siem.Config{})
+        defer siemExporter.Close()
+    }
+
+    // Per-tenant egress policies for outbound nodes; internal and
+    // metadata networks stay blocked even without configured policies
+    egressPolicies := make(map[string]core.EgressPolicy)
+    if spec := os.Getenv("EGRESS_POLICIES"); spec != "" {
+        if err := json.Unmarshal([]byte(spec), &egressPolicies); err != nil {
+            logger.Fatal("Invalid EGRESS_POLICIES configuration", zap.Error(err))
+        }
+    }
+    egressEnforcer, err := core.NewEgressEnforcer(egressPolicies, func(violation core.EgressViolation) {
+        logger.Warn("Egress policy violation",
+            zap.String("tenant", violation.Tenant),
+            zap.String("node_id", violation.NodeID.String()),
+            zap.String("host", violation.Host),
+            zap.String("reason", violation.Reason),
+        )
+        if siemExporter != nil {
+            siemExporter.Submit(siem.Event{
+                Category: "egress",
+                Name:     "policy_violation",
+                Severity: 7,
+                Actor:    violation.Tenant,
+                Target:   violation.Host,
+                Fields:   map[string]string{"reason": violation.Reason},
+            })
+        }
+    })
+    if err != nil {
+        logger.Fatal("Invalid egress policy", zap.Error(err))
+    }
+    engine.SetEgressEnforcer(egressEnforcer)
+
+    // Register this replica in the cluster instance registry
+    instanceStore, err := repositories.NewInstanceStore(&cfg.Database)
+    if err != nil {
+        logger.Fatal("Failed to initialize instance store", zap.Error(err))
+    }
+    instanceRegistry := core.NewInstanceRegistry(instanceStore, engine, core.InstanceRegistryConfig{
+        Version: serviceVersion,
+    })
+    if err := instanceRegistry.Start(); err != nil {
+        logger.Fatal("Failed to register engine instance", zap.Error(err))
+    }
+    defer instanceRegistry.Stop()
+
+    // Compete for the scheduler lease so exactly one replica fires
+    // schedules; on shutdown the lease is released before the process
+    // exits so rolling deploys hand leadership off without missed fires
+    leaseStore, err := repositories.NewLeaseStore(&cfg.Database)
+    if err != nil {
+        logger.Fatal("Failed to initialize lease store", zap.Error(err))
+    }
+    defer leaseStore.Close()
+    schedulerLease := core.NewSchedulerLeaseManager(leaseStore, engine, instanceRegistry, core.SchedulerLeaseConfig{
+        Holder: instanceRegistry.InstanceID(),
+    })
+    schedulerLease.Start()
+    defer schedulerLease.Stop()
+
     // Create Fiber app with configuration
     app := fiber.New(fiber.Config{
         ReadTimeout:  time.Second * 30,
         WriteTimeout: time.Second * 30,
         IdleTimeout:  time.Second * 60,
-        BodyLimit:    1024 * 1024, // 1MB
+        BodyLimit:    cfg.Server.BodyLimit, // SERVER_BODY_LIMIT, 1MB default
         ErrorHandler: customErrorHandler,
+        // Stream large request bodies instead of buffering them fully in
+        // memory so uploads are bounded by BodyLimit, not RAM
+        StreamRequestBody: true,
     })
 
     // Setup middleware stack
     setupMiddleware(app, cfg)
 
+    // Tag each request's context with its tenant for residency routing
+    app.Use(middleware.Tenancy())
+
+    // Shed non-critical requests when the engine is saturated
+    app.Use(middleware.NewLoadShedder(engine, middleware.LoadShedConfig{}))
+
+    // Validate write payloads against the API schemas before they reach
+    // the handlers, reporting field-level errors with JSON Pointers
+    app.Use(middleware.NewOpenAPIValidator().Handler())
+
+    // Per-endpoint soft rate limits; execute and import are stricter
+    // than reads, with per-tenant overrides from the settings table
+    tenantSettings, err := repositories.NewTenantSettingsStore(&cfg.Database)
+    if err != nil {
+        logger.Fatal("Failed to initialize tenant settings store", zap.Error(err))
+    }
+    defer tenantSettings.Close()
+    app.Use(middleware.NewSoftRateLimiter(tenantSettings, middleware.RateLimitConfig{}).Handler())
+    startupGate.MarkComplete(core.StartupStepDatabase)
+
+    // Startup probe: not-ready until every warm-up step has completed
+    app.Get("/health/startup", func(c *fiber.Ctx) error {
+        ready, pending := startupGate.Ready()
+        if !ready {
+            return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+                "status":  "starting",
+                "pending": pending,
+            })
+        }
+        return c.JSON(fiber.Map{"status": "ready"})
+    })
+
+    // Audited support access: admins act within a tenant only through
+    // consented, expiring grants presented per request
+    supportAccess := services.NewSupportAccessManager(func(event services.SupportAuditEvent) {
+        logger.Info("Support access event",
+            zap.String("action", event.Action),
+            zap.String("grant_id", event.GrantID.String()),
+            zap.String("admin_id", event.AdminID.String()),
+            zap.String("user_id", event.UserID.String()),
+            zap.String("path", event.Path),
+        )
+        if siemExporter != nil {
+            siemExporter.Submit(siem.Event{
+                Category: "admin",
+                Name:     "support_access_" + event.Action,
+                Severity: 6,
+                Actor:    event.AdminID.String(),
+                Target:   event.UserID.String(),
+                Fields:   map[string]string{"grant_id": event.GrantID.String(), "path": event.Path},
+            })
+        }
+    })
+    app.Use(middleware.SupportAccess(supportAccess))
+
+    // Persist workflows in PostgreSQL behind the workflow service
+    workflowRepo, err := repositories.NewPostgresRepository(&cfg.Database)
+    if err != nil {
+        logger.Fatal("Failed to initialize workflow repository", zap.Error(err))
+    }
+    defer workflowRepo.Close()
+
+    // Region-pinned storage: tenants with a residency requirement read
+    // and write through the backend serving their pinned region
+    workflowStorage := services.WorkflowRepository(workflowRepo)
+    var residencySelector *repositories.RegionPinnedSelector
+    if len(cfg.Database.ResidencyRegions) > 0 {
+        residencySelector = repositories.NewRegionPinnedSelector(workflowRepo)
+        for region, host := range cfg.Database.ResidencyRegions {
+            regionCfg := cfg.Database
+            regionCfg.Host = host
+            if err := residencySelector.RegisterRegion(region, &regionCfg); err != nil {
+                logger.Fatal("Failed to initialize residency region", zap.String("region", region), zap.Error(err))
+            }
+        }
+        defer residencySelector.Close()
+
+        pins, err := tenantSettings.ListResidencyPins(context.Background())
+        if err != nil {
+            logger.Fatal("Failed to load tenant residency pins", zap.Error(err))
+        }
+        for tenant, region := range pins {
+            if err := residencySelector.PinTenant(tenant, region); err != nil {
+                logger.Warn("Skipping tenant residency pin for unconfigured region",
+                    zap.String("tenant", tenant), zap.String("region", region))
+            }
+        }
+        workflowStorage = repositories.NewResidencyRepository(residencySelector)
+    }
+    workflowService := services.NewWorkflowService(workflowStorage, engine, tracer)
+
+    // Published revisions and the approval gate share the workflow store
+    revisionStore, err := repositories.NewRevisionStore(&cfg.Database)
+    if err != nil {
+        logger.Fatal("Failed to initialize revision store", zap.Error(err))
+    }
+    defer revisionStore.Close()
+    workflowService.SetRevisionStore(revisionStore)
+
+    approvalStore, err := repositories.NewApprovalStore(&cfg.Database)
+    if err != nil {
+        logger.Fatal("Failed to initialize approval store", zap.Error(err))
+    }
+    defer approvalStore.Close()
+    workflowService.SetApprovalStore(approvalStore, os.Getenv("REQUIRE_PUBLISH_APPROVAL") == "true")
+
+    // Shared business calendars that calendar schedules reference by
+    // ID, attached before persisted schedules are reinstalled so
+    // calendar_id references resolve during the reload
+    calendarRegistry := core.NewCalendarRegistry()
+    scheduler.SetCalendarRegistry(calendarRegistry)
+    calendarHandler := handlers.NewCalendarHandler(calendarRegistry)
+
+    // Reinstall persisted schedules before the scheduler starts so the
+    // catch-up scan works from real last-run history, then mark the
+    // startup gate step once the reload has actually happened
+    scheduleStore, err := repositories.NewScheduleStore(&cfg.Database)
+    if err != nil {
+        logger.Fatal("Failed to initialize schedule store", zap.Error(err))
+    }
+    defer scheduleStore.Close()
+    scheduler.SetStateStore(scheduleStore)
+    restored, err := scheduler.RestoreSchedules(context.Background(), workflowRepo.Get)
+    if err != nil {
+        logger.Fatal("Failed to restore persisted schedules", zap.Error(err))
+    }
+    logger.Info("Restored persisted schedules", zap.Int("count", restored))
+    scheduler.Start()
+    startupGate.MarkComplete(core.StartupStepScheduleReload)
+
+    // Recover executions orphaned by lost instances now that the stores
+    // are up; recovery failures are logged rather than fatal so one bad
+    // execution record cannot keep the whole service down
+    executionStore, err := repositories.NewExecutionStore(&cfg.Database, workflowRepo)
+    if err != nil {
+        logger.Fatal("Failed to initialize execution store", zap.Error(err))
+    }
+    defer executionStore.Close()
+    recoveryManager := core.NewRecoveryManager(executionStore, instanceRegistry, engine.Executor(), core.RecoveryConfig{})
+    if err := recoveryManager.RecoverOrphanedExecutions(context.Background()); err != nil {
+        logger.Error("Orphaned execution recovery failed", zap.Error(err))
+    }
+
+    // New runs record themselves through the same store recovery reads,
+    // so a replica lost mid-run leaves a row the next scan picks up
+    engine.SetExecutionRecorder(executionStore, instanceRegistry.InstanceID())
+
     // Initialize handlers
-    workflowHandler := handlers.NewWorkflowHandler(engine, tracer)
+    workflowHandler := handlers.NewWorkflowHandler(workflowService, tracer)
+    clusterHandler := handlers.NewClusterHandler(instanceRegistry)
+    executionHandler := handlers.NewExecutionHandler(engine, core.NewDebugRecorder())
+
+    // Versioned prompt template library referenced by AI task nodes
+    promptStore, err := repositories.NewPromptStore(&cfg.Database)
+    if err != nil {
+        logger.Fatal("Failed to initialize prompt store", zap.Error(err))
+    }
+    defer promptStore.Close()
+    promptHandler := handlers.NewPromptHandler(services.NewPromptService(promptStore))
+
+    // Capture data lineage across node executions
+    lineageRecorder := core.NewLineageRecorder()
+    engine.RegisterInterceptor(lineageRecorder)
+    executionHandler.SetLineageRecorder(lineageRecorder)
+
+    // Derive saturation and headroom gauges for autoscaler integration
+    capacityMonitor := core.NewCapacityMonitor(engine)
+    capacityMonitor.SetDBStats(instanceStore.Stats)
+    engine.SetCapacityMonitor(capacityMonitor)
+    executionHandler.SetCapacityMonitor(capacityMonitor)
+    defer capacityMonitor.Stop()
+
+    // Queue executions in priority order when the engine is saturated,
+    // preempting queued low-priority work for arriving high-priority runs
+    executionQueue := core.NewExecutionQueue(engine, cfg.Engine.QueueCapacity)
+    executionQueue.SetCapacityMonitor(capacityMonitor)
+    executionQueue.SetDispatchFailureHandler(func(workflowID uuid.UUID, opts map[string]interface{}, err error) {
+        // The API caller was answered 202 long before dispatch; this log
+        // line is where a queued run that never started shows up
+        logger.Error("Queued execution failed to start",
+            zap.String("workflow_id", workflowID.String()),
+            zap.Any("execution_id", opts["execution_id"]),
+            zap.Error(err))
+    })
+    capacityMonitor.SetQueue(executionQueue)
+    executionHandler.SetExecutionQueue(executionQueue)
+    defer executionQueue.Shutdown()
+
+    // Serve engine load metrics in the Kubernetes external metrics API
+    // format so an external metrics adapter can feed the horizontal pod
+    // autoscaler queue depth and active executions instead of CPU
+    if os.Getenv("EXTERNAL_METRICS_ENABLED") == "true" {
+        externalMetrics := handlers.NewExternalMetricsHandler(capacityMonitor)
+        app.Get("/apis/external.metrics.k8s.io/v1beta1", externalMetrics.ListResources)
+        app.Get("/apis/external.metrics.k8s.io/v1beta1/namespaces/:namespace/:metric", externalMetrics.GetMetric)
+    }
+
+    // Maintain per-workflow execution stats for the UI
+    statsTracker := core.NewExecutionStatsTracker()
+    engine.RegisterInterceptor(statsTracker)
+    workflowHandler.SetStatsTracker(statsTracker)
+
+    // Evaluate executions against per-workflow SLA targets; breaches are
+    // logged and, when a collector is configured, shipped to the SIEM
+    slaSinks := []core.AlertSink{
+        core.AlertSinkFunc(func(ctx context.Context, breach core.SLABreach) error {
+            logger.Warn("Workflow SLA breached",
+                zap.String("workflow_id", breach.WorkflowID.String()),
+                zap.String("breach_type", breach.BreachType),
+                zap.Float64("observed", breach.Observed),
+                zap.Float64("threshold", breach.Threshold))
+            return nil
+        }),
+    }
+    if siemExporter != nil {
+        slaSinks = append(slaSinks, core.AlertSinkFunc(func(ctx context.Context, breach core.SLABreach) error {
+            siemExporter.Submit(siem.Event{
+                Category: "availability",
+                Name:     "workflow_sla_breach",
+                Severity: 4,
+                Target:   breach.WorkflowID.String(),
+                Fields: map[string]string{
+                    "breach_type": breach.BreachType,
+                    "observed":    fmt.Sprintf("%g", breach.Observed),
+                    "threshold":   fmt.Sprintf("%g", breach.Threshold),
+                },
+            })
+            return nil
+        }))
+    }
+    slaMonitor := core.NewSLAMonitor(slaSinks...)
+    engine.RegisterInterceptor(slaMonitor)
+    workflowHandler.SetSLAMonitor(slaMonitor)
+    workflowHandler.SetScheduler(scheduler)
+
+    // Canary activations split trigger traffic between the latest and the
+    // previous published revision, rolling back on elevated error rates
+    canaryController := core.NewCanaryController()
+    engine.RegisterInterceptor(canaryController)
+    workflowService.SetCanaryRouter(canaryController)
+    workflowHandler.SetCanaryController(canaryController)
+
+    // Aggregate high-frequency trigger events into batched executions,
+    // skipping events whose dedup key was already seen
+    triggerBatcher := core.NewTriggerBatcher(engine.StartWorkflow)
+    triggerBatcher.SetDeduplicator(core.NewDeduplicator(dedupStore))
+    workflowHandler.SetTriggerBatcher(triggerBatcher)
+    defer triggerBatcher.Close()
+
+    // Scan node outputs for PII flowing through nodes not marked for it
+    engine.RegisterInterceptor(core.NewPIIScanner(nil, func(event core.ComplianceEvent) {
+        logger.Warn("PII detected in node output",
+            zap.String("execution_id", event.ExecutionID.String()),
+            zap.String("node_id", event.NodeID.String()),
+            zap.String("detector", event.Detector),
+            zap.String("field", event.Field),
+        )
+    }))
+
+    // Secret-marked node config fields are masked in GET responses;
+    // users on the reveal allowlist may request clear text with
+    // ?reveal=true, and every reveal is audited
+    if allowlist := os.Getenv("SECRET_REVEAL_USERS"); allowlist != "" {
+        allowed := make(map[uuid.UUID]bool)
+        for _, entry := range strings.Split(allowlist, ",") {
+            id, err := uuid.Parse(strings.TrimSpace(entry))
+            if err != nil {
+                logger.Fatal("Invalid SECRET_REVEAL_USERS entry", zap.String("entry", entry), zap.Error(err))
+            }
+            allowed[id] = true
+        }
+        workflowHandler.SetSecretRevealPolicy(&handlers.SecretRevealPolicy{
+            Authorize: func(userID uuid.UUID) bool { return allowed[userID] },
+            OnReveal: func(event handlers.SecretRevealEvent) {
+                logger.Info("Secret fields revealed",
+                    zap.String("user_id", event.UserID.String()),
+                    zap.String("path", event.Path),
+                )
+                if siemExporter != nil {
+                    siemExporter.Submit(siem.Event{
+                        Category: "secrets",
+                        Name:     "config_reveal",
+                        Severity: 6,
+                        Actor:    event.UserID.String(),
+                        Target:   event.Path,
+                    })
+                }
+            },
+        })
+    }
+
+    // Moderate AI node inputs and outputs through the AI service when a
+    // moderation model is configured; blocked content is audited
+    if model := os.Getenv("MODERATION_MODEL"); model != "" {
+        enabled := engine.EnableModeration(model, func(event core.ModerationEvent) {
+            logger.Warn("Content moderation event",
+                zap.String("workflow_id", event.WorkflowID.String()),
+                zap.String("node_id", event.NodeID.String()),
+                zap.String("stage", event.Stage),
+                zap.String("action", event.Action),
+                zap.Strings("categories", event.Categories),
+            )
+            if siemExporter != nil {
+                siemExporter.Submit(siem.Event{
+                    Category: "moderation",
+                    Name:     "content_" + event.Action,
+                    Severity: 6,
+                    Actor:    event.Tenant,
+                    Target:   event.NodeID.String(),
+                    Fields:   map[string]string{"workflow_id": event.WorkflowID.String(), "stage": event.Stage, "categories": strings.Join(event.Categories, ",")},
+                })
+            }
+        })
+        if !enabled {
+            logger.Warn("MODERATION_MODEL set but no AI service connection is configured")
+        }
+    }
+
+    // Coalesce concurrent AI task calls into batched gRPC calls when
+    // batching is configured
+    if cfg.Engine.AIBatchingEnabled {
+        enabled := engine.EnableAIBatching(core.AIBatcherConfig{
+            MaxBatchSize:  cfg.Engine.AIBatchMaxSize,
+            LatencyBudget: cfg.Engine.AIBatchLatencyBudget,
+        })
+        if !enabled {
+            logger.Warn("ENGINE_AI_BATCHING set but no AI service connection is configured")
+        }
+    }
+
+    // Enforce the default per-execution resource budget when any limit
+    // is configured; zero limits leave that resource unbounded
+    if cfg.Engine.BudgetMaxNodes > 0 || cfg.Engine.BudgetMaxWallClock > 0 ||
+        cfg.Engine.BudgetMaxExternalCalls > 0 || cfg.Engine.BudgetMaxAITokens > 0 {
+        engine.SetBudgetPolicy(&core.BudgetPolicy{
+            Default: core.ExecutionBudget{
+                MaxNodes:         cfg.Engine.BudgetMaxNodes,
+                MaxWallClock:     cfg.Engine.BudgetMaxWallClock,
+                MaxExternalCalls: cfg.Engine.BudgetMaxExternalCalls,
+                MaxAITokens:      cfg.Engine.BudgetMaxAITokens,
+            },
+        })
+    }
+
+    // Runtime tuning applies straight to the executor: the admin API and
+    // the execution path share the same manager
+    tuningManager := core.NewTuningManager(core.RuntimeSettings{
+        MaxConcurrentExecutions: cfg.Engine.MaxConcurrentExecutions,
+        ExecutionTimeout:        cfg.Engine.ExecutionTimeout,
+        NodeTimeout:             cfg.Engine.NodeTimeout,
+        MaxRetries:              cfg.Engine.MaxRetries,
+    })
+    engine.SetTuningManager(tuningManager)
+    adminHandler := handlers.NewAdminHandler(tuningManager)
+    adminHandler.SetLogging(structuredLogger)
+    adminHandler.SetSupportAccess(supportAccess)
+
+    // Maintenance mode suspends scheduled executions globally, either
+    // through planned windows or an operator-controlled pause
+    maintenanceManager := core.NewMaintenanceManager()
+    if cfg.Engine.MaintenanceMode {
+        maintenanceManager.Pause(cfg.Engine.MaintenanceReason)
+        logger.Warn("Starting in maintenance mode; scheduled executions are paused",
+            zap.String("reason", cfg.Engine.MaintenanceReason))
+    }
+    scheduler.SetMaintenanceManager(maintenanceManager)
+    adminHandler.SetMaintenanceManager(maintenanceManager)
+
+    // Continuous profiling when an ingest endpoint is configured,
+    // toggleable at runtime through the admin API
+    if endpoint := os.Getenv("PROFILING_ENDPOINT"); endpoint != "" {
+        profiler := profiling.New(profiling.Config{
+            Service:  "workflow-engine",
+            Endpoint: endpoint,
+        })
+        if os.Getenv("PROFILING_ENABLED") == "true" {
+            profiler.Enable()
+        }
+        profiler.Start(context.Background())
+        adminHandler.SetProfiler(profiler)
+    }
+
+    // Per-tenant envelope encryption keys with a rotation API
+    if masterKey := os.Getenv("MASTER_ENCRYPTION_KEY"); masterKey != "" {
+        decoded, err := base64.StdEncoding.DecodeString(masterKey)
+        if err != nil {
+            logger.Fatal("MASTER_ENCRYPTION_KEY must be base64-encoded", zap.Error(err))
+        }
+        keyManager, err := encryption.NewKeyManager(decoded)
+        if err != nil {
+            logger.Fatal("Failed to initialize key manager", zap.Error(err))
+        }
+
+        // Wrapped DEKs and rotated KEKs are persisted so envelopes stay
+        // decryptable across restarts and replicas
+        keyStore, err := repositories.NewKeyStore(&cfg.Database)
+        if err != nil {
+            logger.Fatal("Failed to initialize encryption key store", zap.Error(err))
+        }
+        defer keyStore.Close()
+        if err := keyManager.SetStore(context.Background(), keyStore); err != nil {
+            logger.Fatal("Failed to load persisted encryption keys", zap.Error(err))
+        }
+        adminHandler.SetKeyManager(keyManager)
+
+        // Envelope-encrypt persisted execution payloads, wrapping each
+        // payload's data key under the owning tenant's key
+        payloadEncryptor, err := encryption.NewEnvelopeEncryptor(keyManager)
+        if err != nil {
+            logger.Fatal("Failed to initialize payload encryptor", zap.Error(err))
+        }
+        executionStore.SetPayloadEncryptor(payloadEncryptor)
+
+        // Secret-marked node config fields are sealed at rest in every
+        // storage backend, including region-pinned ones
+        workflowRepo.SetSecretEncryptor(payloadEncryptor)
+        if residencySelector != nil {
+            residencySelector.SetSecretEncryptor(payloadEncryptor)
+        }
+    }
+
+    // Signed definition export/import; a deployment may hold the signing
+    // key, the verification key, or both
+    if signKey := os.Getenv("DEFINITION_SIGNING_KEY"); signKey != "" || os.Getenv("DEFINITION_VERIFICATION_KEY") != "" {
+        var signer *signing.Signer
+        var verifier *signing.Verifier
+        if signKey != "" {
+            decoded, err := base64.StdEncoding.DecodeString(signKey)
+            if err != nil {
+                logger.Fatal("DEFINITION_SIGNING_KEY must be base64-encoded", zap.Error(err))
+            }
+            signer, err = signing.NewSigner(decoded)
+            if err != nil {
+                logger.Fatal("Failed to initialize definition signer", zap.Error(err))
+            }
+        }
+        if verifyKey := os.Getenv("DEFINITION_VERIFICATION_KEY"); verifyKey != "" {
+            decoded, err := base64.StdEncoding.DecodeString(verifyKey)
+            if err != nil {
+                logger.Fatal("DEFINITION_VERIFICATION_KEY must be base64-encoded", zap.Error(err))
+            }
+            verifier, err = signing.NewVerifier(decoded)
+            if err != nil {
+                logger.Fatal("Failed to initialize definition verifier", zap.Error(err))
+            }
+        }
+        workflowHandler.SetDefinitionSigning(signer, verifier)
+    }
+
+    // Compliance posture is verified by config validation at startup;
+    // the report is served for auditors regardless of mode
+    complianceReport := cfg.ComplianceReport()
+    adminHandler.SetComplianceReport(complianceReport)
+    if cfg.Compliance.Enabled {
+        logger.Info("Compliance mode enabled",
+            zap.Bool("compliant", complianceReport.Compliant),
+        )
+    }
 
     // Setup routes
-    setupRoutes(app, workflowHandler)
+    setupRoutes(app, cfg, workflowHandler, clusterHandler, executionHandler, adminHandler, promptHandler, calendarHandler)
+
+    // Optional gRPC listener; the standard health checking protocol and
+    // server reflection are registered so load balancers, probes, and
+    // grpcurl work before any engine services are added
+    if grpcAddress := os.Getenv("GRPC_ADDRESS"); grpcAddress != "" {
+        grpcServer := core.NewGRPCServer()
+        defer grpcServer.Stop()
+        go func() {
+            logger.Info("Starting gRPC server", zap.String("address", grpcAddress))
+            if err := grpcServer.Start(grpcAddress); err != nil {
+                logger.Fatal("Failed to start gRPC server", zap.Error(err))
+            }
+        }()
+    }
 
-    // Start server
+    // Start server. With a certificate configured the listener enforces
+    // the compliance TLS floor and approved cipher suites
     go func() {
         logger.Info("Starting server", zap.String("address", cfg.Server.Address))
+        certFile, keyFile := os.Getenv("TLS_CERT_FILE"), os.Getenv("TLS_KEY_FILE")
+        if certFile != "" && keyFile != "" {
+            cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+            if err != nil {
+                logger.Fatal("Failed to load TLS certificate", zap.Error(err))
+            }
+            tlsConfig := cfg.Compliance.TLSConfig()
+            tlsConfig.Certificates = []tls.Certificate{cert}
+            listener, err := tls.Listen("tcp", cfg.Server.Address, tlsConfig)
+            if err != nil {
+                logger.Fatal("Failed to start TLS listener", zap.Error(err))
+            }
+            if err := app.Listener(listener); err != nil {
+                logger.Fatal("Server failed", zap.Error(err))
+            }
+            return
+        }
+        if cfg.Compliance.Enabled {
+            logger.Warn("Compliance mode without TLS_CERT_FILE/TLS_KEY_FILE; TLS termination must happen upstream")
+        }
         if err := app.Listen(cfg.Server.Address); err != nil {
             logger.Fatal("Server failed", zap.Error(err))
         }
@@ -95,24 +764,77 @@ func main() {
     gracefulShutdown(app, engine)
 }
 
-// initLogger initializes the structured logger with rotation
-func initLogger() (*zap.Logger, error) {
-    config := zap.NewProductionConfig()
-    config.OutputPaths = []string{"stdout", "/var/log/workflow-engine.log"}
-    config.ErrorOutputPaths = []string{"stderr", "/var/log/workflow-engine-error.log"}
-    
-    return config.Build()
+// initEngine constructs the executor, scheduler, and engine from
+// configuration, dialing the optional downstream AI and integration
+// services when their addresses are configured. The scheduler is
+// returned unstarted: main starts it only after persisted schedules
+// have been restored, so the catch-up scan sees real last-run history
+func initEngine(cfg *config.Config) (*core.Engine, *core.Scheduler, error) {
+    dial := func(addr string) (*grpc.ClientConn, error) {
+        if addr == "" {
+            return nil, nil
+        }
+        return grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+    }
+
+    aiConn, err := dial(os.Getenv("AI_SERVICE_ADDR"))
+    if err != nil {
+        return nil, nil, fmt.Errorf("failed to dial AI service: %w", err)
+    }
+    integrationConn, err := dial(os.Getenv("INTEGRATION_SERVICE_ADDR"))
+    if err != nil {
+        return nil, nil, fmt.Errorf("failed to dial integration service: %w", err)
+    }
+
+    executor := core.NewExecutor(aiConn, integrationConn)
+    scheduler := core.NewScheduler(executor, core.SchedulerConfig{
+        MaxRetries: cfg.Engine.MaxRetries,
+    })
+
+    engineConfig := core.EngineConfig{
+        ExecutionTimeout: cfg.Engine.ExecutionTimeout,
+        MaxRetries:       cfg.Engine.MaxRetries,
+    }
+    if cfg.Engine.EnableCircuitBreaker {
+        engineConfig.CircuitBreakerConfig = gobreaker.Settings{
+            Name:    "workflow-engine",
+            Timeout: cfg.Engine.BreakDuration,
+            ReadyToTrip: func(counts gobreaker.Counts) bool {
+                failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+                return counts.Requests >= 3 && failureRatio >= cfg.Engine.ErrorThreshold
+            },
+        }
+    }
+
+    return core.NewEngine(executor, scheduler, engineConfig), scheduler, nil
+}
+
+// initLogger initializes the shared structured logger with the service
+// identity fields and error sampling
+func initLogger() (*logging.Logger, error) {
+    filePath := os.Getenv("LOG_FILE")
+    if filePath == "" {
+        filePath = "/var/log/workflow-engine.log"
+    }
+
+    return logging.New(logging.Config{
+        Service:  "workflow-engine",
+        Version:  serviceVersion,
+        Level:    os.Getenv("LOG_LEVEL"),
+        FilePath: filePath,
+        Compress: true,
+    })
 }
 
 // initTracing initializes the distributed tracing system
 func initTracing(cfg *config.Config) (opentracing.Tracer, io.Closer, error) {
-    jaegerCfg := &config.Configuration{
+    jaegerCfg := &jaegercfg.Configuration{
         ServiceName: "workflow-engine",
-        Sampler: &config.SamplerConfig{
-            Type:  jaeger.AdaptiveSampler,
+        Sampler: &jaegercfg.SamplerConfig{
+            Type:  jaeger.SamplerTypeProbabilistic,
             Param: 1,
         },
-        Reporter: &config.ReporterConfig{
+        Reporter: &jaegercfg.ReporterConfig{
             LogSpans:            true,
             LocalAgentHostPort: cfg.Monitoring.TracingEndpoint,
         },
@@ -126,11 +848,16 @@ func setupMiddleware(app *fiber.App, cfg *config.Config) {
     // Recovery middleware
     app.Use(recover.New(recover.Config{
         EnableStackTrace: true,
-        StackTraceHandler: func(e interface{}) {
+        StackTraceHandler: func(c *fiber.Ctx, e interface{}) {
             logger.Error("Panic recovered", zap.Any("error", e))
         },
     }))
 
+    // Response compression (gzip, deflate, brotli negotiated per request)
+    app.Use(compress.New(compress.Config{
+        Level: compress.LevelDefault,
+    }))
+
     // CORS middleware
     app.Use(cors.New(cors.Config{
         AllowOrigins:     cfg.Server.CorsOrigins,
@@ -142,39 +869,20 @@ func setupMiddleware(app *fiber.App, cfg *config.Config) {
     }))
 
     // Request logging
-    app.Use(logger.New(logger.Config{
+    app.Use(fiberlogger.New(fiberlogger.Config{
         Format:     "${time} ${status} ${method} ${path} ${latency}\n",
         TimeFormat: "2006-01-02 15:04:05",
         Output:     os.Stdout,
     }))
 
-    // Tracing middleware
-    app.Use(func(c *fiber.Ctx) error {
-        span := tracer.StartSpan(c.Path())
-        defer span.Finish()
-        
-        c.Locals("span", span)
-        return c.Next()
-    })
-
-    // Metrics middleware
-    app.Use(func(c *fiber.Ctx) error {
-        start := time.Now()
-        err := c.Next()
-        duration := time.Since(start).Seconds()
-        
-        httpRequestDuration.WithLabelValues(
-            c.Method(),
-            c.Path(),
-            fmt.Sprintf("%d", c.Response().StatusCode()),
-        ).Observe(duration)
-        
-        return err
-    })
+    // Request ID, tracing, and metrics from the shared middleware package
+    app.Use(httpmiddleware.RequestID(httpmiddleware.RequestIDConfig{}))
+    app.Use(httpmiddleware.Tracing(httpmiddleware.TracingConfig{Tracer: tracer}))
+    app.Use(httpmiddleware.Metrics(httpmiddleware.MetricsConfig{Service: "workflow-engine"}))
 }
 
 // setupRoutes configures API routes
-func setupRoutes(app *fiber.App, handler *handlers.WorkflowHandler) {
+func setupRoutes(app *fiber.App, cfg *config.Config, handler *handlers.WorkflowHandler, clusterHandler *handlers.ClusterHandler, executionHandler *handlers.ExecutionHandler, adminHandler *handlers.AdminHandler, promptHandler *handlers.PromptHandler, calendarHandler *handlers.CalendarHandler) {
     // Health check endpoint
     app.Get("/health", func(c *fiber.Ctx) error {
         return c.JSON(fiber.Map{
@@ -183,19 +891,121 @@ func setupRoutes(app *fiber.App, handler *handlers.WorkflowHandler) {
         })
     })
 
-    // Metrics endpoint
-    app.Get("/metrics", monitor.New())
+    // Metrics dashboard, withheld when compliance mode disables debug
+    // endpoints
+    if !cfg.Compliance.DisableDebugEndpoints {
+        app.Get("/metrics", monitor.New())
+    }
 
     // API v1 routes
     v1 := app.Group("/api/v1")
     
     workflows := v1.Group("/workflows")
+    workflows.Get("/", handler.ListWorkflows)
     workflows.Post("/", handler.CreateWorkflow)
+    workflows.Post("/import", handler.ImportWorkflow)
     workflows.Get("/:id", handler.GetWorkflow)
     workflows.Put("/:id", handler.UpdateWorkflow)
     workflows.Delete("/:id", handler.DeleteWorkflow)
-    workflows.Post("/:id/execute", handler.ExecuteWorkflow)
+    workflows.Patch("/:id/layout", handler.UpdateLayout)
+    workflows.Get("/:id/export", handler.ExportWorkflow)
+    workflows.Get("/:id/nodes", handler.GetNodes)
+    workflows.Post("/:id/nodes", handler.CreateNode)
+    workflows.Put("/:id/nodes/:nodeID", handler.UpdateNode)
+    workflows.Delete("/:id/nodes/:nodeID", handler.DeleteNode)
+    workflows.Post("/:id/connections", handler.CreateConnection)
+    workflows.Delete("/:id/connections", handler.DeleteConnection)
+    workflows.Post("/:id/review", handler.SubmitForReview)
+    workflows.Get("/:id/approvals", handler.ListApprovals)
+    workflows.Post("/:id/publish", handler.PublishWorkflow)
+    workflows.Post("/:id/execute", executionHandler.ExecuteWorkflow)
+    workflows.Post("/:id/trigger", handler.TriggerWorkflow)
     workflows.Get("/:id/status", handler.GetWorkflowStatus)
+    workflows.Get("/:id/stats", handler.GetWorkflowStats)
+    workflows.Get("/:id/schedule", handler.GetSchedule)
+    workflows.Patch("/:id/schedule", handler.UpdateSchedule)
+    workflows.Get("/:id/sla", handler.GetSLA)
+    workflows.Put("/:id/sla", handler.SetSLA)
+    workflows.Delete("/:id/sla", handler.DeleteSLA)
+    workflows.Get("/:id/canary", handler.GetCanaryStatus)
+    workflows.Post("/:id/canary", handler.StartCanary)
+    workflows.Post("/:id/canary/promote", handler.PromoteCanary)
+    workflows.Post("/:id/canary/rollback", handler.RollbackCanary)
+
+    // Approval decisions for the publish change-management gate
+    approvals := v1.Group("/approvals")
+    approvals.Post("/:id/approve", handler.ApproveWorkflow)
+    approvals.Post("/:id/reject", handler.RejectWorkflow)
+
+    // Node configuration schemas for config form generation
+    v1.Get("/node-types/:type/schema", handler.GetNodeSchema)
+
+    // Versioned prompt template library for AI task nodes
+    prompts := v1.Group("/prompt-templates")
+    prompts.Get("/", promptHandler.ListPrompts)
+    prompts.Post("/", promptHandler.CreatePrompt)
+    prompts.Get("/:id", promptHandler.GetPrompt)
+    prompts.Delete("/:id", promptHandler.DeletePrompt)
+    prompts.Get("/:id/versions", promptHandler.ListPromptVersions)
+    prompts.Post("/:id/versions", promptHandler.CreatePromptVersion)
+
+    // Shared business calendars referenced by calendar schedules
+    calendars := v1.Group("/calendars")
+    calendars.Get("/", calendarHandler.ListCalendars)
+    calendars.Post("/", calendarHandler.CreateCalendar)
+    calendars.Get("/:id", calendarHandler.GetCalendar)
+    calendars.Put("/:id", calendarHandler.UpdateCalendar)
+    calendars.Delete("/:id", calendarHandler.DeleteCalendar)
+
+    // In-flight executions grouped for concurrency visibility
+    v1.Get("/executions/active", executionHandler.GetActiveExecutions)
+    v1.Get("/capacity", executionHandler.GetCapacity)
+
+    // Per-run debug capture retrieval, withheld in compliance mode
+    if !cfg.Compliance.DisableDebugEndpoints {
+        v1.Get("/executions/:id/debug", executionHandler.GetExecutionDebug)
+    }
+    v1.Get("/executions/:id/lineage", executionHandler.GetExecutionLineage)
+
+    // Cluster status for operators running multiple replicas
+    v1.Get("/cluster", clusterHandler.GetCluster)
+
+    // Engine admin runtime tuning
+    admin := v1.Group("/admin/engine")
+    admin.Get("/settings", adminHandler.GetSettings)
+    admin.Put("/settings", adminHandler.UpdateSettings)
+
+    // Maintenance mode control for planned downtime
+    maintenanceAdmin := v1.Group("/admin/maintenance")
+    maintenanceAdmin.Get("/", adminHandler.GetMaintenance)
+    maintenanceAdmin.Post("/windows", adminHandler.AddMaintenanceWindow)
+    maintenanceAdmin.Post("/pause", adminHandler.PauseMaintenance)
+    maintenanceAdmin.Post("/resume", adminHandler.ResumeMaintenance)
+
+    // Dynamic log level control
+    loggingAdmin := v1.Group("/admin/logging")
+    loggingAdmin.Get("/", adminHandler.GetLogLevel)
+    loggingAdmin.Put("/", adminHandler.UpdateLogLevel)
+
+    // Runtime profiling control, withheld in compliance mode
+    if !cfg.Compliance.DisableDebugEndpoints {
+        profilingAdmin := v1.Group("/admin/profiling")
+        profilingAdmin.Get("/", adminHandler.GetProfiling)
+        profilingAdmin.Put("/", adminHandler.UpdateProfiling)
+    }
+
+    // Encryption key rotation
+    keysAdmin := v1.Group("/admin/keys")
+    keysAdmin.Post("/rotate", adminHandler.RotateKeys)
+
+    // Compliance posture report
+    v1.Get("/admin/compliance", adminHandler.GetCompliance)
+
+    // Support access grants for audited tenant impersonation
+    supportAdmin := v1.Group("/admin/support/grants")
+    supportAdmin.Get("/", adminHandler.ListSupportGrants)
+    supportAdmin.Post("/", adminHandler.CreateSupportGrant)
+    supportAdmin.Delete("/:id", adminHandler.RevokeSupportGrant)
 }
 
 // gracefulShutdown handles graceful shutdown process
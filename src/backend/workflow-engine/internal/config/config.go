@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -33,9 +34,19 @@ var (
 
 // Config represents the main configuration structure for the workflow engine
 type Config struct {
+	Server     ServerConfig
 	Database   DatabaseConfig
 	Engine     EngineConfig
 	Monitoring MonitoringConfig
+	Redis      RedisConfig
+	Compliance ComplianceConfig
+}
+
+// ServerConfig contains HTTP listener configuration
+type ServerConfig struct {
+	Address     string
+	CorsOrigins string
+	BodyLimit   int
 }
 
 // DatabaseConfig contains database-related configuration with enhanced security
@@ -53,6 +64,9 @@ type DatabaseConfig struct {
 	SSLMode           string
 	EnableSharding    bool
 	ShardCount        int
+	// ResidencyRegions maps region names to the database host serving
+	// tenants pinned to that region; empty disables region pinning
+	ResidencyRegions map[string]string
 }
 
 // EngineConfig contains workflow execution configuration
@@ -66,6 +80,23 @@ type EngineConfig struct {
 	EnableCircuitBreaker bool
 	ErrorThreshold       float64
 	BreakDuration       time.Duration
+	// MaintenanceMode starts the instance with scheduled executions
+	// paused until an operator resumes them through the admin API
+	MaintenanceMode   bool
+	MaintenanceReason string
+	// AIBatchingEnabled coalesces concurrent AI task calls into batched
+	// gRPC calls bounded by batch size and latency budget
+	AIBatchingEnabled    bool
+	AIBatchMaxSize       int
+	AIBatchLatencyBudget time.Duration
+	// Default per-execution resource budget; zero values are unlimited
+	BudgetMaxNodes         int
+	BudgetMaxWallClock     time.Duration
+	BudgetMaxExternalCalls int
+	BudgetMaxAITokens      int
+	// QueueCapacity bounds how many executions may wait for a slot in
+	// the priority queue when the engine is saturated
+	QueueCapacity int
 }
 
 // MonitoringConfig contains monitoring and observability configuration
@@ -78,14 +109,30 @@ type MonitoringConfig struct {
 	HealthCheckEndpoint  string
 	HealthCheckInterval  time.Duration
 	EnableDetailedMetrics bool
+	MetricTenantAllowlist   []string
+	MetricWorkflowAllowlist []string
+	HistogramBuckets        map[string][]float64
+	EnableNativeHistograms  bool
+}
+
+// RedisConfig contains Redis connection and caching configuration. Caching
+// is disabled when no address is configured
+type RedisConfig struct {
+	Address      string
+	Password     string
+	DB           int
+	NodeCacheTTL time.Duration
 }
 
 // NewConfig creates a new configuration instance with validation
 func NewConfig() (*Config, error) {
 	cfg := &Config{
+		Server:     loadServerConfig(),
 		Database:   loadDatabaseConfig(),
 		Engine:     loadEngineConfig(),
 		Monitoring: loadMonitoringConfig(),
+		Redis:      loadRedisConfig(),
+		Compliance: loadComplianceConfig(),
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -95,6 +142,17 @@ func NewConfig() (*Config, error) {
 	return cfg, nil
 }
 
+// loadServerConfig loads the HTTP listener configuration
+func loadServerConfig() ServerConfig {
+	return ServerConfig{
+		Address:     getEnvOrDefault("SERVER_ADDRESS", ":8080"),
+		CorsOrigins: getEnvOrDefault("CORS_ORIGINS", "*"),
+		// Maximum accepted request body in bytes; deployments ingesting
+		// large workflow exports raise this past the 1MB default
+		BodyLimit: getEnvAsInt("SERVER_BODY_LIMIT", 1024*1024, 1024, 1024*1024*1024),
+	}
+}
+
 // loadDatabaseConfig loads and validates database configuration
 func loadDatabaseConfig() DatabaseConfig {
 	return DatabaseConfig{
@@ -111,6 +169,7 @@ func loadDatabaseConfig() DatabaseConfig {
 		SSLMode:           getEnvOrDefault("DB_SSL_MODE", "verify-full"),
 		EnableSharding:    getEnvAsBool("DB_ENABLE_SHARDING", false),
 		ShardCount:        getEnvAsInt("DB_SHARD_COUNT", 1, 1, 100),
+		ResidencyRegions:  getEnvAsStringMap("DB_RESIDENCY_REGIONS"),
 	}
 }
 
@@ -126,6 +185,16 @@ func loadEngineConfig() EngineConfig {
 		EnableCircuitBreaker: getEnvAsBool("ENGINE_ENABLE_CIRCUIT_BREAKER", true),
 		ErrorThreshold:       getEnvAsFloat("ENGINE_ERROR_THRESHOLD", 0.5, 0.0, 1.0),
 		BreakDuration:       getEnvAsDuration("ENGINE_BREAK_DURATION", time.Minute, time.Second*30, time.Hour),
+		MaintenanceMode:   getEnvAsBool("ENGINE_MAINTENANCE_MODE", false),
+		MaintenanceReason: getEnvOrDefault("ENGINE_MAINTENANCE_REASON", "maintenance mode enabled at startup"),
+		AIBatchingEnabled:    getEnvAsBool("ENGINE_AI_BATCHING", false),
+		AIBatchMaxSize:       getEnvAsInt("ENGINE_AI_BATCH_SIZE", 16, 1, 256),
+		AIBatchLatencyBudget: getEnvAsDuration("ENGINE_AI_BATCH_LATENCY", 25*time.Millisecond, time.Millisecond, time.Second),
+		BudgetMaxNodes:         getEnvAsInt("ENGINE_BUDGET_MAX_NODES", 0, 0, 1000000),
+		BudgetMaxWallClock:     getEnvAsDuration("ENGINE_BUDGET_MAX_WALL_CLOCK", 0, 0, time.Hour*24),
+		BudgetMaxExternalCalls: getEnvAsInt("ENGINE_BUDGET_MAX_EXTERNAL_CALLS", 0, 0, 1000000),
+		BudgetMaxAITokens:      getEnvAsInt("ENGINE_BUDGET_MAX_AI_TOKENS", 0, 0, 100000000),
+		QueueCapacity:          getEnvAsInt("ENGINE_QUEUE_CAPACITY", 1000, 1, 100000),
 	}
 }
 
@@ -140,6 +209,20 @@ func loadMonitoringConfig() MonitoringConfig {
 		HealthCheckEndpoint:  getEnvOrDefault("HEALTH_CHECK_ENDPOINT", "/health"),
 		HealthCheckInterval: getEnvAsDuration("HEALTH_CHECK_INTERVAL", defaultHealthInterval, time.Second*5, time.Minute*5),
 		EnableDetailedMetrics: getEnvAsBool("ENABLE_DETAILED_METRICS", true),
+		MetricTenantAllowlist:   getEnvAsList("METRIC_TENANT_ALLOWLIST", nil),
+		MetricWorkflowAllowlist: getEnvAsList("METRIC_WORKFLOW_ALLOWLIST", nil),
+		HistogramBuckets:        getEnvAsBucketMap("METRIC_HISTOGRAM_BUCKETS"),
+		EnableNativeHistograms:  getEnvAsBool("ENABLE_NATIVE_HISTOGRAMS", false),
+	}
+}
+
+// loadRedisConfig loads Redis connection and caching configuration
+func loadRedisConfig() RedisConfig {
+	return RedisConfig{
+		Address:      getEnvOrDefault("REDIS_ADDRESS", ""),
+		Password:     getEnvOrDefault("REDIS_PASSWORD", ""),
+		DB:           getEnvAsInt("REDIS_DB", 0, 0, 15),
+		NodeCacheTTL: getEnvAsDuration("NODE_CACHE_TTL", time.Minute*5, time.Second, time.Hour*24),
 	}
 }
 
@@ -157,6 +240,10 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("monitoring configuration error: %w", err)
 	}
 
+	if err := c.validateCompliance(); err != nil {
+		return fmt.Errorf("compliance configuration error: %w", err)
+	}
+
 	return c.validateCrossConfig()
 }
 
@@ -267,6 +354,84 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	return value
 }
 
+func getEnvAsList(key string, defaultValue []string) []string {
+	strValue, exists := os.LookupEnv(key)
+	if !exists || strValue == "" {
+		return defaultValue
+	}
+
+	values := make([]string, 0)
+	for _, value := range strings.Split(strValue, ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvAsStringMap parses comma-separated key=value pairs in the form
+// "eu=db-eu.internal,us=db-us.internal". Entries without both sides are
+// skipped; an empty result returns nil
+func getEnvAsStringMap(key string) map[string]string {
+	strValue, exists := os.LookupEnv(key)
+	if !exists || strValue == "" {
+		return nil
+	}
+
+	values := make(map[string]string)
+	for _, entry := range strings.Split(strValue, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			continue
+		}
+		values[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// getEnvAsBucketMap parses per-family histogram bucket overrides in the
+// form "family:0.1,0.5,1;other_family:1,5,10". Families with unparsable
+// or non-ascending boundaries are skipped
+func getEnvAsBucketMap(key string) map[string][]float64 {
+	strValue, exists := os.LookupEnv(key)
+	if !exists || strValue == "" {
+		return nil
+	}
+
+	buckets := make(map[string][]float64)
+	for _, entry := range strings.Split(strValue, ";") {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			continue
+		}
+
+		boundaries := make([]float64, 0)
+		valid := true
+		for _, field := range strings.Split(parts[1], ",") {
+			value, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+			if err != nil || (len(boundaries) > 0 && value <= boundaries[len(boundaries)-1]) {
+				valid = false
+				break
+			}
+			boundaries = append(boundaries, value)
+		}
+
+		if valid && len(boundaries) > 0 {
+			buckets[parts[0]] = boundaries
+		}
+	}
+
+	if len(buckets) == 0 {
+		return nil
+	}
+	return buckets
+}
+
 func getEnvAsFloat(key string, defaultValue, minValue, maxValue float64) float64 {
 	strValue, exists := os.LookupEnv(key)
 	if !exists {
@@ -0,0 +1,75 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// ActiveNode is one node currently running within an execution
+type ActiveNode struct {
+    NodeID   uuid.UUID       `json:"node_id"`
+    Name     string          `json:"name"`
+    NodeType models.NodeType `json:"node_type"`
+    Since    time.Time       `json:"since"`
+}
+
+// ActiveExecution describes one in-flight run for operator visibility
+// into what is consuming the engine's concurrency budget
+type ActiveExecution struct {
+    WorkflowID   uuid.UUID          `json:"workflow_id"`
+    ExecutionID  uuid.UUID          `json:"execution_id"`
+    Tenant       string             `json:"tenant,omitempty"`
+    Status       string             `json:"status"`
+    StartedAt    time.Time          `json:"started_at"`
+    CurrentNodes []ActiveNode       `json:"current_nodes"`
+    TokenUsage   *models.TokenUsage `json:"token_usage,omitempty"`
+}
+
+// ActiveExecutionDetails snapshots every in-flight execution with the
+// nodes it is currently running
+func (e *Executor) ActiveExecutionDetails() []ActiveExecution {
+    e.mu.RLock()
+    defer e.mu.RUnlock()
+
+    details := make([]ActiveExecution, 0, len(e.activeExecutions))
+    for _, execCtx := range e.activeExecutions {
+        execCtx.mu.RLock()
+        entry := ActiveExecution{
+            WorkflowID:   execCtx.workflowID,
+            ExecutionID:  execCtx.executionID,
+            Tenant:       execCtx.tenant,
+            Status:       string(execCtx.status),
+            StartedAt:    execCtx.startTime,
+            CurrentNodes: make([]ActiveNode, 0),
+        }
+        if execCtx.tokenUsage != nil {
+            usage := *execCtx.tokenUsage
+            entry.TokenUsage = &usage
+        }
+        for nodeID, state := range execCtx.nodeStates {
+            if state.status != StatusRunning {
+                continue
+            }
+            entry.CurrentNodes = append(entry.CurrentNodes, ActiveNode{
+                NodeID:   nodeID,
+                Name:     state.nodeName,
+                NodeType: state.nodeType,
+                Since:    state.startTime,
+            })
+        }
+        execCtx.mu.RUnlock()
+        details = append(details, entry)
+    }
+
+    return details
+}
+
+// ActiveExecutionDetails exposes the executor's in-flight snapshot on
+// the engine
+func (e *Engine) ActiveExecutionDetails() []ActiveExecution {
+    return e.executor.ActiveExecutionDetails()
+}
@@ -0,0 +1,223 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    aipb "github.com/organization/workflow-engine/api/proto/ai/v1"
+)
+
+// Common batching errors
+var (
+    ErrBatcherClosed = errors.New("ai request batcher is closed")
+)
+
+// AI batching constants
+const (
+    // defaultMaxBatchSize caps how many requests are coalesced into one
+    // gRPC call to the AI service
+    defaultMaxBatchSize = 16
+
+    // defaultLatencyBudget bounds how long a request may wait for the
+    // batch to fill before it is flushed anyway
+    defaultLatencyBudget = 25 * time.Millisecond
+)
+
+// Metrics collectors
+var (
+    aiBatchSize = prometheus.NewHistogram(
+        prometheus.HistogramOpts{
+            Name: "workflow_ai_batch_size",
+            Help: "Number of AI requests coalesced per batched call",
+            Buckets: []float64{1, 2, 4, 8, 16, 32, 64},
+        },
+    )
+
+    aiBatchedRequestTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_ai_batched_request_total",
+            Help: "Total number of AI requests sent through the batching layer",
+        },
+        []string{"status"},
+    )
+)
+
+// BatchInvoker performs a single batched call against the AI service and
+// returns one result per request in order
+type BatchInvoker func(ctx context.Context, requests []*aipb.TaskRequest) ([]*aipb.TaskResponse, error)
+
+// batchItem pairs a pending request with its response channel
+type batchItem struct {
+    ctx      context.Context
+    request  *aipb.TaskRequest
+    response chan batchResult
+}
+
+// batchResult carries the outcome of a batched request back to its caller
+type batchResult struct {
+    output *aipb.TaskResponse
+    err    error
+}
+
+// AIBatcherConfig holds configuration for the AI request batcher
+type AIBatcherConfig struct {
+    MaxBatchSize  int
+    LatencyBudget time.Duration
+}
+
+// AIBatcher coalesces many small AI task requests from concurrent
+// executions into batched gRPC calls, reducing per-call overhead and cost
+type AIBatcher struct {
+    invoker  BatchInvoker
+    config   AIBatcherConfig
+    pending  chan *batchItem
+    shutdown chan struct{}
+}
+
+// NewAIBatcher creates a new AI request batcher and starts its flush loop
+func NewAIBatcher(invoker BatchInvoker, config AIBatcherConfig) *AIBatcher {
+    if config.MaxBatchSize <= 0 {
+        config.MaxBatchSize = defaultMaxBatchSize
+    }
+    if config.LatencyBudget <= 0 {
+        config.LatencyBudget = defaultLatencyBudget
+    }
+
+    // Register metrics
+    registerMetrics(aiBatchSize, aiBatchedRequestTotal)
+
+    batcher := &AIBatcher{
+        invoker:  invoker,
+        config:   config,
+        pending:  make(chan *batchItem, config.MaxBatchSize*4),
+        shutdown: make(chan struct{}),
+    }
+
+    go batcher.flushLoop()
+
+    return batcher
+}
+
+// Submit enqueues a request and blocks until its batched call completes
+// or the context is canceled
+func (b *AIBatcher) Submit(ctx context.Context, request *aipb.TaskRequest) (*aipb.TaskResponse, error) {
+    item := &batchItem{
+        ctx:      ctx,
+        request:  request,
+        response: make(chan batchResult, 1),
+    }
+
+    // Checked before enqueueing: once shutdown begins, the buffered queue
+    // must not accept work, or the select below could prefer the ready
+    // send over the closed shutdown channel and strand the caller
+    select {
+    case <-b.shutdown:
+        return nil, ErrBatcherClosed
+    default:
+    }
+
+    select {
+    case b.pending <- item:
+    case <-b.shutdown:
+        return nil, ErrBatcherClosed
+    case <-ctx.Done():
+        return nil, ctx.Err()
+    }
+
+    select {
+    case result := <-item.response:
+        return result.output, result.err
+    case <-ctx.Done():
+        return nil, ctx.Err()
+    }
+}
+
+// Shutdown stops the flush loop; in-flight batches complete, queued
+// requests fail with ErrBatcherClosed
+func (b *AIBatcher) Shutdown() {
+    close(b.shutdown)
+}
+
+// flushLoop accumulates requests until the batch is full or the latency
+// budget for the oldest request expires
+func (b *AIBatcher) flushLoop() {
+    batch := make([]*batchItem, 0, b.config.MaxBatchSize)
+    timer := time.NewTimer(b.config.LatencyBudget)
+    if !timer.Stop() {
+        <-timer.C
+    }
+
+    for {
+        select {
+        case item := <-b.pending:
+            if len(batch) == 0 {
+                timer.Reset(b.config.LatencyBudget)
+            }
+            batch = append(batch, item)
+            if len(batch) >= b.config.MaxBatchSize {
+                if !timer.Stop() {
+                    <-timer.C
+                }
+                b.flush(batch)
+                batch = batch[:0]
+            }
+
+        case <-timer.C:
+            if len(batch) > 0 {
+                b.flush(batch)
+                batch = batch[:0]
+            }
+
+        case <-b.shutdown:
+            for _, item := range batch {
+                item.response <- batchResult{err: ErrBatcherClosed}
+            }
+            // A Submit that passed its shutdown check concurrently may
+            // still land in the queue; keep failing stragglers so no
+            // caller is left waiting on a loop that has exited
+            go func() {
+                for item := range b.pending {
+                    item.response <- batchResult{err: ErrBatcherClosed}
+                }
+            }()
+            return
+        }
+    }
+}
+
+// flush sends the accumulated batch as a single call and fans results
+// back out to the waiting callers
+func (b *AIBatcher) flush(batch []*batchItem) {
+    aiBatchSize.Observe(float64(len(batch)))
+
+    requests := make([]*aipb.TaskRequest, len(batch))
+    for i, item := range batch {
+        requests[i] = item.request
+    }
+
+    // The batched call runs under its own deadline rather than any single
+    // caller's context so one canceled caller can't fail the whole batch
+    ctx, cancel := context.WithTimeout(context.Background(), defaultExecutionTimeout)
+    defer cancel()
+
+    results, err := b.invoker(ctx, requests)
+    if err != nil || len(results) != len(batch) {
+        if err == nil {
+            err = errors.New("ai batch result count mismatch")
+        }
+        for _, item := range batch {
+            aiBatchedRequestTotal.WithLabelValues("failed").Inc()
+            item.response <- batchResult{err: err}
+        }
+        return
+    }
+
+    for i, item := range batch {
+        aiBatchedRequestTotal.WithLabelValues("success").Inc()
+        item.response <- batchResult{output: results[i]}
+    }
+}
@@ -0,0 +1,107 @@
+// Package core provides the workflow execution engine functionality
+package core
+
+import (
+    "github.com/prometheus/client_golang/prometheus"
+)
+
+// nativeHistogramBucketFactor is the growth factor used for sparse native
+// histogram buckets when native histograms are enabled
+const nativeHistogramBucketFactor = 1.1
+
+// histogramFamily describes one configurable histogram: its default
+// options and how to rebuild the package-level collector with new ones
+type histogramFamily struct {
+    opts    prometheus.HistogramOpts
+    rebuild func(opts prometheus.HistogramOpts)
+}
+
+// configurableHistograms maps metric family names to their rebuilders so
+// bucket boundaries can be overridden from configuration. Rebuilding only
+// works before the collectors are registered, i.e. before the engine and
+// its components are constructed
+var configurableHistograms = map[string]histogramFamily{
+    "workflow_execution_duration_seconds": {
+        opts: prometheus.HistogramOpts{
+            Name:    "workflow_execution_duration_seconds",
+            Help:    "Duration of workflow executions",
+            Buckets: []float64{0.1, 0.5, 1, 2, 5, 10, 30},
+        },
+        rebuild: func(opts prometheus.HistogramOpts) {
+            workflowExecutionDuration = prometheus.NewHistogramVec(opts, []string{"status", "type"})
+        },
+    },
+    "workflow_node_execution_duration_seconds": {
+        opts: prometheus.HistogramOpts{
+            Name:    "workflow_node_execution_duration_seconds",
+            Help:    "Duration of node executions in seconds",
+            Buckets: []float64{0.1, 0.5, 1, 2, 5, 10, 30},
+        },
+        rebuild: func(opts prometheus.HistogramOpts) {
+            nodeExecutionDuration = prometheus.NewHistogramVec(opts, []string{"node_type"})
+        },
+    },
+    "scheduled_workflow_latency_seconds": {
+        opts: prometheus.HistogramOpts{
+            Name:    "scheduled_workflow_latency_seconds",
+            Help:    "Latency of scheduled workflow executions",
+            Buckets: []float64{0.1, 0.5, 1, 2, 5, 10, 30},
+        },
+        rebuild: func(opts prometheus.HistogramOpts) {
+            scheduledWorkflowLatency = prometheus.NewHistogramVec(opts, []string{"type"})
+        },
+    },
+    "workflow_recovery_scan_duration_seconds": {
+        opts: prometheus.HistogramOpts{
+            Name:    "workflow_recovery_scan_duration_seconds",
+            Help:    "Duration of the startup orphaned execution scan",
+            Buckets: []float64{0.1, 0.5, 1, 2, 5, 10, 30},
+        },
+        rebuild: func(opts prometheus.HistogramOpts) {
+            recoveryScanDuration = prometheus.NewHistogram(opts)
+        },
+    },
+    "workflow_ai_batch_size": {
+        opts: prometheus.HistogramOpts{
+            Name:    "workflow_ai_batch_size",
+            Help:    "Number of AI requests coalesced per batched call",
+            Buckets: []float64{1, 2, 4, 8, 16, 32, 64},
+        },
+        rebuild: func(opts prometheus.HistogramOpts) {
+            aiBatchSize = prometheus.NewHistogram(opts)
+        },
+    },
+    "workflow_execution_duration_by_workflow_seconds": {
+        opts: prometheus.HistogramOpts{
+            Name:    "workflow_execution_duration_by_workflow_seconds",
+            Help:    "Workflow execution duration by tenant and workflow, limited to allowlisted label values",
+            Buckets: []float64{1, 5, 10, 30, 60, 300, 600},
+        },
+        rebuild: func(opts prometheus.HistogramOpts) {
+            workflowDurationByLabel = prometheus.NewHistogramVec(opts, []string{"tenant", "workflow_id"})
+        },
+    },
+}
+
+// ConfigureHistograms overrides histogram bucket boundaries per metric
+// family and optionally enables sparse native histograms. It must be
+// called before the engine is constructed; families without an override
+// keep their defaults
+func ConfigureHistograms(buckets map[string][]float64, nativeHistograms bool) {
+    for name, family := range configurableHistograms {
+        override, overridden := buckets[name]
+
+        if !overridden && !nativeHistograms {
+            continue
+        }
+
+        opts := family.opts
+        if overridden && len(override) > 0 {
+            opts.Buckets = override
+        }
+        if nativeHistograms {
+            opts.NativeHistogramBucketFactor = nativeHistogramBucketFactor
+        }
+        family.rebuild(opts)
+    }
+}
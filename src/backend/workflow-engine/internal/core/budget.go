@@ -0,0 +1,158 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "errors"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common budget errors
+var (
+    ErrBudgetExceeded = errors.New("execution budget exceeded")
+)
+
+// Metrics collectors
+var (
+    budgetExceededTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_budget_exceeded_total",
+            Help: "Total number of executions failed by budget enforcement",
+        },
+        []string{"resource"},
+    )
+)
+
+// ExecutionBudget bounds the resources a single execution may consume.
+// Zero values mean unlimited
+type ExecutionBudget struct {
+    MaxNodes         int
+    MaxWallClock     time.Duration
+    MaxExternalCalls int
+    MaxAITokens      int
+}
+
+// BudgetPolicy resolves the budget for an execution, preferring a
+// per-workflow budget, then the tenant's budget, then the default
+type BudgetPolicy struct {
+    Default     ExecutionBudget
+    PerWorkflow map[uuid.UUID]ExecutionBudget
+    PerTenant   map[string]ExecutionBudget
+}
+
+// Resolve returns the budget that applies to the given workflow
+func (p *BudgetPolicy) Resolve(workflow *models.Workflow) ExecutionBudget {
+    if budget, ok := p.PerWorkflow[workflow.ID]; ok {
+        return budget
+    }
+    if tenant, ok := workflow.Metadata["tenant_id"].(string); ok {
+        if budget, ok := p.PerTenant[tenant]; ok {
+            return budget
+        }
+    }
+    return p.Default
+}
+
+// budgetTracker enforces an execution budget as resources are consumed
+type budgetTracker struct {
+    mu            sync.Mutex
+    budget        ExecutionBudget
+    startTime     time.Time
+    nodesExecuted int
+    externalCalls int
+    aiTokens      int
+}
+
+// newBudgetTracker creates a tracker for one execution
+func newBudgetTracker(budget ExecutionBudget) *budgetTracker {
+    return &budgetTracker{
+        budget:    budget,
+        startTime: time.Now(),
+    }
+}
+
+// chargeNode accounts for one node execution and checks the node and
+// wall-clock budgets
+func (t *budgetTracker) chargeNode() error {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+
+    t.nodesExecuted++
+    if t.budget.MaxNodes > 0 && t.nodesExecuted > t.budget.MaxNodes {
+        budgetExceededTotal.WithLabelValues("nodes").Inc()
+        return fmt.Errorf("%w: node limit %d reached", ErrBudgetExceeded, t.budget.MaxNodes)
+    }
+
+    if t.budget.MaxWallClock > 0 && time.Since(t.startTime) > t.budget.MaxWallClock {
+        budgetExceededTotal.WithLabelValues("wall_clock").Inc()
+        return fmt.Errorf("%w: wall clock limit %s reached", ErrBudgetExceeded, t.budget.MaxWallClock)
+    }
+
+    return nil
+}
+
+// chargeExternalCalls accounts for external calls made by a node
+func (t *budgetTracker) chargeExternalCalls(count int) error {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+
+    t.externalCalls += count
+    if t.budget.MaxExternalCalls > 0 && t.externalCalls > t.budget.MaxExternalCalls {
+        budgetExceededTotal.WithLabelValues("external_calls").Inc()
+        return fmt.Errorf("%w: external call limit %d reached", ErrBudgetExceeded, t.budget.MaxExternalCalls)
+    }
+    return nil
+}
+
+// chargeAITokens accounts for AI tokens consumed by a node
+func (t *budgetTracker) chargeAITokens(tokens int) error {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+
+    t.aiTokens += tokens
+    if t.budget.MaxAITokens > 0 && t.aiTokens > t.budget.MaxAITokens {
+        budgetExceededTotal.WithLabelValues("ai_tokens").Inc()
+        return fmt.Errorf("%w: AI token limit %d reached", ErrBudgetExceeded, t.budget.MaxAITokens)
+    }
+    return nil
+}
+
+// SetBudgetPolicy enables per-execution resource budget enforcement
+func (e *Executor) SetBudgetPolicy(policy *BudgetPolicy) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.budgetPolicy = policy
+}
+
+// ChargeExternalCalls charges external calls against the active execution
+// of a workflow. Node executors call this around outbound requests
+func (e *Executor) ChargeExternalCalls(workflowID uuid.UUID, count int) error {
+    e.mu.RLock()
+    execCtx, exists := e.activeExecutions[workflowID]
+    e.mu.RUnlock()
+
+    if !exists || execCtx.budget == nil {
+        return nil
+    }
+    return execCtx.budget.chargeExternalCalls(count)
+}
+
+// ChargeAITokens charges AI token usage against the active execution of a
+// workflow. The AI task executor calls this with reported token counts
+func (e *Executor) ChargeAITokens(workflowID uuid.UUID, tokens int) error {
+    e.mu.RLock()
+    execCtx, exists := e.activeExecutions[workflowID]
+    e.mu.RUnlock()
+
+    if !exists || execCtx.budget == nil {
+        return nil
+    }
+    return execCtx.budget.chargeAITokens(tokens)
+}
@@ -0,0 +1,155 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "fmt"
+    "time"
+
+    "github.com/go-redis/redis/v8" // v8.11.5
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Node cache constants
+const (
+    // nodeCacheKeyPrefix namespaces cache entries in Redis
+    nodeCacheKeyPrefix = "workflow:node-cache:"
+
+    // defaultNodeCacheTTL bounds cache entries when neither the node nor
+    // the cache configure a TTL
+    defaultNodeCacheTTL = 5 * time.Minute
+)
+
+// Metrics collectors
+var (
+    nodeCacheRequests = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_node_cache_requests_total",
+            Help: "Total number of node result cache lookups",
+        },
+        []string{"node_type", "result"},
+    )
+)
+
+// NodeResultCache caches the outputs of idempotent nodes keyed by a hash
+// of their resolved inputs, so expensive AI or HTTP nodes are not
+// re-invoked for identical inputs within the TTL window
+type NodeResultCache struct {
+    client     *redis.Client
+    defaultTTL time.Duration
+}
+
+// NewNodeResultCache creates a Redis-backed node result cache
+func NewNodeResultCache(client *redis.Client, defaultTTL time.Duration) *NodeResultCache {
+    if defaultTTL <= 0 {
+        defaultTTL = defaultNodeCacheTTL
+    }
+
+    // Register metrics
+    registerMetrics(nodeCacheRequests)
+
+    return &NodeResultCache{
+        client:     client,
+        defaultTTL: defaultTTL,
+    }
+}
+
+// cacheSettings reads the node's opt-in cache configuration. Caching is
+// off unless the node sets "cache": {"enabled": true}
+func cacheSettings(node *models.Node) (bool, time.Duration) {
+    settings, ok := node.Config["cache"].(map[string]interface{})
+    if !ok {
+        return false, 0
+    }
+
+    enabled, _ := settings["enabled"].(bool)
+    if !enabled {
+        return false, 0
+    }
+
+    var ttl time.Duration
+    if seconds, ok := settings["ttl_seconds"].(float64); ok && seconds > 0 {
+        ttl = time.Duration(seconds) * time.Second
+    }
+    return true, ttl
+}
+
+// cacheKey derives a stable key from the node identity, its configuration,
+// and the resolved input payload
+func cacheKey(node *models.Node, input map[string]interface{}) (string, error) {
+    payload, err := json.Marshal(struct {
+        NodeID string                 `json:"node_id"`
+        Type   string                 `json:"type"`
+        Config map[string]interface{} `json:"config"`
+        Input  map[string]interface{} `json:"input"`
+    }{
+        NodeID: node.ID.String(),
+        Type:   string(node.Type),
+        Config: node.Config,
+        Input:  input,
+    })
+    if err != nil {
+        return "", fmt.Errorf("failed to marshal cache key payload: %w", err)
+    }
+
+    sum := sha256.Sum256(payload)
+    return nodeCacheKeyPrefix + hex.EncodeToString(sum[:]), nil
+}
+
+// Get returns the cached output for the node and input, or false when the
+// node is not cacheable or no fresh entry exists
+func (c *NodeResultCache) Get(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, bool) {
+    enabled, _ := cacheSettings(node)
+    if !enabled || c.client == nil {
+        return nil, false
+    }
+
+    key, err := cacheKey(node, input)
+    if err != nil {
+        return nil, false
+    }
+
+    data, err := c.client.Get(ctx, key).Bytes()
+    if err != nil {
+        nodeCacheRequests.WithLabelValues(string(node.Type), "miss").Inc()
+        return nil, false
+    }
+
+    var output map[string]interface{}
+    if err := json.Unmarshal(data, &output); err != nil {
+        nodeCacheRequests.WithLabelValues(string(node.Type), "miss").Inc()
+        return nil, false
+    }
+
+    nodeCacheRequests.WithLabelValues(string(node.Type), "hit").Inc()
+    return output, true
+}
+
+// Put stores a successful node output under its input hash with the
+// node's TTL, falling back to the cache default
+func (c *NodeResultCache) Put(ctx context.Context, node *models.Node, input, output map[string]interface{}) {
+    enabled, ttl := cacheSettings(node)
+    if !enabled || c.client == nil {
+        return
+    }
+    if ttl <= 0 {
+        ttl = c.defaultTTL
+    }
+
+    key, err := cacheKey(node, input)
+    if err != nil {
+        return
+    }
+
+    data, err := json.Marshal(output)
+    if err != nil {
+        return
+    }
+
+    c.client.Set(ctx, key, data, ttl)
+}
@@ -0,0 +1,152 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+)
+
+// Calendar scheduling constants
+const (
+    calendarDateLayout = "2006-01-02"
+    calendarTimeLayout = "15:04"
+)
+
+// BusinessCalendar tracks holidays and determines business days for
+// calendar-based scheduling with thread-safe operations
+type BusinessCalendar struct {
+    mu       sync.RWMutex
+    holidays map[string]bool
+    location *time.Location
+}
+
+// NewBusinessCalendar creates a business calendar in the given location
+func NewBusinessCalendar(location *time.Location) *BusinessCalendar {
+    if location == nil {
+        location = time.UTC
+    }
+
+    return &BusinessCalendar{
+        holidays: make(map[string]bool),
+        location: location,
+    }
+}
+
+// AddHoliday registers a holiday date in "2006-01-02" format
+func (c *BusinessCalendar) AddHoliday(date string) error {
+    if _, err := time.ParseInLocation(calendarDateLayout, date, c.location); err != nil {
+        return fmt.Errorf("%w: invalid holiday date %s", ErrInvalidSchedule, date)
+    }
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.holidays[date] = true
+    return nil
+}
+
+// IsBusinessDay reports whether the given time falls on a business day,
+// excluding weekends and registered holidays
+func (c *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+    t = t.In(c.location)
+    if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+        return false
+    }
+
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+    return !c.holidays[t.Format(calendarDateLayout)]
+}
+
+// NextRun computes the next execution time at the given wall-clock time on a
+// business day, starting from the reference time
+func (c *BusinessCalendar) NextRun(from time.Time, at string) (time.Time, error) {
+    wallClock, err := time.ParseInLocation(calendarTimeLayout, at, c.location)
+    if err != nil {
+        return time.Time{}, fmt.Errorf("%w: invalid time of day %s", ErrInvalidSchedule, at)
+    }
+
+    from = from.In(c.location)
+    candidate := time.Date(from.Year(), from.Month(), from.Day(),
+        wallClock.Hour(), wallClock.Minute(), 0, 0, c.location)
+
+    if !candidate.After(from) {
+        candidate = candidate.AddDate(0, 0, 1)
+    }
+
+    // Advance past weekends and holidays, bounded to avoid spinning on a
+    // calendar that is entirely holidays
+    for i := 0; i < 366; i++ {
+        if c.IsBusinessDay(candidate) {
+            return candidate, nil
+        }
+        candidate = candidate.AddDate(0, 0, 1)
+    }
+
+    return time.Time{}, fmt.Errorf("%w: no business day found within a year", ErrInvalidSchedule)
+}
+
+// calendarConfigFromSchedule extracts calendar schedule parameters from a
+// schedule configuration. A calendar_id reference resolves a shared
+// calendar from the registry; inline holidays are layered on top of it
+func calendarConfigFromSchedule(config map[string]interface{}, location *time.Location, registry *CalendarRegistry) (*BusinessCalendar, string, error) {
+    at, ok := config["time"].(string)
+    if !ok {
+        return nil, "", fmt.Errorf("%w: time of day is required for calendar schedules", ErrInvalidSchedule)
+    }
+
+    calendar := NewBusinessCalendar(location)
+    if rawID, ok := config["calendar_id"].(string); ok {
+        id, err := uuid.Parse(rawID)
+        if err != nil {
+            return nil, "", fmt.Errorf("%w: invalid calendar_id %s", ErrInvalidSchedule, rawID)
+        }
+        if registry == nil {
+            return nil, "", fmt.Errorf("%w: no calendar registry configured", ErrInvalidSchedule)
+        }
+        calendar, err = registry.Business(id, location)
+        if err != nil {
+            return nil, "", fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+        }
+    }
+    if rawHolidays, ok := config["holidays"].([]interface{}); ok {
+        for _, raw := range rawHolidays {
+            date, ok := raw.(string)
+            if !ok {
+                return nil, "", fmt.Errorf("%w: holiday dates must be strings", ErrInvalidSchedule)
+            }
+            if err := calendar.AddHoliday(date); err != nil {
+                return nil, "", err
+            }
+        }
+    }
+
+    // Validate the time of day up front
+    if _, err := calendar.NextRun(time.Now(), at); err != nil {
+        return nil, "", err
+    }
+
+    return calendar, at, nil
+}
+
+// handleCalendarSchedule manages calendar-based scheduling, firing at the
+// configured wall-clock time on business days only
+func (s *Scheduler) handleCalendarSchedule(workflowID uuid.UUID, calendar *BusinessCalendar, at string) {
+    for {
+        next, err := calendar.NextRun(time.Now(), at)
+        if err != nil {
+            return
+        }
+
+        timer := time.NewTimer(time.Until(next))
+        select {
+        case <-s.ctx.Done():
+            timer.Stop()
+            return
+        case <-timer.C:
+            s.executeScheduledWorkflow(workflowID)
+        }
+    }
+}
@@ -0,0 +1,197 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "errors"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+)
+
+// Common calendar registry errors
+var (
+    ErrCalendarNotFound = errors.New("calendar not found")
+    ErrInvalidCalendar  = errors.New("invalid calendar")
+)
+
+// NamedCalendar is a shared business calendar that calendar schedules
+// can reference by ID, so holiday lists are maintained in one place
+// instead of being repeated inline in every schedule configuration
+type NamedCalendar struct {
+    ID        uuid.UUID `json:"id"`
+    Name      string    `json:"name"`
+    Timezone  string    `json:"timezone,omitempty"`
+    Holidays  []string  `json:"holidays"`
+    CreatedAt time.Time `json:"created_at"`
+    UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CalendarRegistry manages named business calendars with thread-safe
+// operations
+type CalendarRegistry struct {
+    mu        sync.RWMutex
+    calendars map[uuid.UUID]*NamedCalendar
+}
+
+// NewCalendarRegistry creates an empty calendar registry
+func NewCalendarRegistry() *CalendarRegistry {
+    return &CalendarRegistry{
+        calendars: make(map[uuid.UUID]*NamedCalendar),
+    }
+}
+
+// validateCalendar checks the name, timezone and holiday dates of a
+// calendar definition
+func validateCalendar(name, timezone string, holidays []string) (*time.Location, error) {
+    if name == "" {
+        return nil, fmt.Errorf("%w: name is required", ErrInvalidCalendar)
+    }
+
+    location := time.UTC
+    if timezone != "" {
+        loc, err := time.LoadLocation(timezone)
+        if err != nil {
+            return nil, fmt.Errorf("%w: unknown timezone %s", ErrInvalidCalendar, timezone)
+        }
+        location = loc
+    }
+
+    for _, date := range holidays {
+        if _, err := time.ParseInLocation(calendarDateLayout, date, location); err != nil {
+            return nil, fmt.Errorf("%w: invalid holiday date %s", ErrInvalidCalendar, date)
+        }
+    }
+    return location, nil
+}
+
+// Create registers a new named calendar. Names must be unique so
+// operators can find calendars without knowing their IDs
+func (r *CalendarRegistry) Create(name, timezone string, holidays []string) (*NamedCalendar, error) {
+    if _, err := validateCalendar(name, timezone, holidays); err != nil {
+        return nil, err
+    }
+
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    for _, existing := range r.calendars {
+        if existing.Name == name {
+            return nil, fmt.Errorf("%w: calendar %s already exists", ErrInvalidCalendar, name)
+        }
+    }
+
+    now := time.Now().UTC()
+    calendar := &NamedCalendar{
+        ID:        uuid.New(),
+        Name:      name,
+        Timezone:  timezone,
+        Holidays:  append([]string(nil), holidays...),
+        CreatedAt: now,
+        UpdatedAt: now,
+    }
+    r.calendars[calendar.ID] = calendar
+    return copyCalendar(calendar), nil
+}
+
+// Update replaces a calendar's definition. Schedules referencing the
+// calendar pick up the change on their next firing computation
+func (r *CalendarRegistry) Update(id uuid.UUID, name, timezone string, holidays []string) (*NamedCalendar, error) {
+    if _, err := validateCalendar(name, timezone, holidays); err != nil {
+        return nil, err
+    }
+
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    calendar, exists := r.calendars[id]
+    if !exists {
+        return nil, ErrCalendarNotFound
+    }
+    for _, existing := range r.calendars {
+        if existing.ID != id && existing.Name == name {
+            return nil, fmt.Errorf("%w: calendar %s already exists", ErrInvalidCalendar, name)
+        }
+    }
+
+    calendar.Name = name
+    calendar.Timezone = timezone
+    calendar.Holidays = append([]string(nil), holidays...)
+    calendar.UpdatedAt = time.Now().UTC()
+    return copyCalendar(calendar), nil
+}
+
+// Get returns the calendar with the given ID
+func (r *CalendarRegistry) Get(id uuid.UUID) (*NamedCalendar, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+
+    calendar, exists := r.calendars[id]
+    if !exists {
+        return nil, ErrCalendarNotFound
+    }
+    return copyCalendar(calendar), nil
+}
+
+// List returns every registered calendar
+func (r *CalendarRegistry) List() []*NamedCalendar {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+
+    calendars := make([]*NamedCalendar, 0, len(r.calendars))
+    for _, calendar := range r.calendars {
+        calendars = append(calendars, copyCalendar(calendar))
+    }
+    return calendars
+}
+
+// Delete removes a calendar. Installed schedules keep the materialized
+// calendar they resolved at installation time
+func (r *CalendarRegistry) Delete(id uuid.UUID) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    if _, exists := r.calendars[id]; !exists {
+        return ErrCalendarNotFound
+    }
+    delete(r.calendars, id)
+    return nil
+}
+
+// Business materializes the named calendar into a BusinessCalendar in
+// the calendar's own timezone, falling back to the given location when
+// none is configured
+func (r *CalendarRegistry) Business(id uuid.UUID, fallback *time.Location) (*BusinessCalendar, error) {
+    r.mu.RLock()
+    calendar, exists := r.calendars[id]
+    r.mu.RUnlock()
+    if !exists {
+        return nil, ErrCalendarNotFound
+    }
+
+    location := fallback
+    if calendar.Timezone != "" {
+        loc, err := time.LoadLocation(calendar.Timezone)
+        if err != nil {
+            return nil, fmt.Errorf("%w: unknown timezone %s", ErrInvalidCalendar, calendar.Timezone)
+        }
+        location = loc
+    }
+
+    business := NewBusinessCalendar(location)
+    for _, date := range calendar.Holidays {
+        if err := business.AddHoliday(date); err != nil {
+            return nil, err
+        }
+    }
+    return business, nil
+}
+
+// copyCalendar returns a defensive copy so callers cannot mutate
+// registry state
+func copyCalendar(calendar *NamedCalendar) *NamedCalendar {
+    clone := *calendar
+    clone.Holidays = append([]string(nil), calendar.Holidays...)
+    return &clone
+}
@@ -0,0 +1,334 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "math/rand"
+    "sync"
+    "time"
+
+    "github.com/google/uuid"                         // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+    "github.com/opentracing/opentracing-go"          // v1.2.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common canary errors
+var (
+    ErrCanaryNotActive      = errors.New("no active canary for workflow")
+    ErrCanaryAlreadyActive  = errors.New("canary already active for workflow")
+    ErrInvalidCanaryConfig  = errors.New("invalid canary configuration")
+)
+
+// Canary activation constants
+const (
+    defaultCanaryPercentage     = 10
+    defaultCanaryErrorThreshold = 0.2
+    defaultCanaryMinSamples     = 20
+)
+
+// Metrics collectors
+var (
+    canaryExecutionTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_canary_execution_total",
+            Help: "Total number of canary-routed executions by track and status",
+        },
+        []string{"track", "status"},
+    )
+
+    canaryRollbackTotal = prometheus.NewCounter(
+        prometheus.CounterOpts{
+            Name: "workflow_canary_rollback_total",
+            Help: "Total number of automatic canary rollbacks",
+        },
+    )
+)
+
+// CanaryConfig holds configuration for a canary activation
+type CanaryConfig struct {
+    // Percentage of trigger executions routed to the canary version (1-99)
+    Percentage int
+    // ErrorRateThreshold triggers automatic rollback when exceeded (0-1)
+    ErrorRateThreshold float64
+    // MinSamples is the minimum number of canary executions before the
+    // error rate is evaluated for rollback
+    MinSamples int
+}
+
+// canaryState tracks an in-flight canary activation for a workflow
+type canaryState struct {
+    stableVersion int
+    canaryVersion int
+    config        CanaryConfig
+    successes     int
+    failures      int
+    startedAt     time.Time
+    // rolledBack pins routing to the stable version after a rollback; the
+    // canary revision stays the latest published one, so the state must
+    // survive until a newer version supersedes it
+    rolledBack bool
+}
+
+// CanaryController manages blue/green workflow activation where a configurable
+// percentage of triggers run the new version and the rest the stable version,
+// with automatic rollback when the canary error rate exceeds the threshold
+type CanaryController struct {
+    mu       sync.RWMutex
+    canaries map[uuid.UUID]*canaryState
+    rand     *rand.Rand
+}
+
+// NewCanaryController creates a new canary controller instance
+func NewCanaryController() *CanaryController {
+    // Register metrics
+    registerMetrics(canaryExecutionTotal, canaryRollbackTotal)
+
+    return &CanaryController{
+        canaries: make(map[uuid.UUID]*canaryState),
+        rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+    }
+}
+
+// StartCanary activates a new workflow version in canary mode alongside the
+// current stable version
+func (c *CanaryController) StartCanary(ctx context.Context, workflow *models.Workflow, canaryVersion int, config CanaryConfig) error {
+    span, _ := opentracing.StartSpanFromContext(ctx, "StartCanary")
+    defer span.Finish()
+
+    span.SetTag("workflow_id", workflow.ID)
+    span.SetTag("canary_version", canaryVersion)
+
+    if err := validateCanaryConfig(&config); err != nil {
+        return err
+    }
+
+    if canaryVersion <= workflow.Version {
+        return fmt.Errorf("%w: canary version must be newer than stable version %d", ErrInvalidCanaryConfig, workflow.Version)
+    }
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    if state, exists := c.canaries[workflow.ID]; exists && !state.rolledBack {
+        return ErrCanaryAlreadyActive
+    }
+
+    c.canaries[workflow.ID] = &canaryState{
+        stableVersion: workflow.Version,
+        canaryVersion: canaryVersion,
+        config:        config,
+        startedAt:     time.Now().UTC(),
+    }
+
+    return nil
+}
+
+// PickVersion selects the workflow version for a triggered execution. When no
+// canary is active the stable version is always returned.
+func (c *CanaryController) PickVersion(workflowID uuid.UUID, stableVersion int) (version int, canary bool) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    state, exists := c.canaries[workflowID]
+    if !exists {
+        return stableVersion, false
+    }
+
+    if state.rolledBack {
+        // The rollback pin only applies while the rolled-back canary is
+        // still the latest revision; a newer publish supersedes it
+        if stableVersion != state.canaryVersion {
+            delete(c.canaries, workflowID)
+            return stableVersion, false
+        }
+        return state.stableVersion, false
+    }
+
+    if c.rand.Intn(100) < state.config.Percentage {
+        return state.canaryVersion, true
+    }
+
+    return state.stableVersion, false
+}
+
+// RecordResult records the outcome of a canary-routed execution and rolls the
+// canary back automatically if the error rate exceeds the threshold
+func (c *CanaryController) RecordResult(workflowID uuid.UUID, canary bool, execErr error) {
+    track := "stable"
+    if canary {
+        track = "canary"
+    }
+    status := "success"
+    if execErr != nil {
+        status = "failed"
+    }
+    canaryExecutionTotal.WithLabelValues(track, status).Inc()
+
+    if !canary {
+        return
+    }
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    state, exists := c.canaries[workflowID]
+    if !exists || state.rolledBack {
+        return
+    }
+
+    if execErr != nil {
+        state.failures++
+    } else {
+        state.successes++
+    }
+
+    samples := state.failures + state.successes
+    if samples < state.config.MinSamples {
+        return
+    }
+
+    errorRate := float64(state.failures) / float64(samples)
+    if errorRate > state.config.ErrorRateThreshold {
+        state.rolledBack = true
+        canaryRollbackTotal.Inc()
+    }
+}
+
+// PromoteCanary completes a canary activation, making the canary version the
+// new stable version
+func (c *CanaryController) PromoteCanary(workflowID uuid.UUID) (int, error) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    state, exists := c.canaries[workflowID]
+    if !exists || state.rolledBack {
+        return 0, ErrCanaryNotActive
+    }
+
+    delete(c.canaries, workflowID)
+    return state.canaryVersion, nil
+}
+
+// RollbackCanary manually aborts a canary activation
+func (c *CanaryController) RollbackCanary(workflowID uuid.UUID) (int, error) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    state, exists := c.canaries[workflowID]
+    if !exists || state.rolledBack {
+        return 0, ErrCanaryNotActive
+    }
+
+    state.rolledBack = true
+    canaryRollbackTotal.Inc()
+    return state.stableVersion, nil
+}
+
+// GetCanaryStatus returns the current status of a canary activation
+func (c *CanaryController) GetCanaryStatus(workflowID uuid.UUID) (map[string]interface{}, error) {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    state, exists := c.canaries[workflowID]
+    if !exists {
+        return nil, ErrCanaryNotActive
+    }
+
+    samples := state.failures + state.successes
+    errorRate := 0.0
+    if samples > 0 {
+        errorRate = float64(state.failures) / float64(samples)
+    }
+
+    status := "active"
+    if state.rolledBack {
+        status = "rolled_back"
+    }
+
+    return map[string]interface{}{
+        "status":         status,
+        "stable_version": state.stableVersion,
+        "canary_version": state.canaryVersion,
+        "percentage":     state.config.Percentage,
+        "samples":        samples,
+        "error_rate":     errorRate,
+        "started_at":     state.startedAt,
+    }, nil
+}
+
+// The controller observes execution outcomes through the executor's
+// interceptor chain, matching each run's workflow version against the
+// active canary to attribute it to the right track
+var _ ExecutionInterceptor = (*CanaryController)(nil)
+
+// BeforeExecute implements ExecutionInterceptor; canary routing happens
+// at version resolution, never at admission
+func (c *CanaryController) BeforeExecute(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID) error {
+    return nil
+}
+
+// AfterNode implements ExecutionInterceptor; canary evaluation applies to
+// whole executions, not individual nodes
+func (c *CanaryController) AfterNode(ctx context.Context, node *models.Node, output map[string]interface{}, duration time.Duration) {
+}
+
+// OnError implements ExecutionInterceptor, counting workflow-level
+// failures toward the canary error rate
+func (c *CanaryController) OnError(ctx context.Context, workflow *models.Workflow, node *models.Node, err error) {
+    if workflow == nil {
+        return
+    }
+    c.observeOutcome(workflow, err)
+}
+
+// OnComplete implements ExecutionInterceptor, counting successful
+// executions toward the canary sample window
+func (c *CanaryController) OnComplete(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID, duration time.Duration) {
+    c.observeOutcome(workflow, nil)
+}
+
+// observeOutcome attributes an execution outcome to the canary or stable
+// track of an active canary, if any
+func (c *CanaryController) observeOutcome(workflow *models.Workflow, execErr error) {
+    c.mu.RLock()
+    state, exists := c.canaries[workflow.ID]
+    active := exists && !state.rolledBack
+    canary := active && workflow.Version == state.canaryVersion
+    c.mu.RUnlock()
+
+    if !active {
+        return
+    }
+
+    c.RecordResult(workflow.ID, canary, execErr)
+}
+
+// validateCanaryConfig validates and defaults a canary configuration
+func validateCanaryConfig(config *CanaryConfig) error {
+    if config.Percentage == 0 {
+        config.Percentage = defaultCanaryPercentage
+    }
+    if config.Percentage < 1 || config.Percentage > 99 {
+        return fmt.Errorf("%w: percentage must be between 1 and 99", ErrInvalidCanaryConfig)
+    }
+
+    if config.ErrorRateThreshold == 0 {
+        config.ErrorRateThreshold = defaultCanaryErrorThreshold
+    }
+    if config.ErrorRateThreshold < 0 || config.ErrorRateThreshold > 1 {
+        return fmt.Errorf("%w: error rate threshold must be between 0 and 1", ErrInvalidCanaryConfig)
+    }
+
+    if config.MinSamples == 0 {
+        config.MinSamples = defaultCanaryMinSamples
+    }
+    if config.MinSamples < 1 {
+        return fmt.Errorf("%w: minimum samples must be positive", ErrInvalidCanaryConfig)
+    }
+
+    return nil
+}
@@ -0,0 +1,236 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "database/sql"
+    "sort"
+    "sync"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// Capacity monitoring constants
+const (
+    // capacitySampleWindow bounds how many recent samples feed the
+    // derived percentile gauges
+    capacitySampleWindow = 256
+
+    // capacityRefreshInterval is how often the derived gauges are
+    // recomputed so scrapes see fresh values even without API traffic
+    capacityRefreshInterval = 10 * time.Second
+)
+
+// Metrics collectors
+var (
+    concurrencyUtilization = prometheus.NewGauge(
+        prometheus.GaugeOpts{
+            Name: "workflow_engine_concurrency_utilization",
+            Help: "Fraction of the execution concurrency budget currently in use",
+        },
+    )
+
+    queueWaitP95Seconds = prometheus.NewGauge(
+        prometheus.GaugeOpts{
+            Name: "workflow_engine_queue_wait_p95_seconds",
+            Help: "95th percentile time executions spent waiting in the queue, over a recent window",
+        },
+    )
+
+    schedulerDriftP95Seconds = prometheus.NewGauge(
+        prometheus.GaugeOpts{
+            Name: "workflow_engine_scheduler_drift_p95_seconds",
+            Help: "95th percentile delay between a schedule's intended and actual fire time, over a recent window",
+        },
+    )
+
+    dbPoolSaturation = prometheus.NewGauge(
+        prometheus.GaugeOpts{
+            Name: "workflow_engine_db_pool_saturation",
+            Help: "Fraction of the database connection pool currently in use",
+        },
+    )
+)
+
+// sampleWindow is a fixed-size ring of recent duration samples used to
+// derive percentiles without unbounded growth
+type sampleWindow struct {
+    samples []float64
+    next    int
+    filled  bool
+}
+
+// observe records one sample in seconds, overwriting the oldest entry
+// once the window is full
+func (w *sampleWindow) observe(seconds float64) {
+    if len(w.samples) == 0 {
+        w.samples = make([]float64, capacitySampleWindow)
+    }
+    w.samples[w.next] = seconds
+    w.next++
+    if w.next == len(w.samples) {
+        w.next = 0
+        w.filled = true
+    }
+}
+
+// percentile returns the requested percentile over the current window,
+// or zero when no samples have been recorded
+func (w *sampleWindow) percentile(p float64) float64 {
+    count := w.next
+    if w.filled {
+        count = len(w.samples)
+    }
+    if count == 0 {
+        return 0
+    }
+
+    sorted := make([]float64, count)
+    copy(sorted, w.samples[:count])
+    sort.Float64s(sorted)
+
+    index := int(float64(count) * p)
+    if index >= count {
+        index = count - 1
+    }
+    return sorted[index]
+}
+
+// CapacityReport summarizes engine headroom for autoscaler integration
+type CapacityReport struct {
+    ActiveExecutions    int     `json:"active_executions"`
+    MaxConcurrent       int     `json:"max_concurrent"`
+    Headroom            int     `json:"headroom"`
+    Utilization         float64 `json:"utilization"`
+    QueueDepth          int     `json:"queue_depth"`
+    QueueWaitP95Ms      float64 `json:"queue_wait_p95_ms"`
+    SchedulerDriftP95Ms float64 `json:"scheduler_drift_p95_ms"`
+    DBConnectionsInUse  int     `json:"db_connections_in_use"`
+    DBConnectionsMax    int     `json:"db_connections_max"`
+    DBPoolSaturation    float64 `json:"db_pool_saturation"`
+}
+
+// CapacityMonitor derives saturation and headroom gauges from the
+// engine, execution queue, scheduler, and database pool. The queue and
+// database sources are optional; the corresponding fields report zero
+// until they are wired
+type CapacityMonitor struct {
+    mu         sync.Mutex
+    engine     *Engine
+    queue      *ExecutionQueue
+    dbStats    func() sql.DBStats
+    queueWaits sampleWindow
+    drifts     sampleWindow
+    shutdown   chan struct{}
+}
+
+// NewCapacityMonitor creates a capacity monitor over the engine and
+// starts its gauge refresh loop
+func NewCapacityMonitor(engine *Engine) *CapacityMonitor {
+    // Register metrics
+    registerMetrics(concurrencyUtilization, queueWaitP95Seconds, schedulerDriftP95Seconds, dbPoolSaturation)
+
+    monitor := &CapacityMonitor{
+        engine:   engine,
+        shutdown: make(chan struct{}),
+    }
+
+    go monitor.refreshLoop()
+
+    return monitor
+}
+
+// SetQueue attaches the execution queue so queue depth and wait times
+// feed the report
+func (m *CapacityMonitor) SetQueue(queue *ExecutionQueue) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.queue = queue
+}
+
+// SetDBStats attaches a database pool statistics source, typically the
+// Stats method of a repository's connection pool
+func (m *CapacityMonitor) SetDBStats(stats func() sql.DBStats) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.dbStats = stats
+}
+
+// ObserveQueueWait records how long one execution waited for a slot
+func (m *CapacityMonitor) ObserveQueueWait(wait time.Duration) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.queueWaits.observe(wait.Seconds())
+}
+
+// ObserveSchedulerDrift records how far behind its intended fire time a
+// schedule actually fired. Early firings are clamped to zero so clock
+// adjustments do not skew the percentile downward
+func (m *CapacityMonitor) ObserveSchedulerDrift(drift time.Duration) {
+    if drift < 0 {
+        drift = 0
+    }
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.drifts.observe(drift.Seconds())
+}
+
+// Report computes the current capacity summary and refreshes the
+// derived gauges as a side effect
+func (m *CapacityMonitor) Report() CapacityReport {
+    report := CapacityReport{
+        ActiveExecutions: m.engine.ActiveExecutions(),
+        MaxConcurrent:    m.engine.MaxConcurrentExecutions(),
+    }
+    report.Headroom = report.MaxConcurrent - report.ActiveExecutions
+    if report.Headroom < 0 {
+        report.Headroom = 0
+    }
+    if report.MaxConcurrent > 0 {
+        report.Utilization = float64(report.ActiveExecutions) / float64(report.MaxConcurrent)
+    }
+
+    m.mu.Lock()
+    if m.queue != nil {
+        report.QueueDepth = m.queue.Depth()
+    }
+    report.QueueWaitP95Ms = m.queueWaits.percentile(0.95) * 1000
+    report.SchedulerDriftP95Ms = m.drifts.percentile(0.95) * 1000
+    if m.dbStats != nil {
+        stats := m.dbStats()
+        report.DBConnectionsInUse = stats.InUse
+        report.DBConnectionsMax = stats.MaxOpenConnections
+        if stats.MaxOpenConnections > 0 {
+            report.DBPoolSaturation = float64(stats.InUse) / float64(stats.MaxOpenConnections)
+        }
+    }
+    m.mu.Unlock()
+
+    concurrencyUtilization.Set(report.Utilization)
+    queueWaitP95Seconds.Set(report.QueueWaitP95Ms / 1000)
+    schedulerDriftP95Seconds.Set(report.SchedulerDriftP95Ms / 1000)
+    dbPoolSaturation.Set(report.DBPoolSaturation)
+
+    return report
+}
+
+// Stop halts the gauge refresh loop
+func (m *CapacityMonitor) Stop() {
+    close(m.shutdown)
+}
+
+// refreshLoop recomputes the derived gauges on a fixed interval so
+// Prometheus scrapes stay current between API requests
+func (m *CapacityMonitor) refreshLoop() {
+    ticker := time.NewTicker(capacityRefreshInterval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ticker.C:
+            m.Report()
+        case <-m.shutdown:
+            return
+        }
+    }
+}
@@ -0,0 +1,136 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "fmt"
+    "time"
+
+    "github.com/robfig/cron/v3"                      // v3.0.1
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// CatchUpPolicy defines how missed cron runs are handled when the engine was
+// down or scheduling was paused at the scheduled time
+type CatchUpPolicy string
+
+const (
+    // CatchUpSkip ignores missed runs entirely
+    CatchUpSkip CatchUpPolicy = "skip"
+    // CatchUpFireOnce triggers a single catch-up execution regardless of how
+    // many runs were missed
+    CatchUpFireOnce CatchUpPolicy = "fire_once"
+    // CatchUpFireAll triggers one execution per missed run, capped by
+    // maxCatchUpRuns
+    CatchUpFireAll CatchUpPolicy = "fire_all"
+
+    // maxCatchUpRuns caps the number of catch-up executions per schedule
+    maxCatchUpRuns = 10
+)
+
+// CatchUpPolicyMap defines valid catch-up policies for validation
+var CatchUpPolicyMap = map[CatchUpPolicy]bool{
+    CatchUpSkip:     true,
+    CatchUpFireOnce: true,
+    CatchUpFireAll:  true,
+}
+
+// Metrics collectors
+var (
+    catchUpExecutionTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "scheduled_workflow_catchup_total",
+            Help: "Total number of catch-up executions for missed cron runs by policy",
+        },
+        []string{"policy"},
+    )
+
+    missedRunsTotal = prometheus.NewCounter(
+        prometheus.CounterOpts{
+            Name: "scheduled_workflow_missed_runs_total",
+            Help: "Total number of missed cron runs detected",
+        },
+    )
+)
+
+// init registers catch-up metrics
+func init() {
+    registerMetrics(catchUpExecutionTotal, missedRunsTotal)
+}
+
+// catchUpPolicyFromConfig extracts and validates the catch-up policy from a
+// schedule configuration, defaulting to skip
+func catchUpPolicyFromConfig(config map[string]interface{}) (CatchUpPolicy, error) {
+    raw, ok := config["catch_up"]
+    if !ok {
+        return CatchUpSkip, nil
+    }
+
+    policy, ok := raw.(string)
+    if !ok || !CatchUpPolicyMap[CatchUpPolicy(policy)] {
+        return "", fmt.Errorf("%w: unsupported catch-up policy: %v", ErrInvalidSchedule, raw)
+    }
+
+    return CatchUpPolicy(policy), nil
+}
+
+// countMissedRuns counts cron firings between the last run and now
+func countMissedRuns(cronExpr string, lastRun, now time.Time) (int, error) {
+    schedule, err := cron.ParseStandard(cronExpr)
+    if err != nil {
+        return 0, fmt.Errorf("%w: invalid cron expression: %v", ErrInvalidSchedule, err)
+    }
+
+    missed := 0
+    next := schedule.Next(lastRun)
+    for !next.After(now) && missed <= maxCatchUpRuns {
+        missed++
+        next = schedule.Next(next)
+    }
+
+    return missed, nil
+}
+
+// CatchUpMissedRuns detects cron schedules that missed firings while the
+// scheduler was down or paused and applies each schedule's catch-up policy
+func (s *Scheduler) CatchUpMissedRuns() {
+    s.mu.RLock()
+    candidates := make([]*scheduleContext, 0, len(s.activeSchedules))
+    for _, schedCtx := range s.activeSchedules {
+        if schedCtx.cronID != 0 && !schedCtx.lastRun.IsZero() {
+            candidates = append(candidates, schedCtx)
+        }
+    }
+    s.mu.RUnlock()
+
+    now := time.Now()
+    for _, schedCtx := range candidates {
+        cronExpr, ok := schedCtx.config["cron"].(string)
+        if !ok {
+            continue
+        }
+
+        policy, err := catchUpPolicyFromConfig(schedCtx.config)
+        if err != nil || policy == CatchUpSkip {
+            continue
+        }
+
+        missed, err := countMissedRuns(cronExpr, schedCtx.lastRun, now)
+        if err != nil || missed == 0 {
+            continue
+        }
+        missedRunsTotal.Add(float64(missed))
+
+        runs := 1
+        if policy == CatchUpFireAll {
+            runs = missed
+            if runs > maxCatchUpRuns {
+                runs = maxCatchUpRuns
+            }
+        }
+
+        for i := 0; i < runs; i++ {
+            s.executeScheduledWorkflow(schedCtx.workflow.ID)
+            catchUpExecutionTotal.WithLabelValues(string(policy)).Inc()
+        }
+    }
+}
@@ -0,0 +1,56 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+
+    "github.com/google/uuid"
+
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/aiservice"
+)
+
+// WorkflowExecutor is the execution contract the engine depends on.
+// Alternate implementations (e.g. a Temporal adapter or queue-based
+// workers) can stand in for the default Executor as long as they honor
+// the contract verified by the enginetest suite
+type WorkflowExecutor interface {
+    ExecuteWorkflow(ctx context.Context, workflow *models.Workflow) error
+    CancelExecution(workflowID uuid.UUID) error
+    ActiveExecutions() int
+    ActiveExecutionDetails() []ActiveExecution
+    MaxConcurrentExecutions() int
+    RegisterInterceptor(interceptor ExecutionInterceptor)
+    RegisterNodeExecutor(nodeType models.NodeType, executor NodeExecutor)
+    AIClient() *aiservice.Client
+    ModelRouter() *ModelRouter
+    SetNodeResultCache(cache *NodeResultCache)
+    SetEgressEnforcer(egress *EgressEnforcer)
+    SetModerator(moderator *Moderator)
+    SetTuningManager(tuning *TuningManager)
+    SetBudgetPolicy(policy *BudgetPolicy)
+    SetExecutionRecorder(recorder ExecutionRecorder, instanceID string)
+    ChargeExternalCalls(workflowID uuid.UUID, count int) error
+    ChargeAITokens(workflowID uuid.UUID, tokens int) error
+}
+
+// WorkflowScheduler is the scheduling contract the engine depends on
+type WorkflowScheduler interface {
+    ScheduleWorkflow(ctx context.Context, workflow *models.Workflow, scheduleConfig map[string]interface{}) error
+    ReplaceSchedule(ctx context.Context, workflow *models.Workflow, scheduleConfig map[string]interface{}) error
+    UnscheduleWorkflow(ctx context.Context, workflowID uuid.UUID) error
+    PauseSchedule(ctx context.Context, workflowID uuid.UUID) error
+    ResumeSchedule(ctx context.Context, workflowID uuid.UUID) error
+    IsSchedulePaused(workflowID uuid.UUID) (bool, error)
+    SetCapacityMonitor(monitor *CapacityMonitor)
+    SetLeader(leader bool)
+    RecomputeNextRuns()
+    Start()
+    Stop()
+}
+
+// The default implementations must satisfy the contracts
+var (
+    _ WorkflowExecutor  = (*Executor)(nil)
+    _ WorkflowScheduler = (*Scheduler)(nil)
+)
@@ -0,0 +1,190 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "encoding/json"
+    "errors"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+)
+
+// Common debug capture errors
+var (
+    ErrDebugNotEnabled = errors.New("debug capture not enabled for execution")
+)
+
+// Debug capture constants
+const (
+    // maxDebugPayloadSize caps the size of any single captured input or
+    // output payload in bytes
+    maxDebugPayloadSize = 64 * 1024 // 64KB
+
+    // maxDebugEntries caps the number of captured entries per execution
+    maxDebugEntries = 500
+
+    // debugRetention defines how long captured traces are kept in memory
+    debugRetention = time.Hour
+)
+
+// DebugEntry captures the inputs, outputs, and template resolution trace of a
+// single node execution
+type DebugEntry struct {
+    NodeID          uuid.UUID              `json:"node_id"`
+    NodeType        string                 `json:"node_type"`
+    Input           map[string]interface{} `json:"input,omitempty"`
+    Output          map[string]interface{} `json:"output,omitempty"`
+    TemplateTraces  []TemplateTrace        `json:"template_traces,omitempty"`
+    Truncated       bool                   `json:"truncated"`
+    StartedAt       time.Time              `json:"started_at"`
+    CompletedAt     time.Time              `json:"completed_at"`
+    Error           string                 `json:"error,omitempty"`
+}
+
+// TemplateTrace records a single template resolution step
+type TemplateTrace struct {
+    Expression string `json:"expression"`
+    Resolved   string `json:"resolved"`
+    NodeID     uuid.UUID `json:"node_id"`
+}
+
+// debugCapture holds captured debug data for one execution
+type debugCapture struct {
+    entries   []DebugEntry
+    truncated bool
+    createdAt time.Time
+}
+
+// DebugRecorder records full node inputs/outputs and template resolution
+// traces for executions that were started with the debug flag, without
+// enabling heavy capture globally
+type DebugRecorder struct {
+    mu       sync.RWMutex
+    captures map[uuid.UUID]*debugCapture
+}
+
+// NewDebugRecorder creates a new debug recorder instance
+func NewDebugRecorder() *DebugRecorder {
+    recorder := &DebugRecorder{
+        captures: make(map[uuid.UUID]*debugCapture),
+    }
+
+    // Start retention cleanup worker
+    go recorder.cleanupWorker()
+
+    return recorder
+}
+
+// Enable activates debug capture for a single execution
+func (d *DebugRecorder) Enable(executionID uuid.UUID) {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+
+    d.captures[executionID] = &debugCapture{
+        entries:   make([]DebugEntry, 0),
+        createdAt: time.Now().UTC(),
+    }
+}
+
+// Enabled reports whether debug capture is active for an execution
+func (d *DebugRecorder) Enabled(executionID uuid.UUID) bool {
+    d.mu.RLock()
+    defer d.mu.RUnlock()
+
+    _, exists := d.captures[executionID]
+    return exists
+}
+
+// RecordNode captures the inputs and outputs of a node execution, truncating
+// payloads that exceed the size limit
+func (d *DebugRecorder) RecordNode(executionID uuid.UUID, entry DebugEntry) {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+
+    capture, exists := d.captures[executionID]
+    if !exists {
+        return
+    }
+
+    if len(capture.entries) >= maxDebugEntries {
+        capture.truncated = true
+        return
+    }
+
+    entry.Input, entry.Truncated = truncatePayload(entry.Input)
+    if output, truncated := truncatePayload(entry.Output); truncated {
+        entry.Output = output
+        entry.Truncated = true
+    }
+
+    capture.entries = append(capture.entries, entry)
+}
+
+// RecordTemplateTrace appends a template resolution trace to the most recent
+// entry for the given node
+func (d *DebugRecorder) RecordTemplateTrace(executionID uuid.UUID, trace TemplateTrace) {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+
+    capture, exists := d.captures[executionID]
+    if !exists {
+        return
+    }
+
+    for i := len(capture.entries) - 1; i >= 0; i-- {
+        if capture.entries[i].NodeID == trace.NodeID {
+            capture.entries[i].TemplateTraces = append(capture.entries[i].TemplateTraces, trace)
+            return
+        }
+    }
+}
+
+// GetCapture returns the captured debug entries for an execution
+func (d *DebugRecorder) GetCapture(executionID uuid.UUID) ([]DebugEntry, bool, error) {
+    d.mu.RLock()
+    defer d.mu.RUnlock()
+
+    capture, exists := d.captures[executionID]
+    if !exists {
+        return nil, false, ErrDebugNotEnabled
+    }
+
+    entries := make([]DebugEntry, len(capture.entries))
+    copy(entries, capture.entries)
+    return entries, capture.truncated, nil
+}
+
+// cleanupWorker periodically discards expired captures
+func (d *DebugRecorder) cleanupWorker() {
+    ticker := time.NewTicker(debugRetention / 4)
+    defer ticker.Stop()
+
+    for range ticker.C {
+        d.mu.Lock()
+        for id, capture := range d.captures {
+            if time.Since(capture.createdAt) > debugRetention {
+                delete(d.captures, id)
+            }
+        }
+        d.mu.Unlock()
+    }
+}
+
+// truncatePayload enforces the debug payload size limit, replacing oversized
+// payloads with a marker entry
+func truncatePayload(payload map[string]interface{}) (map[string]interface{}, bool) {
+    if payload == nil {
+        return nil, false
+    }
+
+    encoded, err := json.Marshal(payload)
+    if err != nil || len(encoded) <= maxDebugPayloadSize {
+        return payload, false
+    }
+
+    return map[string]interface{}{
+        "_truncated":     true,
+        "_original_size": len(encoded),
+    }, true
+}
@@ -0,0 +1,166 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/go-redis/redis/v8" // v8.11.5
+    "github.com/google/uuid" // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// defaultDedupWindow is how long a seen key suppresses repeat executions
+// when the trigger doesn't configure its own window
+const defaultDedupWindow = time.Hour
+
+// Metrics collectors
+var (
+    deduplicatedExecutionTotal = prometheus.NewCounter(
+        prometheus.CounterOpts{
+            Name: "workflow_deduplicated_execution_total",
+            Help: "Total number of executions skipped because their dedup key was already seen",
+        },
+    )
+)
+
+// DedupStore records seen dedup keys. Implementations must be safe for
+// concurrent use; the Redis-backed store keeps keys across restarts
+type DedupStore interface {
+    // SeenWithin records the key for the window and reports whether it
+    // was already recorded
+    SeenWithin(ctx context.Context, workflowID uuid.UUID, key string, window time.Duration) (bool, error)
+}
+
+// RedisDedupStore persists seen keys in Redis so deduplication stays
+// correct across engine restarts
+type RedisDedupStore struct {
+    client *redis.Client
+}
+
+// NewRedisDedupStore creates a Redis-backed dedup store
+func NewRedisDedupStore(client *redis.Client) *RedisDedupStore {
+    return &RedisDedupStore{client: client}
+}
+
+// SeenWithin implements DedupStore using SETNX with the window as TTL
+func (s *RedisDedupStore) SeenWithin(ctx context.Context, workflowID uuid.UUID, key string, window time.Duration) (bool, error) {
+    stored, err := s.client.SetNX(ctx, fmt.Sprintf("workflow:dedup:%s:%s", workflowID, key), 1, window).Result()
+    if err != nil {
+        return false, fmt.Errorf("failed to record dedup key: %w", err)
+    }
+    return !stored, nil
+}
+
+// MemoryDedupStore keeps seen keys in memory for deployments without
+// Redis. Keys do not survive restarts
+type MemoryDedupStore struct {
+    mu   sync.Mutex
+    seen map[string]time.Time
+}
+
+// NewMemoryDedupStore creates an empty in-memory dedup store
+func NewMemoryDedupStore() *MemoryDedupStore {
+    return &MemoryDedupStore{
+        seen: make(map[string]time.Time),
+    }
+}
+
+// SeenWithin implements DedupStore, sweeping expired keys as it goes
+func (s *MemoryDedupStore) SeenWithin(ctx context.Context, workflowID uuid.UUID, key string, window time.Duration) (bool, error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    now := time.Now()
+    for stored, expiry := range s.seen {
+        if now.After(expiry) {
+            delete(s.seen, stored)
+        }
+    }
+
+    compound := workflowID.String() + ":" + key
+    if _, exists := s.seen[compound]; exists {
+        return true, nil
+    }
+    s.seen[compound] = now.Add(window)
+    return false, nil
+}
+
+// Deduplicator decides whether a trigger event should be skipped based
+// on the trigger node's declared dedup key
+type Deduplicator struct {
+    store DedupStore
+}
+
+// NewDeduplicator creates a deduplicator over the given store
+func NewDeduplicator(store DedupStore) *Deduplicator {
+    registerMetrics(deduplicatedExecutionTotal)
+
+    return &Deduplicator{store: store}
+}
+
+// dedupConfig reads the trigger node's dedup settings. Deduplication is
+// off unless the node declares a dedup block:
+//
+//	"dedup": {"key_field": "order_id", "window_seconds": 3600}
+func dedupConfig(workflow *models.Workflow) (string, time.Duration, bool) {
+    for _, node := range workflow.GetNodes() {
+        if node.Type != models.TriggerNode {
+            continue
+        }
+        settings, ok := node.Config["dedup"].(map[string]interface{})
+        if !ok {
+            return "", 0, false
+        }
+
+        keyField, _ := settings["key_field"].(string)
+        window := defaultDedupWindow
+        if seconds, ok := settings["window_seconds"].(float64); ok && seconds > 0 {
+            window = time.Duration(seconds * float64(time.Second))
+        }
+        return keyField, window, true
+    }
+    return "", 0, false
+}
+
+// ShouldSkip reports whether the event's dedup key was already seen
+// within the trigger's window. Events whose key field is absent are
+// never deduplicated
+func (d *Deduplicator) ShouldSkip(ctx context.Context, workflow *models.Workflow, event map[string]interface{}) (bool, error) {
+    keyField, window, enabled := dedupConfig(workflow)
+    if !enabled {
+        return false, nil
+    }
+
+    // An empty key field hashes the whole payload
+    var keySource interface{} = event
+    if keyField != "" {
+        value, present := event[keyField]
+        if !present {
+            return false, nil
+        }
+        keySource = value
+    }
+
+    encoded, err := json.Marshal(keySource)
+    if err != nil {
+        return false, fmt.Errorf("failed to derive dedup key: %w", err)
+    }
+    digest := sha256.Sum256(encoded)
+
+    seen, err := d.store.SeenWithin(ctx, workflow.ID, hex.EncodeToString(digest[:]), window)
+    if err != nil {
+        return false, err
+    }
+    if seen {
+        deduplicatedExecutionTotal.Inc()
+    }
+    return seen, nil
+}
@@ -0,0 +1,120 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "math/rand"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+)
+
+// deterministicClockTick is how far the injected clock advances on each
+// reading, keeping timestamps distinct but reproducible
+const deterministicClockTick = time.Millisecond
+
+// determinismContextKey carries a Determinism through execution contexts
+type determinismContextKey struct{}
+
+// Determinism fixes the sources of nondeterminism for one execution —
+// random numbers, the clock, and UUID generation — so a run can be
+// reproduced exactly for debugging and golden-file testing
+type Determinism struct {
+    mu    sync.Mutex
+    seed  int64
+    now   time.Time
+    rng   *rand.Rand
+    uuids *rand.Rand
+}
+
+// NewDeterminism creates a determinism source from a seed and a fixed
+// start time. The same seed and start always yield the same sequence of
+// random values, timestamps, and UUIDs. A zero start falls back to a
+// fixed epoch so runs keyed only by seed still reproduce exactly
+func NewDeterminism(seed int64, start time.Time) *Determinism {
+    if start.IsZero() {
+        start = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+    }
+    return &Determinism{
+        seed:  seed,
+        now:   start,
+        rng:   rand.New(rand.NewSource(seed)),
+        uuids: rand.New(rand.NewSource(seed + 1)),
+    }
+}
+
+// Seed returns the seed this source was created with
+func (d *Determinism) Seed() int64 {
+    return d.seed
+}
+
+// Now returns the injected clock's current reading and advances it by a
+// fixed tick so successive readings remain ordered
+func (d *Determinism) Now() time.Time {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+
+    now := d.now
+    d.now = d.now.Add(deterministicClockTick)
+    return now
+}
+
+// Int63 returns the next value from the seeded random stream
+func (d *Determinism) Int63() int64 {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+    return d.rng.Int63()
+}
+
+// Float64 returns the next value in [0, 1) from the seeded random stream
+func (d *Determinism) Float64() float64 {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+    return d.rng.Float64()
+}
+
+// NewUUID returns the next UUID from the seeded generator
+func (d *Determinism) NewUUID() uuid.UUID {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+
+    var id uuid.UUID
+    d.uuids.Read(id[:])
+
+    // Stamp version 4 and variant bits so the result is a valid UUID
+    id[6] = (id[6] & 0x0f) | 0x40
+    id[8] = (id[8] & 0x3f) | 0x80
+    return id
+}
+
+// WithDeterministicRun attaches a seeded determinism source to the
+// context so the execution it starts is reproducible
+func WithDeterministicRun(ctx context.Context, seed int64, start time.Time) context.Context {
+    return context.WithValue(ctx, determinismContextKey{}, NewDeterminism(seed, start))
+}
+
+// DeterminismFromContext returns the determinism source attached to the
+// context, or nil for normal nondeterministic runs. Node executors can
+// use it to seed their own randomness
+func DeterminismFromContext(ctx context.Context) *Determinism {
+    determinism, _ := ctx.Value(determinismContextKey{}).(*Determinism)
+    return determinism
+}
+
+// executionID returns a deterministic or random execution ID depending
+// on whether the context carries a determinism source
+func executionID(ctx context.Context) uuid.UUID {
+    if determinism := DeterminismFromContext(ctx); determinism != nil {
+        return determinism.NewUUID()
+    }
+    return uuid.New()
+}
+
+// executionStartTime returns the injected or wall clock reading
+func executionStartTime(ctx context.Context) time.Time {
+    if determinism := DeterminismFromContext(ctx); determinism != nil {
+        return determinism.Now()
+    }
+    return time.Now()
+}
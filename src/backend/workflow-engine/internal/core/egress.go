@@ -0,0 +1,227 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "net"
+    "net/url"
+    "strings"
+    "sync"
+
+    "github.com/google/uuid" // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// egressBlockedCIDRs are networks outbound nodes may never reach
+// regardless of tenant policy: loopback, RFC 1918, link-local (including
+// cloud metadata endpoints), carrier-grade NAT, and their IPv6 analogues
+var egressBlockedCIDRs = []string{
+    "0.0.0.0/8",
+    "127.0.0.0/8",
+    "10.0.0.0/8",
+    "172.16.0.0/12",
+    "192.168.0.0/16",
+    "169.254.0.0/16",
+    "100.64.0.0/10",
+    "::1/128",
+    "fc00::/7",
+    "fe80::/10",
+}
+
+// Common egress errors
+var (
+    ErrEgressDenied = errors.New("egress denied by policy")
+)
+
+// Metrics collectors
+var (
+    egressDeniedTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_egress_denied_total",
+            Help: "Total number of outbound node calls blocked by egress policy",
+        },
+        []string{"reason"},
+    )
+)
+
+// EgressPolicy lists what one tenant's outbound nodes may reach. An
+// empty policy allows any public destination; the blocked networks are
+// unreachable either way
+type EgressPolicy struct {
+    AllowedDomains []string `json:"allowed_domains"`
+    AllowedCIDRs   []string `json:"allowed_cidrs"`
+}
+
+// compiledEgressPolicy is an EgressPolicy with its CIDRs parsed
+type compiledEgressPolicy struct {
+    domains []string
+    cidrs   []*net.IPNet
+}
+
+// allowsDomain reports whether the host matches an allowed domain or one
+// of its subdomains
+func (p compiledEgressPolicy) allowsDomain(host string) bool {
+    host = strings.ToLower(host)
+    for _, domain := range p.domains {
+        if host == domain || strings.HasSuffix(host, "."+domain) {
+            return true
+        }
+    }
+    return false
+}
+
+// allowsIP reports whether the address falls in an allowed CIDR
+func (p compiledEgressPolicy) allowsIP(ip net.IP) bool {
+    for _, cidr := range p.cidrs {
+        if cidr.Contains(ip) {
+            return true
+        }
+    }
+    return false
+}
+
+// EgressViolation describes one outbound call blocked by policy
+type EgressViolation struct {
+    Tenant string
+    NodeID uuid.UUID
+    Host   string
+    Reason string
+}
+
+// EgressEnforcer applies per-tenant egress policies to outbound nodes,
+// blocking SSRF-style calls to internal and metadata networks. Violations
+// are counted and reported through the configured callback
+type EgressEnforcer struct {
+    mu          sync.RWMutex
+    policies    map[string]compiledEgressPolicy
+    blocked     []*net.IPNet
+    onViolation func(EgressViolation)
+}
+
+// NewEgressEnforcer creates an enforcer from per-tenant policies. The
+// callback receives every violation; nil disables reporting
+func NewEgressEnforcer(policies map[string]EgressPolicy, onViolation func(EgressViolation)) (*EgressEnforcer, error) {
+    registerMetrics(egressDeniedTotal)
+
+    blocked := make([]*net.IPNet, 0, len(egressBlockedCIDRs))
+    for _, cidr := range egressBlockedCIDRs {
+        _, network, err := net.ParseCIDR(cidr)
+        if err != nil {
+            return nil, fmt.Errorf("invalid blocked CIDR %s: %w", cidr, err)
+        }
+        blocked = append(blocked, network)
+    }
+
+    compiled := make(map[string]compiledEgressPolicy, len(policies))
+    for tenant, policy := range policies {
+        entry := compiledEgressPolicy{
+            domains: make([]string, 0, len(policy.AllowedDomains)),
+            cidrs:   make([]*net.IPNet, 0, len(policy.AllowedCIDRs)),
+        }
+        for _, domain := range policy.AllowedDomains {
+            entry.domains = append(entry.domains, strings.ToLower(strings.TrimSpace(domain)))
+        }
+        for _, cidr := range policy.AllowedCIDRs {
+            _, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+            if err != nil {
+                return nil, fmt.Errorf("invalid CIDR %q for tenant %s: %w", cidr, tenant, err)
+            }
+            entry.cidrs = append(entry.cidrs, network)
+        }
+        compiled[tenant] = entry
+    }
+
+    return &EgressEnforcer{
+        policies:    compiled,
+        blocked:     blocked,
+        onViolation: onViolation,
+    }, nil
+}
+
+// outboundNodeURL returns the destination of an outbound action node, or
+// empty for nodes that make no outbound calls
+func outboundNodeURL(node *models.Node) string {
+    if node.Type != models.ActionNode {
+        return ""
+    }
+    rawURL, _ := node.Config["url"].(string)
+    return rawURL
+}
+
+// CheckNode enforces the tenant's egress policy against the node's
+// outbound destination. Nodes without one always pass
+func (f *EgressEnforcer) CheckNode(ctx context.Context, tenant string, node *models.Node) error {
+    rawURL := outboundNodeURL(node)
+    if rawURL == "" {
+        return nil
+    }
+    return f.Check(ctx, tenant, node.ID, rawURL)
+}
+
+// Check enforces the tenant's egress policy against a destination URL,
+// resolving the host so DNS names pointing at blocked networks are
+// caught too
+func (f *EgressEnforcer) Check(ctx context.Context, tenant string, nodeID uuid.UUID, rawURL string) error {
+    parsed, err := url.Parse(rawURL)
+    if err != nil {
+        return f.deny(tenant, nodeID, rawURL, "invalid_url")
+    }
+    if parsed.Scheme != "http" && parsed.Scheme != "https" {
+        return f.deny(tenant, nodeID, parsed.Host, "scheme")
+    }
+    host := parsed.Hostname()
+
+    f.mu.RLock()
+    policy, hasPolicy := f.policies[tenant]
+    f.mu.RUnlock()
+    domainAllowed := hasPolicy && policy.allowsDomain(host)
+
+    var addresses []net.IP
+    if ip := net.ParseIP(host); ip != nil {
+        addresses = []net.IP{ip}
+    } else {
+        resolved, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+        if err != nil {
+            return f.deny(tenant, nodeID, host, "resolution_failed")
+        }
+        for _, addr := range resolved {
+            addresses = append(addresses, addr.IP)
+        }
+    }
+
+    for _, ip := range addresses {
+        // An explicitly allowlisted CIDR wins, letting tenants reach
+        // their own private integrations deliberately
+        if hasPolicy && policy.allowsIP(ip) {
+            continue
+        }
+        for _, network := range f.blocked {
+            if network.Contains(ip) {
+                return f.deny(tenant, nodeID, host, "blocked_network")
+            }
+        }
+        if hasPolicy && !domainAllowed {
+            return f.deny(tenant, nodeID, host, "not_allowlisted")
+        }
+    }
+
+    return nil
+}
+
+// deny records the violation and returns the policy error
+func (f *EgressEnforcer) deny(tenant string, nodeID uuid.UUID, host, reason string) error {
+    egressDeniedTotal.WithLabelValues(reason).Inc()
+    if f.onViolation != nil {
+        f.onViolation(EgressViolation{
+            Tenant: tenant,
+            NodeID: nodeID,
+            Host:   host,
+            Reason: reason,
+        })
+    }
+    return fmt.Errorf("%w: %s (%s)", ErrEgressDenied, host, reason)
+}
@@ -4,6 +4,7 @@ package core
 import (
     "context"
     "errors"
+    "fmt"
     "sync"
     "time"
     
@@ -12,7 +13,7 @@ import (
     "github.com/opentracing/opentracing-go"         // v1.2.0
     "github.com/sony/gobreaker"      // v0.5.0
     
-    "internal/models"
+    "github.com/organization/workflow-engine/internal/models"
 )
 
 // Common errors
@@ -71,16 +72,129 @@ type EngineConfig struct {
 // Engine manages workflow execution with enhanced reliability and observability
 type Engine struct {
     mu              sync.RWMutex
-    executor        *Executor
-    scheduler       *Scheduler
+    executor        WorkflowExecutor
+    scheduler       WorkflowScheduler
     activeWorkflows map[uuid.UUID]*engineContext
     breaker         *gobreaker.CircuitBreaker
     metricsRegistry *prometheus.Registry
+    labelPolicy     *MetricLabelPolicy
+    clientManager   *ClientManager
     tracer          opentracing.Tracer
 }
 
-// NewEngine creates a new workflow engine instance with the provided configuration
-func NewEngine(executor *Executor, scheduler *Scheduler, config EngineConfig) *Engine {
+// SetClientManager attaches the gRPC client manager so downstream
+// connection health is surfaced in engine health
+func (e *Engine) SetClientManager(manager *ClientManager) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.clientManager = manager
+}
+
+// RegisterInterceptor registers an execution interceptor on the engine's
+// executor
+func (e *Engine) RegisterInterceptor(interceptor ExecutionInterceptor) {
+    e.executor.RegisterInterceptor(interceptor)
+}
+
+// SetNodeResultCache enables node result caching on the engine's executor
+func (e *Engine) SetNodeResultCache(cache *NodeResultCache) {
+    e.executor.SetNodeResultCache(cache)
+}
+
+// SetEgressEnforcer enables per-tenant egress policy enforcement on the
+// engine's executor
+func (e *Engine) SetEgressEnforcer(egress *EgressEnforcer) {
+    e.executor.SetEgressEnforcer(egress)
+}
+
+// SetTuningManager attaches the runtime tuning manager to the engine's
+// executor so admin settings changes take effect immediately
+func (e *Engine) SetTuningManager(tuning *TuningManager) {
+    e.executor.SetTuningManager(tuning)
+}
+
+// SetExecutionRecorder attaches execution record persistence to the
+// engine's executor so every run leaves a row crash recovery can find
+func (e *Engine) SetExecutionRecorder(recorder ExecutionRecorder, instanceID string) {
+    e.executor.SetExecutionRecorder(recorder, instanceID)
+}
+
+// SetBudgetPolicy enables per-execution resource budget enforcement on
+// the engine's executor
+func (e *Engine) SetBudgetPolicy(policy *BudgetPolicy) {
+    e.executor.SetBudgetPolicy(policy)
+}
+
+// SetCapacityMonitor attaches a capacity monitor to the engine's
+// scheduler so schedule firings report their drift
+func (e *Engine) SetCapacityMonitor(monitor *CapacityMonitor) {
+    e.scheduler.SetCapacityMonitor(monitor)
+}
+
+// RegisterVectorNodes installs the built-in embedding and vector store
+// actions on the engine's executor, embedding through the AI service
+// when a connection is configured
+func (e *Engine) RegisterVectorNodes(store VectorStore) {
+    var embedder Embedder
+    if client := e.executor.AIClient(); client != nil {
+        embedder = NewAIEmbedder(client)
+    }
+    vectorExecutor := NewVectorNodeExecutor(embedder, store)
+    vectorExecutor.SetExternalCallCharger(e.executor.ChargeExternalCalls)
+    e.executor.RegisterNodeExecutor(models.ActionNode, vectorExecutor)
+}
+
+// EnableAIBatching coalesces concurrent AI task calls into batched gRPC
+// calls with the configured batch size and latency budget. It reports
+// false when no AI connection is configured
+func (e *Engine) EnableAIBatching(config AIBatcherConfig) bool {
+    client := e.executor.AIClient()
+    router := e.executor.ModelRouter()
+    if client == nil || router == nil {
+        return false
+    }
+    router.SetBatcher(NewAIBatcher(client.ExecuteBatch, config))
+    return true
+}
+
+// EnableModeration turns on pre/post content moderation for AI task
+// nodes, classifying through the AI service with the given model. It
+// reports false when no AI connection is configured; onAudit may be nil
+func (e *Engine) EnableModeration(model string, onAudit func(event ModerationEvent)) bool {
+    client := e.executor.AIClient()
+    if client == nil {
+        return false
+    }
+    e.executor.SetModerator(NewModerator(NewAIModerationProvider(client, model), onAudit))
+    return true
+}
+
+// SetSchedulerLeadership promotes or demotes the engine's scheduler;
+// followers keep schedules installed but do not fire them
+func (e *Engine) SetSchedulerLeadership(leader bool) {
+    e.scheduler.SetLeader(leader)
+}
+
+// RecomputeSchedules refreshes intended next-run times, used when
+// scheduler leadership moves to this replica
+func (e *Engine) RecomputeSchedules() {
+    e.scheduler.RecomputeNextRuns()
+}
+
+// SetMetricLabelPolicy enables per-tenant and per-workflow execution
+// metrics governed by the configured allowlists
+func (e *Engine) SetMetricLabelPolicy(policy *MetricLabelPolicy) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.labelPolicy = policy
+}
+
+// NewEngine creates a new workflow engine instance with the provided
+// configuration. Executor and scheduler are accepted through their
+// contracts so alternate implementations can be plugged in
+func NewEngine(executor WorkflowExecutor, scheduler WorkflowScheduler, config EngineConfig) *Engine {
     if config.ExecutionTimeout == 0 {
         config.ExecutionTimeout = 5 * time.Minute
     }
@@ -97,14 +211,12 @@ func NewEngine(executor *Executor, scheduler *Scheduler, config EngineConfig) *E
         scheduler:       scheduler,
         activeWorkflows: make(map[uuid.UUID]*engineContext),
         breaker:         breaker,
-        metricsRegistry: prometheus.NewRegistry(),
+        metricsRegistry: MetricsRegistry(),
         tracer:          opentracing.GlobalTracer(),
     }
 
     // Register metrics
-    engine.metricsRegistry.MustRegister(workflowExecutionTotal)
-    engine.metricsRegistry.MustRegister(workflowExecutionDuration)
-    engine.metricsRegistry.MustRegister(workflowHealthStatus)
+    registerMetrics(workflowExecutionTotal, workflowExecutionDuration, workflowHealthStatus)
 
     // Initialize health status
     workflowHealthStatus.WithLabelValues("engine").Set(1)
@@ -118,6 +230,9 @@ func (e *Engine) StartWorkflow(ctx context.Context, workflowID uuid.UUID, opts m
     defer span.Finish()
 
     span.SetTag("workflow_id", workflowID)
+    if tenant, ok := opts["tenant_id"].(string); ok {
+        span.SetTag("tenant", tenant)
+    }
     startTime := time.Now()
 
     // Execute with circuit breaker
@@ -131,9 +246,15 @@ func (e *Engine) StartWorkflow(ctx context.Context, workflowID uuid.UUID, opts m
     if err != nil {
         status = "failed"
     }
-    workflowExecutionDuration.WithLabelValues(status, "start").Observe(duration)
+    observeWithTraceExemplar(ctx, workflowExecutionDuration.WithLabelValues(status, "start"), duration)
     workflowExecutionTotal.WithLabelValues(status, "start").Inc()
 
+    // Per-tenant and per-workflow series, bounded by the label allowlist
+    if e.labelPolicy != nil {
+        tenant, _ := opts["tenant_id"].(string)
+        e.labelPolicy.RecordExecution(tenant, workflowID, status, duration)
+    }
+
     return err
 }
 
@@ -170,13 +291,57 @@ func (e *Engine) executeWorkflow(ctx context.Context, workflowID uuid.UUID, opts
     return nil
 }
 
+// Execute runs a workflow definition directly through the executor,
+// satisfying the services.WorkflowEngine contract
+func (e *Engine) Execute(ctx context.Context, workflow *models.Workflow) error {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "Engine.Execute")
+    defer span.Finish()
+
+    if workflow == nil {
+        return ErrWorkflowNotFound
+    }
+    span.SetTag("workflow_id", workflow.ID)
+
+    startTime := time.Now()
+    _, err := e.breaker.Execute(func() (interface{}, error) {
+        return nil, e.executor.ExecuteWorkflow(ctx, workflow)
+    })
+
+    duration := time.Since(startTime).Seconds()
+    status := "success"
+    if err != nil {
+        status = "failed"
+    }
+    observeWithTraceExemplar(ctx, workflowExecutionDuration.WithLabelValues(status, "execute"), duration)
+    workflowExecutionTotal.WithLabelValues(status, "execute").Inc()
+
+    return err
+}
+
+// Validate performs engine-level validation of a workflow definition,
+// satisfying the services.WorkflowEngine contract
+func (e *Engine) Validate(ctx context.Context, workflow *models.Workflow) error {
+    if workflow == nil {
+        return ErrWorkflowNotFound
+    }
+    return workflow.Validate()
+}
+
+// Stop shuts down the engine, stopping the scheduler so no further
+// schedules fire; in-flight executions run to completion
+func (e *Engine) Stop() error {
+    e.scheduler.Stop()
+    workflowHealthStatus.WithLabelValues("engine").Set(0)
+    return nil
+}
+
 // StopWorkflow gracefully stops workflow execution
 func (e *Engine) StopWorkflow(ctx context.Context, workflowID uuid.UUID) error {
     span, _ := opentracing.StartSpanFromContext(ctx, "StopWorkflow")
     defer span.Finish()
 
     e.mu.RLock()
-    engineCtx, exists := e.activeWorkflows[workflowID]
+    _, exists := e.activeWorkflows[workflowID]
     e.mu.RUnlock()
 
     if !exists {
@@ -215,6 +380,25 @@ func (e *Engine) GetWorkflowStatus(workflowID uuid.UUID) (string, error) {
     return engineCtx.status, nil
 }
 
+// ActiveExecutions returns the number of currently active workflow executions
+func (e *Engine) ActiveExecutions() int {
+    e.mu.RLock()
+    defer e.mu.RUnlock()
+    return len(e.activeWorkflows)
+}
+
+// MaxConcurrentExecutions returns the engine's execution capacity
+func (e *Engine) MaxConcurrentExecutions() int {
+    return e.executor.MaxConcurrentExecutions()
+}
+
+// Executor exposes the underlying workflow executor for components that
+// re-execute workflows outside the engine's request path, such as the
+// startup recovery scan
+func (e *Engine) Executor() WorkflowExecutor {
+    return e.executor
+}
+
 // GetHealth returns the health status of the workflow engine
 func (e *Engine) GetHealth() map[string]interface{} {
     health := map[string]interface{}{
@@ -222,10 +406,14 @@ func (e *Engine) GetHealth() map[string]interface{} {
         "active_workflows": len(e.activeWorkflows),
         "circuit_breaker": map[string]interface{}{
             "state":     e.breaker.State().String(),
-            "failures": e.breaker.Counts().Failures,
+            "failures": e.breaker.Counts().TotalFailures,
         },
     }
 
+    if e.clientManager != nil {
+        health["grpc_clients"] = e.clientManager.Health()
+    }
+
     return health
 }
 
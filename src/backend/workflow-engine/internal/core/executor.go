@@ -3,16 +3,21 @@ package core
 
 import (
     "context"
+    "errors"
     "fmt"
+    "runtime/pprof"
     "sync"
     "time"
-    
+
     "github.com/google/uuid"
     "github.com/prometheus/client_golang/prometheus"
     "github.com/opentracing/opentracing-go"
     "google.golang.org/grpc"
-    
-    "internal/models"
+
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/aiservice"
+    "github.com/organization/workflow-engine/pkg/chaos"
+    "github.com/organization/workflow-engine/pkg/safehttp"
 )
 
 // ExecutionStatus represents the current status of a workflow execution
@@ -25,12 +30,18 @@ const (
     StatusCompleted ExecutionStatus = "completed"
     StatusFailed    ExecutionStatus = "failed"
     StatusCanceled  ExecutionStatus = "canceled"
+    StatusSkipped   ExecutionStatus = "skipped"
 
     // Default timeout for workflow execution
     defaultExecutionTimeout = 5 * time.Minute
     maxConcurrentExecutions = 1000
 )
 
+// ErrMaxConcurrentExecutions is returned when the executor has no free
+// execution slot. The queue treats it as transient and requeues the run
+// rather than failing it
+var ErrMaxConcurrentExecutions = errors.New("maximum concurrent executions reached")
+
 // Metrics collectors
 var (
     nodeExecutionTotal = prometheus.NewCounterVec(
@@ -56,16 +67,30 @@ var (
             Help: "Number of currently active workflow executions",
         },
     )
+
+    executionRecordErrors = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_execution_record_errors_total",
+            Help: "Failed best-effort writes of execution records",
+        },
+        []string{"stage"},
+    )
 )
 
 // executionContext holds the state for a single workflow execution
 type executionContext struct {
-    workflowID uuid.UUID
+    workflowID  uuid.UUID
+    executionID uuid.UUID
+    tenant     string
     status     ExecutionStatus
     startTime  time.Time
+    execution  *models.Execution
     nodeStates map[uuid.UUID]*nodeState
     results    map[uuid.UUID]interface{}
     errors     []error
+    compensations *compensationLog
+    budget     *budgetTracker
+    tokenUsage *models.TokenUsage
     ctx        context.Context
     cancel     context.CancelFunc
     mu         sync.RWMutex
@@ -74,12 +99,22 @@ type executionContext struct {
 // nodeState tracks the execution state of a single node
 type nodeState struct {
     status    ExecutionStatus
+    nodeType  models.NodeType
+    nodeName  string
     startTime time.Time
     endTime   time.Time
     retries   int
     error     error
 }
 
+// ExecutionRecorder persists execution lifecycle records. A run is
+// recorded before its first node executes so an instance lost mid-run
+// leaves a row the startup recovery scan can find
+type ExecutionRecorder interface {
+    Create(ctx context.Context, execution *models.Execution) error
+    Update(ctx context.Context, execution *models.Execution) error
+}
+
 // NodeExecutor defines the interface for node type-specific executors
 type NodeExecutor interface {
     Execute(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error)
@@ -92,9 +127,112 @@ type Executor struct {
     activeExecutions       map[uuid.UUID]*executionContext
     nodeExecutors         map[models.NodeType]NodeExecutor
     aiServiceConn         *grpc.ClientConn
+    aiClient              *aiservice.Client
+    modelRouter           *ModelRouter
     integrationServiceConn *grpc.ClientConn
     executionWg           sync.WaitGroup
     metricsRegistry       *prometheus.Registry
+    interceptors          []ExecutionInterceptor
+    resultCache           *NodeResultCache
+    budgetPolicy          *BudgetPolicy
+    egress                *EgressEnforcer
+    moderator             *Moderator
+    tuning                *TuningManager
+    recorder              ExecutionRecorder
+    instanceID            string
+}
+
+// SetNodeResultCache enables caching of idempotent node results
+func (e *Executor) SetNodeResultCache(cache *NodeResultCache) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.resultCache = cache
+}
+
+// SetEgressEnforcer enables per-tenant egress policy enforcement on
+// outbound nodes
+func (e *Executor) SetEgressEnforcer(egress *EgressEnforcer) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.egress = egress
+}
+
+// SetExecutionRecorder enables persistence of execution records under
+// this instance's identity; the recovery scan on the next startup reads
+// the same rows to find runs this instance did not finish
+func (e *Executor) SetExecutionRecorder(recorder ExecutionRecorder, instanceID string) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.recorder = recorder
+    e.instanceID = instanceID
+}
+
+// SetModerator enables pre/post content moderation on AI task nodes
+// that configure a moderation policy
+func (e *Executor) SetModerator(moderator *Moderator) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.moderator = moderator
+}
+
+// SetTuningManager attaches the runtime tuning manager; concurrency and
+// timeout limits are read from it on every execution so admin changes
+// take effect without a restart
+func (e *Executor) SetTuningManager(tuning *TuningManager) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.tuning = tuning
+}
+
+// concurrencyLimit returns the effective concurrent execution cap
+func (e *Executor) concurrencyLimit() int {
+    e.mu.RLock()
+    tuning := e.tuning
+    e.mu.RUnlock()
+
+    if tuning != nil {
+        return tuning.MaxConcurrentExecutions()
+    }
+    return maxConcurrentExecutions
+}
+
+// executionTimeout returns the effective per-execution timeout
+func (e *Executor) executionTimeout() time.Duration {
+    e.mu.RLock()
+    tuning := e.tuning
+    e.mu.RUnlock()
+
+    if tuning != nil {
+        return tuning.ExecutionTimeout()
+    }
+    return defaultExecutionTimeout
+}
+
+// AIClient returns the typed AI service client, nil when no AI
+// connection was configured
+func (e *Executor) AIClient() *aiservice.Client {
+    return e.aiClient
+}
+
+// ModelRouter returns the model routing executor AI task nodes use to
+// apply per-node fallback policies, nil when no AI connection was
+// configured
+func (e *Executor) ModelRouter() *ModelRouter {
+    return e.modelRouter
+}
+
+// RegisterNodeExecutor installs the executor used for a node type,
+// replacing any previous registration
+func (e *Executor) RegisterNodeExecutor(nodeType models.NodeType, executor NodeExecutor) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.nodeExecutors[nodeType] = executor
 }
 
 // NewExecutor creates a new workflow executor instance
@@ -104,13 +242,21 @@ func NewExecutor(aiConn, integrationConn *grpc.ClientConn) *Executor {
         nodeExecutors:         make(map[models.NodeType]NodeExecutor),
         aiServiceConn:         aiConn,
         integrationServiceConn: integrationConn,
-        metricsRegistry:       prometheus.NewRegistry(),
+        metricsRegistry:       MetricsRegistry(),
+    }
+
+    // Wrap the raw AI connection in the typed client so node executors
+    // get deadline and retry defaults instead of dialing through it,
+    // and route AI tasks through per-node model fallback policies
+    if aiConn != nil {
+        e.aiClient = aiservice.NewClient(aiConn, aiservice.Config{})
+        e.modelRouter = NewModelRouter(e.aiClient, 0)
+        e.modelRouter.SetTokenCharger(e.ChargeAITokens)
+        e.modelRouter.SetTokenUsageRecorder(e.RecordTokenUsage)
     }
 
     // Register metrics
-    e.metricsRegistry.MustRegister(nodeExecutionTotal)
-    e.metricsRegistry.MustRegister(nodeExecutionDuration)
-    e.metricsRegistry.MustRegister(activeExecutions)
+    registerMetrics(nodeExecutionTotal, nodeExecutionDuration, activeExecutions, compensationTotal, budgetExceededTotal, executionRecordErrors)
 
     // Initialize node executors
     e.registerNodeExecutors()
@@ -133,12 +279,40 @@ func (e *Executor) ExecuteWorkflow(ctx context.Context, workflow *models.Workflo
 
     // Create execution context with timeout
     execCtx := e.createExecutionContext(ctx, workflow)
-    
+
+    // Run registered interceptors; any BeforeExecute error aborts the run
+    interceptors := e.snapshotInterceptors()
+    for _, interceptor := range interceptors {
+        if err := interceptor.BeforeExecute(ctx, workflow, execCtx.executionID); err != nil {
+            return fmt.Errorf("execution rejected by interceptor: %w", err)
+        }
+    }
+
+    // Enrich the span with the execution context for trace filtering
+    applyExecutionTags(span, ExecutionSpanTags{
+        WorkflowID:  workflow.ID,
+        ExecutionID: execCtx.executionID,
+        Version:     workflow.Version,
+    })
+
+    // Tag the run with the definition's content hash so operators can
+    // prove which definition produced it, signed exports included
+    if hash, err := workflow.Hash(); err == nil {
+        span.SetTag("definition_hash", hash)
+    }
+
+    // Label profile samples with the execution so continuous profiling
+    // can correlate hot paths with individual runs
+    ctx = pprof.WithLabels(ctx, pprof.Labels("execution_id", execCtx.executionID.String()))
+    pprof.SetGoroutineLabels(ctx)
+    defer pprof.SetGoroutineLabels(context.Background())
+
     // Register active execution
+    limit := e.concurrencyLimit()
     e.mu.Lock()
-    if len(e.activeExecutions) >= maxConcurrentExecutions {
+    if len(e.activeExecutions) >= limit {
         e.mu.Unlock()
-        return fmt.Errorf("maximum concurrent executions reached")
+        return ErrMaxConcurrentExecutions
     }
     e.activeExecutions[workflow.ID] = execCtx
     activeExecutions.Inc()
@@ -151,19 +325,36 @@ func (e *Executor) ExecuteWorkflow(ctx context.Context, workflow *models.Workflo
         e.mu.Unlock()
     }()
 
+    // Persist the execution record before the first node runs; a run
+    // that cannot be recorded would be invisible to crash recovery
+    if err := e.recordExecutionStart(ctx, workflow, execCtx); err != nil {
+        return fmt.Errorf("failed to record execution start: %w", err)
+    }
+
     // Build execution graph
     graph := e.buildExecutionGraph(workflow.Nodes)
-    
+
     // Execute nodes in dependency order
-    err := e.executeGraph(execCtx, graph)
+    err := e.executeGraph(execCtx, workflow.Nodes, graph)
     if err != nil {
         execCtx.status = StatusFailed
+        // Undo completed side effects in reverse order (saga rollback)
+        e.runCompensations(ctx, execCtx.compensations)
+        e.recordExecutionFinish(ctx, execCtx, err)
+        for _, interceptor := range interceptors {
+            interceptor.OnError(ctx, workflow, nil, err)
+        }
         return fmt.Errorf("workflow execution failed: %w", err)
     }
 
     execCtx.status = StatusCompleted
     workflow.UpdateLastExecuted()
-    
+    e.recordExecutionFinish(ctx, execCtx, nil)
+
+    for _, interceptor := range interceptors {
+        interceptor.OnComplete(ctx, workflow, execCtx.executionID, time.Since(execCtx.startTime))
+    }
+
     return nil
 }
 
@@ -187,24 +378,135 @@ func (e *Executor) CancelExecution(workflowID uuid.UUID) error {
     return nil
 }
 
+// ActiveExecutions returns the number of currently active executions
+func (e *Executor) ActiveExecutions() int {
+    e.mu.RLock()
+    defer e.mu.RUnlock()
+    return len(e.activeExecutions)
+}
+
+// MaxConcurrentExecutions returns the executor's concurrency capacity
+func (e *Executor) MaxConcurrentExecutions() int {
+    return e.concurrencyLimit()
+}
+
 // createExecutionContext initializes a new execution context
 func (e *Executor) createExecutionContext(ctx context.Context, workflow *models.Workflow) *executionContext {
-    timeout := defaultExecutionTimeout
-    if workflow.ExecutionTimeout > 0 {
-        timeout = workflow.ExecutionTimeout
-    }
+    timeout := e.executionTimeout()
 
     ctx, cancel := context.WithTimeout(ctx, timeout)
-    
+
+    // Attach the resource budget that applies to this execution
+    var budget *budgetTracker
+    e.mu.RLock()
+    if e.budgetPolicy != nil {
+        budget = newBudgetTracker(e.budgetPolicy.Resolve(workflow))
+    }
+    e.mu.RUnlock()
+
+    tenant, _ := workflow.Metadata["tenant_id"].(string)
+
     return &executionContext{
-        workflowID: workflow.ID,
+        workflowID:  workflow.ID,
+        executionID: executionID(ctx),
+        tenant:     tenant,
         status:     StatusPending,
-        startTime:  time.Now(),
+        startTime:  executionStartTime(ctx),
         nodeStates: make(map[uuid.UUID]*nodeState),
         results:    make(map[uuid.UUID]interface{}),
         errors:     make([]error, 0),
+        compensations: &compensationLog{},
         ctx:        ctx,
         cancel:     cancel,
+        budget:     budget,
+    }
+}
+
+// recordExecutionStart persists a running execution record for the run,
+// stamped with this instance, the definition's content hash and the
+// active trace context so recovery can resume the trace. Without a
+// configured recorder the run proceeds unrecorded
+func (e *Executor) recordExecutionStart(ctx context.Context, workflow *models.Workflow, execCtx *executionContext) error {
+    e.mu.RLock()
+    recorder, instanceID := e.recorder, e.instanceID
+    e.mu.RUnlock()
+    if recorder == nil {
+        return nil
+    }
+
+    execution, err := models.NewExecution(workflow.ID, instanceID)
+    if err != nil {
+        return err
+    }
+    // The record shares the run's execution ID so traces, profiles and
+    // the persisted row all name the same run
+    execution.ID = execCtx.executionID
+    execution.MarkRunning(instanceID)
+    if hash, err := workflow.Hash(); err == nil {
+        execution.SetDefinitionHash(hash)
+    }
+    if span := opentracing.SpanFromContext(ctx); span != nil {
+        execution.SetTraceContext(InjectTraceContext(span))
+    }
+
+    if err := recorder.Create(ctx, execution); err != nil {
+        return err
+    }
+    execCtx.execution = execution
+    return nil
+}
+
+// recordExecutionFinish closes out the persisted execution record. The
+// run itself already finished, so a failed update only counts against
+// metrics; the row stays running and the next recovery scan retries it
+func (e *Executor) recordExecutionFinish(ctx context.Context, execCtx *executionContext, execErr error) {
+    if execCtx.execution == nil {
+        return
+    }
+
+    if execErr != nil {
+        execCtx.execution.MarkFailed(execErr.Error())
+    } else {
+        execCtx.execution.MarkCompleted()
+        // A completed run has nothing left to resume from
+        execCtx.execution.SetCheckpoint(nil)
+    }
+
+    e.mu.RLock()
+    recorder := e.recorder
+    e.mu.RUnlock()
+    if err := recorder.Update(ctx, execCtx.execution); err != nil {
+        executionRecordErrors.WithLabelValues("finish").Inc()
+    }
+}
+
+// checkpointNode persists the set of completed nodes after each node
+// success so recovery can tell how far an orphaned run got. Checkpoint
+// writes are best-effort: losing one costs resume granularity, not the
+// run
+func (e *Executor) checkpointNode(execCtx *executionContext, node *models.Node) {
+    if execCtx.execution == nil {
+        return
+    }
+
+    execCtx.mu.RLock()
+    completed := make([]string, 0, len(execCtx.results))
+    for nodeID := range execCtx.results {
+        completed = append(completed, nodeID.String())
+    }
+    execCtx.mu.RUnlock()
+
+    execCtx.execution.SetCheckpoint(map[string]interface{}{
+        "tenant_id":       execCtx.tenant,
+        "completed_nodes": completed,
+        "last_node_id":    node.ID.String(),
+    })
+
+    e.mu.RLock()
+    recorder := e.recorder
+    e.mu.RUnlock()
+    if err := recorder.Update(execCtx.ctx, execCtx.execution); err != nil {
+        executionRecordErrors.WithLabelValues("checkpoint").Inc()
     }
 }
 
@@ -213,13 +515,13 @@ func (e *Executor) executeNode(ctx context.Context, node *models.Node, input map
     span, ctx := opentracing.StartSpanFromContext(ctx, "ExecuteNode")
     defer span.Finish()
 
-    span.SetTag("node_id", node.ID)
+    applyExecutionTags(span, ExecutionSpanTags{WorkflowID: node.WorkflowID, NodeID: node.ID})
     span.SetTag("node_type", node.Type)
 
     startTime := time.Now()
     defer func() {
         duration := time.Since(startTime).Seconds()
-        nodeExecutionDuration.WithLabelValues(string(node.Type)).Observe(duration)
+        observeWithTraceExemplar(ctx, nodeExecutionDuration.WithLabelValues(string(node.Type)), duration)
     }()
 
     executor, exists := e.nodeExecutors[node.Type]
@@ -227,13 +529,167 @@ func (e *Executor) executeNode(ctx context.Context, node *models.Node, input map
         return nil, fmt.Errorf("no executor found for node type %s", node.Type)
     }
 
-    result, err := executor.Execute(ctx, node, input)
+    // Enforce the execution's resource budget before running the node
+    e.mu.RLock()
+    if execCtx, active := e.activeExecutions[node.WorkflowID]; active && execCtx.budget != nil {
+        if err := execCtx.budget.chargeNode(); err != nil {
+            e.mu.RUnlock()
+            nodeExecutionTotal.WithLabelValues(string(node.Type), "budget_exceeded").Inc()
+            return nil, err
+        }
+    }
+    e.mu.RUnlock()
+
+    // Serve cached results for nodes that opted into caching
+    e.mu.RLock()
+    cache := e.resultCache
+    e.mu.RUnlock()
+    if cache != nil {
+        if cached, hit := cache.Get(ctx, node, input); hit {
+            span.SetTag("cache_hit", true)
+            nodeExecutionTotal.WithLabelValues(string(node.Type), "cached").Inc()
+            return cached, nil
+        }
+    }
+
+    // Enforce the tenant's egress policy before any outbound call
+    e.mu.RLock()
+    egress := e.egress
+    tenant := ""
+    if execCtx, active := e.activeExecutions[node.WorkflowID]; active {
+        tenant = execCtx.tenant
+    }
+    e.mu.RUnlock()
+    if egress != nil {
+        if err := egress.CheckNode(ctx, tenant, node); err != nil {
+            nodeExecutionTotal.WithLabelValues(string(node.Type), "egress_denied").Inc()
+            span.SetTag("error", err.Error())
+            return nil, err
+        }
+    }
+
+    // Moderate AI node inputs before the call and outputs afterwards
+    // when the node configured a moderation policy
+    e.mu.RLock()
+    moderator := e.moderator
+    e.mu.RUnlock()
+    var moderationPolicy ModerationPolicy
+    moderationConfigured := false
+    if moderator != nil && node.Type == models.AITaskNode {
+        moderationPolicy, moderationConfigured = ModerationPolicyFromConfig(node.Config)
+    }
+    if moderationConfigured {
+        event := ModerationEvent{WorkflowID: node.WorkflowID, NodeID: node.ID, Tenant: tenant, Stage: ModerationStageInput}
+        if err := moderator.Check(ctx, event, moderationPolicy, moderationContent(input)); err != nil {
+            nodeExecutionTotal.WithLabelValues(string(node.Type), "moderation_blocked").Inc()
+            span.SetTag("error", err.Error())
+            return nil, err
+        }
+    }
+
+    // Parse output guardrails for AI nodes that configured them; a
+    // malformed block fails the node rather than running unguarded
+    var guardrails GuardrailRules
+    guardrailsConfigured := false
+    if node.Type == models.AITaskNode {
+        var err error
+        guardrails, guardrailsConfigured, err = GuardrailRulesFromConfig(node.Config)
+        if err != nil {
+            nodeExecutionTotal.WithLabelValues(string(node.Type), "failed").Inc()
+            span.SetTag("error", err.Error())
+            return nil, err
+        }
+    }
+
+    // Fault injection for resilience testing; no-op unless configured
+    if err := chaos.Inject(chaos.TargetNode); err != nil {
+        nodeExecutionTotal.WithLabelValues(string(node.Type), "failed").Inc()
+        return nil, err
+    }
+
+    // Upstream nodes may emit chunk streams; executors that do not
+    // consume streams receive the assembled output instead
+    streaming, _ := executor.(StreamingNodeExecutor)
+    if streaming == nil || !streaming.SupportsStreaming() {
+        if err := resolveStreamInputs(ctx, input); err != nil {
+            nodeExecutionTotal.WithLabelValues(string(node.Type), "failed").Inc()
+            return nil, err
+        }
+    }
+
+    var result map[string]interface{}
+    var err error
+    if guardrailsConfigured {
+        result, err = e.executeWithGuardrails(ctx, executor, node, input, guardrails)
+    } else {
+        result, err = executor.Execute(ctx, node, input)
+    }
     if err != nil {
         nodeExecutionTotal.WithLabelValues(string(node.Type), "failed").Inc()
+        for _, interceptor := range e.snapshotInterceptors() {
+            interceptor.OnError(ctx, nil, node, err)
+        }
         return nil, err
     }
 
+    if moderationConfigured {
+        event := ModerationEvent{WorkflowID: node.WorkflowID, NodeID: node.ID, Tenant: tenant, Stage: ModerationStageOutput}
+        if err := moderator.Check(ctx, event, moderationPolicy, moderationContent(result)); err != nil {
+            nodeExecutionTotal.WithLabelValues(string(node.Type), "moderation_blocked").Inc()
+            span.SetTag("error", err.Error())
+            return nil, err
+        }
+    }
+
     nodeExecutionTotal.WithLabelValues(string(node.Type), "success").Inc()
+    if cache != nil {
+        cache.Put(ctx, node, input, result)
+    }
+
+    // Record the node for saga rollback if it declared a compensation
+    if _, ok := compensationConfig(node); ok {
+        e.mu.RLock()
+        if execCtx, exists := e.activeExecutions[node.WorkflowID]; exists {
+            execCtx.compensations.record(node, result)
+        }
+        e.mu.RUnlock()
+    }
+    for _, interceptor := range e.snapshotInterceptors() {
+        interceptor.AfterNode(ctx, node, result, time.Since(startTime))
+    }
+    return result, nil
+}
+
+// executeWithGuardrails runs an AI node through the retry-with-feedback
+// loop: violation feedback is threaded back into the node's input under
+// "guardrail_feedback", and the final check result rides on the output
+// so downstream nodes can branch on flagged content
+func (e *Executor) executeWithGuardrails(ctx context.Context, executor NodeExecutor, node *models.Node, input map[string]interface{}, rules GuardrailRules) (map[string]interface{}, error) {
+    var result map[string]interface{}
+    _, check, err := RunWithGuardrails(ctx, rules, func(ctx context.Context, feedback string) (string, error) {
+        attempt := input
+        if feedback != "" {
+            attempt = make(map[string]interface{}, len(input)+1)
+            for key, value := range input {
+                attempt[key] = value
+            }
+            attempt["guardrail_feedback"] = feedback
+        }
+        var err error
+        result, err = executor.Execute(ctx, node, attempt)
+        if err != nil {
+            return "", err
+        }
+        return guardrailContent(result), nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    if result == nil {
+        result = make(map[string]interface{})
+    }
+    result["guardrails"] = check
     return result, nil
 }
 
@@ -261,7 +717,11 @@ func (e *Executor) cleanupWorker() {
 // registerNodeExecutors initializes the supported node executors
 func (e *Executor) registerNodeExecutors() {
     // Register built-in node executors
-    // Implementation details for specific node executors would be in separate files
+    // Outbound action nodes call through the hardened safehttp client so
+    // dialed addresses are re-validated at connect time
+    if client, err := safehttp.NewClient(safehttp.Config{}); err == nil {
+        e.nodeExecutors[models.ActionNode] = NewHTTPActionExecutor(client)
+    }
 }
 
 // buildExecutionGraph creates a dependency graph of nodes
@@ -277,9 +737,209 @@ func (e *Executor) buildExecutionGraph(nodes []*models.Node) map[uuid.UUID][]*mo
     return graph
 }
 
-// executeGraph executes nodes in the correct order based on dependencies
-func (e *Executor) executeGraph(execCtx *executionContext, graph map[uuid.UUID][]*models.Node) error {
-    // Implementation of topological sort and parallel execution
-    // would go here based on the graph structure
+// executeGraph executes nodes in dependency order, applying each
+// connection's input mappings to route upstream outputs onto downstream
+// inputs. Error connections are routed here rather than in the success
+// graph: a failing node with an on-error branch activates that branch
+// instead of aborting the run, while its success branch is skipped
+func (e *Executor) executeGraph(execCtx *executionContext, nodes []*models.Node, graph map[uuid.UUID][]*models.Node) error {
+    // Topological order via Kahn's algorithm over input connections
+    indegree := make(map[uuid.UUID]int, len(nodes))
+    for _, node := range nodes {
+        indegree[node.ID] = len(node.GetInputConnections())
+    }
+
+    // Error targets stay suspended until a failing source activates
+    // them; errorSources counts how many nodes can still fire each
+    // target so unfired fallbacks can be skipped once every source
+    // has succeeded
+    nodeByID := make(map[uuid.UUID]*models.Node, len(nodes))
+    errorSources := make(map[uuid.UUID]int)
+    for _, node := range nodes {
+        nodeByID[node.ID] = node
+        for _, targetID := range node.GetErrorConnections() {
+            errorSources[targetID]++
+        }
+    }
+
+    var (
+        ready         []*models.Node
+        scheduled     = make(map[uuid.UUID]bool, len(nodes))
+        skipped       = make(map[uuid.UUID]bool)
+        activated     = make(map[uuid.UUID]bool)
+        skippedInputs = make(map[uuid.UUID]int)
+        errorInputs   = make(map[uuid.UUID]map[string]interface{})
+        processed     int
+    )
+
+    schedule := func(node *models.Node) {
+        if !scheduled[node.ID] && !skipped[node.ID] {
+            scheduled[node.ID] = true
+            ready = append(ready, node)
+        }
+    }
+
+    // skipNode abandons a node and propagates the skip downstream; a
+    // downstream node still runs if at least one of its inputs executed
+    var skipNode func(node *models.Node)
+    var resolveErrorSource func(targetID uuid.UUID)
+    skipNode = func(node *models.Node) {
+        if scheduled[node.ID] || skipped[node.ID] {
+            return
+        }
+        skipped[node.ID] = true
+        execCtx.mu.Lock()
+        execCtx.nodeStates[node.ID] = &nodeState{status: StatusSkipped, nodeType: node.Type, nodeName: node.Name}
+        execCtx.mu.Unlock()
+
+        for _, downstream := range graph[node.ID] {
+            indegree[downstream.ID]--
+            skippedInputs[downstream.ID]++
+            if indegree[downstream.ID] == 0 {
+                if skippedInputs[downstream.ID] == len(downstream.GetInputConnections()) && !activated[downstream.ID] {
+                    skipNode(downstream)
+                } else {
+                    schedule(downstream)
+                }
+            }
+        }
+        for _, targetID := range node.GetErrorConnections() {
+            resolveErrorSource(targetID)
+        }
+    }
+    resolveErrorSource = func(targetID uuid.UUID) {
+        errorSources[targetID]--
+        target, exists := nodeByID[targetID]
+        if !exists {
+            return
+        }
+        // A pure fallback node has no success inputs; once no source
+        // can fire it anymore it will never run
+        if errorSources[targetID] == 0 && !activated[targetID] && len(target.GetInputConnections()) == 0 {
+            skipNode(target)
+        }
+    }
+
+    for _, node := range nodes {
+        if indegree[node.ID] == 0 && errorSources[node.ID] == 0 {
+            schedule(node)
+        }
+    }
+
+    for len(ready) > 0 {
+        node := ready[0]
+        ready = ready[1:]
+
+        input, err := e.assembleNodeInput(execCtx, node)
+        if err != nil {
+            return fmt.Errorf("failed to assemble input for node %s: %w", node.ID, err)
+        }
+        for key, value := range errorInputs[node.ID] {
+            input[key] = value
+        }
+
+        state := &nodeState{status: StatusRunning, nodeType: node.Type, nodeName: node.Name, startTime: time.Now()}
+        execCtx.mu.Lock()
+        execCtx.nodeStates[node.ID] = state
+        execCtx.mu.Unlock()
+
+        output, err := e.executeNode(execCtx.ctx, node, input)
+        state.endTime = time.Now()
+        if err != nil {
+            state.status = StatusFailed
+            state.error = err
+            execCtx.mu.Lock()
+            execCtx.errors = append(execCtx.errors, err)
+            execCtx.mu.Unlock()
+
+            errorTargets := node.GetErrorConnections()
+            if len(errorTargets) == 0 {
+                return fmt.Errorf("node %s failed: %w", node.ID, err)
+            }
+
+            // Route the failure onto the on-error branch: the fallback
+            // receives the error details as input while the abandoned
+            // success branch is skipped
+            processed++
+            for _, targetID := range errorTargets {
+                target, exists := nodeByID[targetID]
+                if !exists {
+                    continue
+                }
+                activated[targetID] = true
+                if errorInputs[targetID] == nil {
+                    errorInputs[targetID] = make(map[string]interface{})
+                }
+                errorInputs[targetID]["error"] = err.Error()
+                errorInputs[targetID]["failed_node_id"] = node.ID.String()
+                errorInputs[targetID]["failed_node_name"] = node.Name
+                if indegree[targetID] == 0 {
+                    schedule(target)
+                }
+            }
+            for _, downstream := range graph[node.ID] {
+                indegree[downstream.ID]--
+                skippedInputs[downstream.ID]++
+                if indegree[downstream.ID] == 0 {
+                    if skippedInputs[downstream.ID] == len(downstream.GetInputConnections()) && !activated[downstream.ID] {
+                        skipNode(downstream)
+                    } else {
+                        schedule(downstream)
+                    }
+                }
+            }
+            continue
+        }
+        state.status = StatusCompleted
+
+        execCtx.mu.Lock()
+        execCtx.results[node.ID] = output
+        execCtx.mu.Unlock()
+
+        e.checkpointNode(execCtx, node)
+
+        processed++
+        for _, downstream := range graph[node.ID] {
+            indegree[downstream.ID]--
+            if indegree[downstream.ID] == 0 {
+                schedule(downstream)
+            }
+        }
+        for _, targetID := range node.GetErrorConnections() {
+            resolveErrorSource(targetID)
+        }
+    }
+
+    if processed+len(skipped) != len(nodes) {
+        return fmt.Errorf("execution graph contains unreachable or cyclic nodes")
+    }
     return nil
+}
+
+// assembleNodeInput merges the mapped outputs of the node's upstream
+// connections into its input. Connections without declared mappings pass
+// their whole output through
+func (e *Executor) assembleNodeInput(execCtx *executionContext, node *models.Node) (map[string]interface{}, error) {
+    input := make(map[string]interface{})
+    for _, sourceID := range node.GetInputConnections() {
+        execCtx.mu.RLock()
+        result, exists := execCtx.results[sourceID]
+        execCtx.mu.RUnlock()
+        if !exists {
+            continue
+        }
+        output, ok := result.(map[string]interface{})
+        if !ok {
+            continue
+        }
+
+        mapped, err := node.MapInput(sourceID, output)
+        if err != nil {
+            return nil, err
+        }
+        for key, value := range mapped {
+            input[key] = value
+        }
+    }
+    return input, nil
 }
\ No newline at end of file
@@ -0,0 +1,44 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+    "github.com/uber/jaeger-client-go" // v2.30.0
+)
+
+// traceIDFromContext extracts the active trace ID from the span carried in
+// the context, returning an empty string when there is no sampled trace
+func traceIDFromContext(ctx context.Context) string {
+    span := opentracing.SpanFromContext(ctx)
+    if span == nil {
+        return ""
+    }
+
+    spanContext, ok := span.Context().(jaeger.SpanContext)
+    if !ok || !spanContext.IsSampled() {
+        return ""
+    }
+
+    return spanContext.TraceID().String()
+}
+
+// observeWithTraceExemplar records a histogram observation and, when the
+// request carries a sampled trace, attaches the trace ID as an exemplar so
+// Grafana can link latency spikes directly to the corresponding trace
+func observeWithTraceExemplar(ctx context.Context, observer prometheus.Observer, value float64) {
+    traceID := traceIDFromContext(ctx)
+    if traceID == "" {
+        observer.Observe(value)
+        return
+    }
+
+    if exemplarObserver, ok := observer.(prometheus.ExemplarObserver); ok {
+        exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{"trace_id": traceID})
+        return
+    }
+
+    observer.Observe(value)
+}
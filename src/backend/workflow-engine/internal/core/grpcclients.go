@@ -0,0 +1,272 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/credentials/insecure"
+    "google.golang.org/grpc/health/grpc_health_v1"
+    "google.golang.org/grpc/keepalive"
+
+    "github.com/organization/workflow-engine/pkg/chaos"
+)
+
+// Common client manager errors
+var (
+    ErrClientNotRegistered = errors.New("grpc client not registered")
+)
+
+// Client manager constants
+const (
+    // clientHealthInterval is how often managed connections are health
+    // checked
+    clientHealthInterval = 15 * time.Second
+
+    // clientHealthTimeout bounds a single health check call
+    clientHealthTimeout = 3 * time.Second
+)
+
+// Metrics collectors
+var (
+    grpcClientHealthy = prometheus.NewGaugeVec(
+        prometheus.GaugeOpts{
+            Name: "workflow_grpc_client_healthy",
+            Help: "Health status of managed gRPC client connections",
+        },
+        []string{"service"},
+    )
+
+    grpcClientRecycleTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_grpc_client_recycle_total",
+            Help: "Total number of gRPC connections recycled after failed health checks",
+        },
+        []string{"service"},
+    )
+)
+
+// ServiceClientConfig holds per-service connection settings
+type ServiceClientConfig struct {
+    Target              string
+    Deadline            time.Duration
+    MaxRetries          int
+    KeepaliveTime       time.Duration
+    KeepaliveTimeout    time.Duration
+    LoadBalancingPolicy string
+}
+
+// managedClient pairs a connection with its config and health state
+type managedClient struct {
+    conn        *grpc.ClientConn
+    config      ServiceClientConfig
+    healthy     bool
+    lastChecked time.Time
+    failures    int
+}
+
+// ClientManager owns the gRPC connections to downstream services, applying
+// per-service deadlines, retry policies, keepalive, and load balancing,
+// and recycling connections whose health checks fail
+type ClientManager struct {
+    mu      sync.RWMutex
+    clients map[string]*managedClient
+    ctx     context.Context
+    cancel  context.CancelFunc
+}
+
+// NewClientManager creates a new client manager and starts its health loop
+func NewClientManager() *ClientManager {
+    ctx, cancel := context.WithCancel(context.Background())
+
+    // Register metrics
+    registerMetrics(grpcClientHealthy, grpcClientRecycleTotal)
+
+    manager := &ClientManager{
+        clients: make(map[string]*managedClient),
+        ctx:     ctx,
+        cancel:  cancel,
+    }
+
+    go manager.healthLoop()
+
+    return manager
+}
+
+// Register dials a service and places it under management
+func (m *ClientManager) Register(name string, config ServiceClientConfig) error {
+    if config.Deadline <= 0 {
+        config.Deadline = 10 * time.Second
+    }
+    if config.MaxRetries <= 0 {
+        config.MaxRetries = 3
+    }
+    if config.KeepaliveTime <= 0 {
+        config.KeepaliveTime = 30 * time.Second
+    }
+    if config.KeepaliveTimeout <= 0 {
+        config.KeepaliveTimeout = 10 * time.Second
+    }
+    if config.LoadBalancingPolicy == "" {
+        config.LoadBalancingPolicy = "round_robin"
+    }
+
+    conn, err := m.dial(config)
+    if err != nil {
+        return fmt.Errorf("failed to dial %s: %w", name, err)
+    }
+
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    m.clients[name] = &managedClient{
+        conn:    conn,
+        config:  config,
+        healthy: true,
+    }
+    grpcClientHealthy.WithLabelValues(name).Set(1)
+
+    return nil
+}
+
+// dial opens a connection with the service's retry, keepalive, and load
+// balancing configuration applied
+func (m *ClientManager) dial(config ServiceClientConfig) (*grpc.ClientConn, error) {
+    serviceConfig := fmt.Sprintf(`{
+        "loadBalancingConfig": [{"%s": {}}],
+        "methodConfig": [{
+            "name": [{}],
+            "timeout": "%s",
+            "retryPolicy": {
+                "maxAttempts": %d,
+                "initialBackoff": "0.1s",
+                "maxBackoff": "2s",
+                "backoffMultiplier": 2,
+                "retryableStatusCodes": ["UNAVAILABLE", "RESOURCE_EXHAUSTED"]
+            }
+        }]
+    }`, config.LoadBalancingPolicy, config.Deadline.String(), config.MaxRetries)
+
+    options := []grpc.DialOption{
+        grpc.WithTransportCredentials(insecure.NewCredentials()),
+        grpc.WithDefaultServiceConfig(serviceConfig),
+        grpc.WithKeepaliveParams(keepalive.ClientParameters{
+            Time:                config.KeepaliveTime,
+            Timeout:             config.KeepaliveTimeout,
+            PermitWithoutStream: true,
+        }),
+    }
+
+    // Fault injection for resilience testing; no-op unless configured
+    if chaos.Enabled() {
+        options = append(options, grpc.WithUnaryInterceptor(chaos.UnaryClientInterceptor()))
+    }
+
+    return grpc.Dial(config.Target, options...)
+}
+
+// Conn returns the managed connection for a service
+func (m *ClientManager) Conn(name string) (*grpc.ClientConn, error) {
+    m.mu.RLock()
+    defer m.mu.RUnlock()
+
+    client, exists := m.clients[name]
+    if !exists {
+        return nil, fmt.Errorf("%w: %s", ErrClientNotRegistered, name)
+    }
+    return client.conn, nil
+}
+
+// Health reports the health state of every managed connection for
+// inclusion in the engine health payload
+func (m *ClientManager) Health() map[string]interface{} {
+    m.mu.RLock()
+    defer m.mu.RUnlock()
+
+    health := make(map[string]interface{}, len(m.clients))
+    for name, client := range m.clients {
+        health[name] = map[string]interface{}{
+            "healthy":      client.healthy,
+            "state":        client.conn.GetState().String(),
+            "last_checked": client.lastChecked,
+        }
+    }
+    return health
+}
+
+// Shutdown stops the health loop and closes all managed connections
+func (m *ClientManager) Shutdown() {
+    m.cancel()
+
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    for _, client := range m.clients {
+        client.conn.Close()
+    }
+}
+
+// healthLoop periodically health checks managed connections and recycles
+// those that fail
+func (m *ClientManager) healthLoop() {
+    ticker := time.NewTicker(clientHealthInterval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ticker.C:
+            m.checkAll()
+        case <-m.ctx.Done():
+            return
+        }
+    }
+}
+
+// checkAll health checks every managed connection
+func (m *ClientManager) checkAll() {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    for name, client := range m.clients {
+        healthy := m.check(client.conn)
+        client.lastChecked = time.Now().UTC()
+
+        if healthy {
+            client.healthy = true
+            client.failures = 0
+            grpcClientHealthy.WithLabelValues(name).Set(1)
+            continue
+        }
+
+        client.failures++
+        client.healthy = false
+        grpcClientHealthy.WithLabelValues(name).Set(0)
+
+        // Recycle the connection after consecutive failures
+        if client.failures >= 2 {
+            if conn, err := m.dial(client.config); err == nil {
+                client.conn.Close()
+                client.conn = conn
+                client.failures = 0
+                grpcClientRecycleTotal.WithLabelValues(name).Inc()
+            }
+        }
+    }
+}
+
+// check performs a single gRPC health check call
+func (m *ClientManager) check(conn *grpc.ClientConn) bool {
+    ctx, cancel := context.WithTimeout(m.ctx, clientHealthTimeout)
+    defer cancel()
+
+    resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+    if err != nil {
+        return false
+    }
+    return resp.Status == grpc_health_v1.HealthCheckResponse_SERVING
+}
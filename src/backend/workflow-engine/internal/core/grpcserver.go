@@ -0,0 +1,74 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "fmt"
+    "net"
+
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/health"
+    "google.golang.org/grpc/health/grpc_health_v1"
+    "google.golang.org/grpc/reflection"
+)
+
+// GRPCServer hosts the engine's gRPC services. It registers the
+// standard health checking protocol and server reflection up front so
+// load balancers, Kubernetes probes, and tooling like grpcurl work
+// before any engine-specific services are added
+type GRPCServer struct {
+    server   *grpc.Server
+    health   *health.Server
+    listener net.Listener
+}
+
+// NewGRPCServer creates a gRPC server with health and reflection
+// services registered
+func NewGRPCServer(options ...grpc.ServerOption) *GRPCServer {
+    server := grpc.NewServer(options...)
+
+    healthServer := health.NewServer()
+    grpc_health_v1.RegisterHealthServer(server, healthServer)
+    reflection.Register(server)
+
+    return &GRPCServer{
+        server: server,
+        health: healthServer,
+    }
+}
+
+// Server exposes the underlying server so engine services can register
+// themselves before Start is called
+func (s *GRPCServer) Server() *grpc.Server {
+    return s.server
+}
+
+// SetServing flips the overall health status reported to probes and
+// load balancers
+func (s *GRPCServer) SetServing(serving bool) {
+    status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+    if serving {
+        status = grpc_health_v1.HealthCheckResponse_SERVING
+    }
+    s.health.SetServingStatus("", status)
+}
+
+// Start listens on the address and serves until Stop is called. The
+// server reports serving once the listener is bound
+func (s *GRPCServer) Start(address string) error {
+    listener, err := net.Listen("tcp", address)
+    if err != nil {
+        return fmt.Errorf("failed to listen on %s: %w", address, err)
+    }
+    s.listener = listener
+    s.SetServing(true)
+
+    return s.server.Serve(listener)
+}
+
+// Stop drains in-flight RPCs and shuts the server down, reporting
+// not-serving first so load balancers stop routing new requests
+func (s *GRPCServer) Stop() {
+    s.SetServing(false)
+    s.health.Shutdown()
+    s.server.GracefulStop()
+}
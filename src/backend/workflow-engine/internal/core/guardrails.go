@@ -0,0 +1,234 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "regexp"
+    "strings"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common guardrail errors
+var (
+    ErrGuardrailRejected = errors.New("output rejected by guardrails")
+)
+
+// Rejection behaviors when output still fails after retries
+const (
+    // RejectFail fails the node, the default
+    RejectFail = "fail"
+
+    // RejectFlag passes the output downstream with the violations
+    // attached so a later node can decide what to do with it
+    RejectFlag = "flag"
+)
+
+// defaultGuardrailRetries bounds the retry-with-feedback loop
+const defaultGuardrailRetries = 2
+
+// Metrics collectors
+var (
+    guardrailCheckTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_guardrail_check_total",
+            Help: "Total guardrail evaluations by outcome",
+        },
+        []string{"outcome"},
+    )
+)
+
+// init registers guardrail metrics
+func init() {
+    registerMetrics(guardrailCheckTotal)
+}
+
+// GuardrailRules validates AI output before it flows to downstream
+// nodes: a JSON Schema for structured output, a pattern the output must
+// match, and deny patterns it must not
+type GuardrailRules struct {
+    Schema       *models.ConfigSchema
+    Pattern      *regexp.Regexp
+    DenyPatterns []*regexp.Regexp
+    MaxRetries   int
+    OnReject     string
+}
+
+// GuardrailRulesFromConfig parses the rules from a node's "guardrails"
+// config block, reporting whether one was configured
+func GuardrailRulesFromConfig(config map[string]interface{}) (GuardrailRules, bool, error) {
+    block, ok := config["guardrails"].(map[string]interface{})
+    if !ok {
+        return GuardrailRules{}, false, nil
+    }
+
+    rules := GuardrailRules{MaxRetries: defaultGuardrailRetries, OnReject: RejectFail}
+
+    if raw, ok := block["schema"].(map[string]interface{}); ok {
+        encoded, err := json.Marshal(raw)
+        if err != nil {
+            return GuardrailRules{}, false, fmt.Errorf("invalid guardrail schema: %w", err)
+        }
+        schema := &models.ConfigSchema{}
+        if err := json.Unmarshal(encoded, schema); err != nil {
+            return GuardrailRules{}, false, fmt.Errorf("invalid guardrail schema: %w", err)
+        }
+        rules.Schema = schema
+    }
+    if pattern, ok := block["pattern"].(string); ok && pattern != "" {
+        compiled, err := regexp.Compile(pattern)
+        if err != nil {
+            return GuardrailRules{}, false, fmt.Errorf("invalid guardrail pattern: %w", err)
+        }
+        rules.Pattern = compiled
+    }
+    if denies, ok := block["deny_patterns"].([]interface{}); ok {
+        for _, entry := range denies {
+            pattern, ok := entry.(string)
+            if !ok {
+                continue
+            }
+            compiled, err := regexp.Compile(pattern)
+            if err != nil {
+                return GuardrailRules{}, false, fmt.Errorf("invalid guardrail deny pattern: %w", err)
+            }
+            rules.DenyPatterns = append(rules.DenyPatterns, compiled)
+        }
+    }
+    if retries, ok := block["max_retries"].(float64); ok && retries >= 0 {
+        rules.MaxRetries = int(retries)
+    }
+    if onReject, ok := block["on_reject"].(string); ok && onReject != "" {
+        rules.OnReject = onReject
+    }
+
+    return rules, true, nil
+}
+
+// GuardrailResult is the outcome of evaluating one output
+type GuardrailResult struct {
+    Passed     bool     `json:"passed"`
+    Attempts   int      `json:"attempts"`
+    Violations []string `json:"violations,omitempty"`
+}
+
+// Evaluate checks one output against the rules, collecting every
+// violation so retry feedback tells the model everything to fix at once
+func (r GuardrailRules) Evaluate(output string) GuardrailResult {
+    result := GuardrailResult{Passed: true}
+
+    if r.Schema != nil {
+        var decoded map[string]interface{}
+        if err := json.Unmarshal([]byte(output), &decoded); err != nil {
+            result.Violations = append(result.Violations, "output is not valid JSON")
+        } else {
+            result.Violations = append(result.Violations, schemaViolations(r.Schema, decoded)...)
+        }
+    }
+    if r.Pattern != nil && !r.Pattern.MatchString(output) {
+        result.Violations = append(result.Violations, fmt.Sprintf("output does not match required pattern %q", r.Pattern.String()))
+    }
+    for _, deny := range r.DenyPatterns {
+        if deny.MatchString(output) {
+            result.Violations = append(result.Violations, fmt.Sprintf("output matches denied pattern %q", deny.String()))
+        }
+    }
+
+    result.Passed = len(result.Violations) == 0
+    return result
+}
+
+// RunWithGuardrails drives the retry-with-feedback loop: produce is
+// called with feedback describing the previous attempt's violations
+// until the output passes or the retry budget is spent. On final
+// rejection the configured behavior decides between failing the node
+// and passing the flagged output downstream
+func RunWithGuardrails(ctx context.Context, rules GuardrailRules, produce func(ctx context.Context, feedback string) (string, error)) (string, GuardrailResult, error) {
+    feedback := ""
+    var output string
+    var result GuardrailResult
+
+    for attempt := 0; attempt <= rules.MaxRetries; attempt++ {
+        var err error
+        output, err = produce(ctx, feedback)
+        if err != nil {
+            return "", result, err
+        }
+
+        result = rules.Evaluate(output)
+        result.Attempts = attempt + 1
+        if result.Passed {
+            guardrailCheckTotal.WithLabelValues("passed").Inc()
+            return output, result, nil
+        }
+
+        guardrailCheckTotal.WithLabelValues("retried").Inc()
+        feedback = "The previous output was rejected: " + strings.Join(result.Violations, "; ") + ". Produce a corrected output."
+    }
+
+    if rules.OnReject == RejectFlag {
+        guardrailCheckTotal.WithLabelValues("flagged").Inc()
+        return output, result, nil
+    }
+    guardrailCheckTotal.WithLabelValues("rejected").Inc()
+    return "", result, fmt.Errorf("%w: %s", ErrGuardrailRejected, strings.Join(result.Violations, "; "))
+}
+
+// guardrailContent extracts the text a node's guardrails evaluate,
+// preferring the conventional "output" field and otherwise joining the
+// result's string fields the way moderation does
+func guardrailContent(result map[string]interface{}) string {
+    if output, ok := result["output"].(string); ok {
+        return output
+    }
+    return moderationContent(result)
+}
+
+// schemaViolations validates decoded JSON against the repo's config
+// schema subset: required keys and primitive property types
+func schemaViolations(schema *models.ConfigSchema, decoded map[string]interface{}) []string {
+    violations := make([]string, 0)
+    for _, required := range schema.Required {
+        if _, present := decoded[required]; !present {
+            violations = append(violations, fmt.Sprintf("missing required field %q", required))
+        }
+    }
+    for name, property := range schema.Properties {
+        value, present := decoded[name]
+        if !present || property.Type == "" {
+            continue
+        }
+        if !matchesSchemaType(property.Type, value) {
+            violations = append(violations, fmt.Sprintf("field %q is not of type %s", name, property.Type))
+        }
+    }
+    return violations
+}
+
+// matchesSchemaType checks one JSON-decoded value against a schema type
+func matchesSchemaType(schemaType string, value interface{}) bool {
+    switch schemaType {
+    case "string":
+        _, ok := value.(string)
+        return ok
+    case "number", "integer":
+        _, ok := value.(float64)
+        return ok
+    case "boolean":
+        _, ok := value.(bool)
+        return ok
+    case "array":
+        _, ok := value.([]interface{})
+        return ok
+    case "object":
+        _, ok := value.(map[string]interface{})
+        return ok
+    default:
+        return true
+    }
+}
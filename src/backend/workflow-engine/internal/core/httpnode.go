@@ -0,0 +1,114 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net/http"
+    "net/url"
+    "strings"
+
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/safehttp"
+)
+
+// HTTPActionExecutor executes action nodes that declare an outbound
+// destination. Every request goes through the hardened safehttp client,
+// so the dialed address is re-validated at connect time on top of the
+// tenant egress policy checked before the node runs. Action nodes
+// without a url make no outbound call and pass their input through
+type HTTPActionExecutor struct {
+    client *safehttp.Client
+}
+
+// NewHTTPActionExecutor creates an HTTP action executor over the given
+// hardened client
+func NewHTTPActionExecutor(client *safehttp.Client) *HTTPActionExecutor {
+    return &HTTPActionExecutor{client: client}
+}
+
+// Validate checks the node's outbound configuration
+func (x *HTTPActionExecutor) Validate(node *models.Node) error {
+    rawURL, _ := node.Config["url"].(string)
+    if rawURL == "" {
+        return nil
+    }
+
+    parsed, err := url.Parse(rawURL)
+    if err != nil {
+        return fmt.Errorf("invalid url: %w", err)
+    }
+    if parsed.Scheme != "http" && parsed.Scheme != "https" {
+        return fmt.Errorf("unsupported url scheme: %s", parsed.Scheme)
+    }
+    return nil
+}
+
+// Execute performs the node's outbound HTTP call. The request body is
+// the configured body string, or the node's input serialized as JSON for
+// methods that carry one. Responses past the client's size cap fail the
+// node instead of exhausting memory
+func (x *HTTPActionExecutor) Execute(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    rawURL, _ := node.Config["url"].(string)
+    if rawURL == "" {
+        return input, nil
+    }
+
+    method, _ := node.Config["method"].(string)
+    method = strings.ToUpper(method)
+    if method == "" {
+        method = http.MethodGet
+    }
+
+    var body io.Reader
+    contentType := ""
+    if method != http.MethodGet && method != http.MethodHead {
+        if raw, ok := node.Config["body"].(string); ok {
+            body = strings.NewReader(raw)
+        } else if len(input) > 0 {
+            encoded, err := json.Marshal(input)
+            if err != nil {
+                return nil, fmt.Errorf("failed to encode request body: %w", err)
+            }
+            body = strings.NewReader(string(encoded))
+            contentType = "application/json"
+        }
+    }
+
+    req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+    if err != nil {
+        return nil, fmt.Errorf("failed to build request: %w", err)
+    }
+    if contentType != "" {
+        req.Header.Set("Content-Type", contentType)
+    }
+    if headers, ok := node.Config["headers"].(map[string]interface{}); ok {
+        for key, value := range headers {
+            if header, ok := value.(string); ok {
+                req.Header.Set(key, header)
+            }
+        }
+    }
+
+    resp, err := x.client.Do(req)
+    if err != nil {
+        return nil, fmt.Errorf("http action failed: %w", err)
+    }
+    defer resp.Body.Close()
+
+    data, err := io.ReadAll(resp.Body)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read response: %w", err)
+    }
+    // Error statuses fail the node so on-error branches can route them
+    if resp.StatusCode >= http.StatusBadRequest {
+        return nil, fmt.Errorf("http action returned status %d", resp.StatusCode)
+    }
+
+    return map[string]interface{}{
+        "status_code": resp.StatusCode,
+        "body":        string(data),
+    }, nil
+}
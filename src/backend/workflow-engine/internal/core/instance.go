@@ -0,0 +1,213 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "os"
+    "sync"
+    "time"
+
+    "github.com/google/uuid"                         // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// Common instance registry errors
+var (
+    ErrInstanceNotRegistered = errors.New("instance not registered")
+)
+
+// Instance registry constants
+const (
+    defaultHeartbeatInterval = 15 * time.Second
+    defaultInstanceTTL       = 45 * time.Second
+)
+
+// Metrics collectors
+var (
+    instanceHeartbeatTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_instance_heartbeat_total",
+            Help: "Total number of instance heartbeats by status",
+        },
+        []string{"status"},
+    )
+
+    clusterInstanceCount = prometheus.NewGauge(
+        prometheus.GaugeOpts{
+            Name: "workflow_cluster_instances",
+            Help: "Number of live engine instances in the cluster",
+        },
+    )
+)
+
+// InstanceRecord describes one registered engine replica
+type InstanceRecord struct {
+    ID               string    `json:"id"`
+    Hostname         string    `json:"hostname"`
+    Version          string    `json:"version"`
+    ActiveExecutions int       `json:"active_executions"`
+    SchedulerLeader  bool      `json:"scheduler_leader"`
+    StartedAt        time.Time `json:"started_at"`
+    LastHeartbeat    time.Time `json:"last_heartbeat"`
+}
+
+// InstanceStore defines the persistence interface for the instance registry
+type InstanceStore interface {
+    Upsert(ctx context.Context, record *InstanceRecord) error
+    List(ctx context.Context) ([]*InstanceRecord, error)
+    Delete(ctx context.Context, instanceID string) error
+}
+
+// InstanceRegistryConfig holds configuration for the instance registry
+type InstanceRegistryConfig struct {
+    Version           string
+    HeartbeatInterval time.Duration
+    InstanceTTL       time.Duration
+}
+
+// InstanceRegistry registers this engine replica in the shared instance store
+// and periodically heartbeats so peers can detect lost instances
+type InstanceRegistry struct {
+    mu       sync.RWMutex
+    store    InstanceStore
+    engine   *Engine
+    record   *InstanceRecord
+    config   InstanceRegistryConfig
+    isLeader bool
+    ctx      context.Context
+    cancel   context.CancelFunc
+}
+
+// NewInstanceRegistry creates a new instance registry for this replica
+func NewInstanceRegistry(store InstanceStore, engine *Engine, config InstanceRegistryConfig) *InstanceRegistry {
+    if config.HeartbeatInterval == 0 {
+        config.HeartbeatInterval = defaultHeartbeatInterval
+    }
+    if config.InstanceTTL == 0 {
+        config.InstanceTTL = defaultInstanceTTL
+    }
+
+    hostname, _ := os.Hostname()
+    ctx, cancel := context.WithCancel(context.Background())
+
+    registry := &InstanceRegistry{
+        store:  store,
+        engine: engine,
+        config: config,
+        ctx:    ctx,
+        cancel: cancel,
+        record: &InstanceRecord{
+            ID:        uuid.New().String(),
+            Hostname:  hostname,
+            Version:   config.Version,
+            StartedAt: time.Now().UTC(),
+        },
+    }
+
+    // Register metrics
+    registerMetrics(instanceHeartbeatTotal, clusterInstanceCount)
+
+    return registry
+}
+
+// Start registers the instance and begins the heartbeat loop
+func (r *InstanceRegistry) Start() error {
+    if err := r.heartbeat(r.ctx); err != nil {
+        return fmt.Errorf("initial instance registration failed: %w", err)
+    }
+
+    go r.heartbeatWorker()
+    return nil
+}
+
+// Stop deregisters the instance and stops the heartbeat loop
+func (r *InstanceRegistry) Stop() error {
+    r.cancel()
+
+    ctx, cancel := context.WithTimeout(context.Background(), defaultHeartbeatInterval)
+    defer cancel()
+
+    return r.store.Delete(ctx, r.record.ID)
+}
+
+// InstanceID returns the identifier of this replica
+func (r *InstanceRegistry) InstanceID() string {
+    return r.record.ID
+}
+
+// SetSchedulerLeader records whether this replica currently holds scheduler
+// leadership, which is surfaced through the cluster status API
+func (r *InstanceRegistry) SetSchedulerLeader(leader bool) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.isLeader = leader
+}
+
+// IsAlive implements InstanceChecker using heartbeat freshness
+func (r *InstanceRegistry) IsAlive(ctx context.Context, instanceID string) (bool, error) {
+    records, err := r.store.List(ctx)
+    if err != nil {
+        return false, fmt.Errorf("failed to list instances: %w", err)
+    }
+
+    for _, record := range records {
+        if record.ID == instanceID {
+            return time.Since(record.LastHeartbeat) < r.config.InstanceTTL, nil
+        }
+    }
+
+    return false, nil
+}
+
+// ListInstances returns all registered instances with liveness applied
+func (r *InstanceRegistry) ListInstances(ctx context.Context) ([]*InstanceRecord, error) {
+    records, err := r.store.List(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("failed to list instances: %w", err)
+    }
+
+    live := make([]*InstanceRecord, 0, len(records))
+    for _, record := range records {
+        if time.Since(record.LastHeartbeat) < r.config.InstanceTTL {
+            live = append(live, record)
+        }
+    }
+
+    clusterInstanceCount.Set(float64(len(live)))
+    return live, nil
+}
+
+// heartbeatWorker periodically refreshes this instance's registration
+func (r *InstanceRegistry) heartbeatWorker() {
+    ticker := time.NewTicker(r.config.HeartbeatInterval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-r.ctx.Done():
+            return
+        case <-ticker.C:
+            if err := r.heartbeat(r.ctx); err != nil {
+                instanceHeartbeatTotal.WithLabelValues("failed").Inc()
+                continue
+            }
+            instanceHeartbeatTotal.WithLabelValues("success").Inc()
+        }
+    }
+}
+
+// heartbeat refreshes the registry record for this instance
+func (r *InstanceRegistry) heartbeat(ctx context.Context) error {
+    r.mu.Lock()
+    r.record.LastHeartbeat = time.Now().UTC()
+    r.record.SchedulerLeader = r.isLeader
+    if r.engine != nil {
+        r.record.ActiveExecutions = len(r.engine.activeWorkflows)
+    }
+    record := *r.record
+    r.mu.Unlock()
+
+    return r.store.Upsert(ctx, &record)
+}
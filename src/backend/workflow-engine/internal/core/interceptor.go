@@ -0,0 +1,55 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// ExecutionInterceptor receives lifecycle callbacks around workflow
+// execution so platform teams can plug in custom logging, lineage capture,
+// or policy enforcement without modifying the core engine. Implementations
+// must be safe for concurrent use; hooks are invoked inline on the
+// execution path, so expensive work should be offloaded
+type ExecutionInterceptor interface {
+    // BeforeExecute runs before a workflow execution starts. Returning an
+    // error aborts the execution before any node runs
+    BeforeExecute(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID) error
+
+    // AfterNode runs after each node completes successfully with the
+    // node's output and observed duration
+    AfterNode(ctx context.Context, node *models.Node, output map[string]interface{}, duration time.Duration)
+
+    // OnError runs when a node or the workflow as a whole fails
+    OnError(ctx context.Context, workflow *models.Workflow, node *models.Node, err error)
+
+    // OnComplete runs once after the execution finishes successfully
+    OnComplete(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID, duration time.Duration)
+}
+
+// RegisterInterceptor appends an interceptor to the executor's chain.
+// Interceptors run in registration order
+func (e *Executor) RegisterInterceptor(interceptor ExecutionInterceptor) {
+    if interceptor == nil {
+        return
+    }
+
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.interceptors = append(e.interceptors, interceptor)
+}
+
+// snapshotInterceptors returns the current interceptor chain under lock
+func (e *Executor) snapshotInterceptors() []ExecutionInterceptor {
+    e.mu.RLock()
+    defer e.mu.RUnlock()
+
+    interceptors := make([]ExecutionInterceptor, len(e.interceptors))
+    copy(interceptors, e.interceptors)
+    return interceptors
+}
@@ -0,0 +1,90 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "github.com/google/uuid"         // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// aggregatedLabel is the bucket that non-allowlisted tenants and workflows
+// collapse into, keeping label cardinality bounded
+const aggregatedLabel = "other"
+
+// Metrics collectors
+var (
+    workflowExecutionsByLabel = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_execution_by_workflow_total",
+            Help: "Workflow executions by tenant and workflow, limited to allowlisted label values",
+        },
+        []string{"tenant", "workflow_id", "status"},
+    )
+
+    workflowDurationByLabel = prometheus.NewHistogramVec(
+        prometheus.HistogramOpts{
+            Name:    "workflow_execution_duration_by_workflow_seconds",
+            Help:    "Workflow execution duration by tenant and workflow, limited to allowlisted label values",
+            Buckets: []float64{1, 5, 10, 30, 60, 300, 600},
+        },
+        []string{"tenant", "workflow_id"},
+    )
+)
+
+// MetricLabelPolicy controls which tenant and workflow identifiers appear
+// as metric label values. Values outside the allowlists are aggregated
+// into a single "other" series so per-workflow dashboards stay possible
+// without unbounded cardinality.
+type MetricLabelPolicy struct {
+    allowedTenants   map[string]bool
+    allowedWorkflows map[string]bool
+}
+
+// NewMetricLabelPolicy creates a label policy from the configured tenant
+// and workflow allowlists
+func NewMetricLabelPolicy(tenants, workflows []string) *MetricLabelPolicy {
+    policy := &MetricLabelPolicy{
+        allowedTenants:   make(map[string]bool, len(tenants)),
+        allowedWorkflows: make(map[string]bool, len(workflows)),
+    }
+
+    for _, tenant := range tenants {
+        policy.allowedTenants[tenant] = true
+    }
+    for _, workflow := range workflows {
+        policy.allowedWorkflows[workflow] = true
+    }
+
+    registerMetrics(workflowExecutionsByLabel, workflowDurationByLabel)
+
+    return policy
+}
+
+// TenantLabel maps a tenant identifier to its metric label value
+func (p *MetricLabelPolicy) TenantLabel(tenant string) string {
+    if tenant == "" {
+        return "unknown"
+    }
+    if p.allowedTenants[tenant] {
+        return tenant
+    }
+    return aggregatedLabel
+}
+
+// WorkflowLabel maps a workflow ID to its metric label value
+func (p *MetricLabelPolicy) WorkflowLabel(workflowID uuid.UUID) string {
+    id := workflowID.String()
+    if p.allowedWorkflows[id] {
+        return id
+    }
+    return aggregatedLabel
+}
+
+// RecordExecution records a completed execution against the per-tenant and
+// per-workflow series permitted by the allowlists
+func (p *MetricLabelPolicy) RecordExecution(tenant string, workflowID uuid.UUID, status string, duration float64) {
+    tenantLabel := p.TenantLabel(tenant)
+    workflowLabel := p.WorkflowLabel(workflowID)
+
+    workflowExecutionsByLabel.WithLabelValues(tenantLabel, workflowLabel, status).Inc()
+    workflowDurationByLabel.WithLabelValues(tenantLabel, workflowLabel).Observe(duration)
+}
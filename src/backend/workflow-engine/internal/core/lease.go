@@ -0,0 +1,203 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "sync"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// Scheduler lease constants
+const (
+    // schedulerLeaseName is the shared lease all replicas compete for
+    schedulerLeaseName = "scheduler"
+
+    // defaultLeaseTTL bounds how long a crashed leader blocks handoff
+    defaultLeaseTTL = 30 * time.Second
+)
+
+// Metrics collectors
+var (
+    schedulerLeaseHeld = prometheus.NewGauge(
+        prometheus.GaugeOpts{
+            Name: "workflow_scheduler_lease_held",
+            Help: "Whether this replica currently holds the scheduler lease",
+        },
+    )
+
+    schedulerLeaseTransitionTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_scheduler_lease_transition_total",
+            Help: "Total scheduler lease transitions by event",
+        },
+        []string{"event"},
+    )
+)
+
+// LeaseStore defines the persistence interface for leadership leases
+type LeaseStore interface {
+    // TryAcquire takes or renews the named lease for the holder,
+    // returning whether the holder owns it afterwards
+    TryAcquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+
+    // Release drops the named lease if the holder still owns it
+    Release(ctx context.Context, name, holder string) error
+}
+
+// SchedulerLeaseConfig holds configuration for the lease manager
+type SchedulerLeaseConfig struct {
+    Holder        string
+    TTL           time.Duration
+    RenewInterval time.Duration
+}
+
+// SchedulerLeaseManager coordinates scheduler leadership across replicas
+// through a shared lease. Only the lease holder fires schedules; during
+// rolling deploys the outgoing replica releases the lease on shutdown so
+// the incoming one takes over immediately instead of waiting out the
+// TTL, recomputing next-run times as it does
+type SchedulerLeaseManager struct {
+    mu          sync.Mutex
+    store       LeaseStore
+    engine      *Engine
+    registry    *InstanceRegistry
+    config      SchedulerLeaseConfig
+    leader      bool
+    lastRenewal time.Time
+    ctx         context.Context
+    cancel      context.CancelFunc
+}
+
+// NewSchedulerLeaseManager creates a lease manager for this replica
+func NewSchedulerLeaseManager(store LeaseStore, engine *Engine, registry *InstanceRegistry, config SchedulerLeaseConfig) *SchedulerLeaseManager {
+    if config.TTL <= 0 {
+        config.TTL = defaultLeaseTTL
+    }
+    if config.RenewInterval <= 0 {
+        config.RenewInterval = config.TTL / 3
+    }
+
+    // Register metrics
+    registerMetrics(schedulerLeaseHeld, schedulerLeaseTransitionTotal)
+
+    ctx, cancel := context.WithCancel(context.Background())
+    return &SchedulerLeaseManager{
+        store:    store,
+        engine:   engine,
+        registry: registry,
+        config:   config,
+        ctx:      ctx,
+        cancel:   cancel,
+    }
+}
+
+// Start demotes the local scheduler to follower and begins competing
+// for the lease
+func (m *SchedulerLeaseManager) Start() {
+    m.engine.SetSchedulerLeadership(false)
+    go m.leaseLoop()
+}
+
+// Stop releases the lease if held so the replacement replica can take
+// over without waiting for the TTL to expire
+func (m *SchedulerLeaseManager) Stop() {
+    m.cancel()
+
+    m.mu.Lock()
+    wasLeader := m.leader
+    m.leader = false
+    m.mu.Unlock()
+
+    if !wasLeader {
+        return
+    }
+
+    m.engine.SetSchedulerLeadership(false)
+    m.registry.SetSchedulerLeader(false)
+    schedulerLeaseHeld.Set(0)
+    schedulerLeaseTransitionTotal.WithLabelValues("released").Inc()
+
+    ctx, cancel := context.WithTimeout(context.Background(), m.config.TTL)
+    defer cancel()
+    m.store.Release(ctx, schedulerLeaseName, m.config.Holder)
+}
+
+// IsLeader reports whether this replica currently holds the lease
+func (m *SchedulerLeaseManager) IsLeader() bool {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return m.leader
+}
+
+// leaseLoop acquires and renews the lease, promoting and demoting the
+// local scheduler on transitions
+func (m *SchedulerLeaseManager) leaseLoop() {
+    ticker := time.NewTicker(m.config.RenewInterval)
+    defer ticker.Stop()
+
+    m.tryAcquire()
+    for {
+        select {
+        case <-m.ctx.Done():
+            return
+        case <-ticker.C:
+            m.tryAcquire()
+        }
+    }
+}
+
+// tryAcquire attempts to take or renew the lease and applies any
+// leadership transition that results
+func (m *SchedulerLeaseManager) tryAcquire() {
+    ctx, cancel := context.WithTimeout(m.ctx, m.config.RenewInterval)
+    defer cancel()
+
+    acquired, err := m.store.TryAcquire(ctx, schedulerLeaseName, m.config.Holder, m.config.TTL)
+    if err != nil {
+        // A single failed renew keeps leadership so a transient store
+        // blip does not bounce the scheduler, but once the TTL elapses
+        // without a successful renewal another replica may already hold
+        // the lease — step down rather than risk two leaders firing
+        m.mu.Lock()
+        expired := m.leader && time.Since(m.lastRenewal) >= m.config.TTL
+        if expired {
+            m.leader = false
+        }
+        m.mu.Unlock()
+
+        if expired {
+            m.engine.SetSchedulerLeadership(false)
+            m.registry.SetSchedulerLeader(false)
+            schedulerLeaseHeld.Set(0)
+            schedulerLeaseTransitionTotal.WithLabelValues("expired").Inc()
+        }
+        return
+    }
+
+    m.mu.Lock()
+    wasLeader := m.leader
+    m.leader = acquired
+    if acquired {
+        m.lastRenewal = time.Now()
+    }
+    m.mu.Unlock()
+
+    switch {
+    case acquired && !wasLeader:
+        // Schedules may have fired while another replica led; recompute
+        // next-run times before firing anything so the handoff produces
+        // neither missed nor duplicate runs
+        m.engine.RecomputeSchedules()
+        m.engine.SetSchedulerLeadership(true)
+        m.registry.SetSchedulerLeader(true)
+        schedulerLeaseHeld.Set(1)
+        schedulerLeaseTransitionTotal.WithLabelValues("acquired").Inc()
+    case !acquired && wasLeader:
+        m.engine.SetSchedulerLeadership(false)
+        m.registry.SetSchedulerLeader(false)
+        schedulerLeaseHeld.Set(0)
+        schedulerLeaseTransitionTotal.WithLabelValues("lost").Inc()
+    }
+}
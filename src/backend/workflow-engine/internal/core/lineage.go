@@ -0,0 +1,202 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common lineage errors
+var (
+    ErrLineageNotFound = errors.New("no lineage recorded for execution")
+)
+
+// Lineage capture constants
+const (
+    // lineageRetention defines how long lineage graphs are kept in memory
+    lineageRetention = 24 * time.Hour
+)
+
+// LineageSource identifies an upstream node whose output a node consumed,
+// with field-level detail where it could be determined
+type LineageSource struct {
+    NodeID uuid.UUID `json:"node_id"`
+    Fields []string  `json:"fields,omitempty"`
+}
+
+// LineageEntry records the data consumed and produced by a single node
+// execution for impact analysis and GDPR data mapping
+type LineageEntry struct {
+    NodeID       uuid.UUID       `json:"node_id"`
+    NodeType     string          `json:"node_type"`
+    Sources      []LineageSource `json:"sources,omitempty"`
+    OutputFields []string        `json:"output_fields,omitempty"`
+    RecordedAt   time.Time       `json:"recorded_at"`
+}
+
+// ExecutionLineage is the full lineage graph captured for one execution
+type ExecutionLineage struct {
+    ExecutionID uuid.UUID      `json:"execution_id"`
+    WorkflowID  uuid.UUID      `json:"workflow_id"`
+    Entries     []LineageEntry `json:"entries"`
+}
+
+// lineageCapture holds in-progress lineage for one execution
+type lineageCapture struct {
+    lineage   *ExecutionLineage
+    createdAt time.Time
+}
+
+// LineageRecorder captures which upstream node outputs each node consumed.
+// It implements ExecutionInterceptor so it can be registered on the
+// executor without modifying the execution path
+type LineageRecorder struct {
+    mu         sync.RWMutex
+    captures   map[uuid.UUID]*lineageCapture
+    byWorkflow map[uuid.UUID]uuid.UUID
+}
+
+// NewLineageRecorder creates a new lineage recorder instance
+func NewLineageRecorder() *LineageRecorder {
+    recorder := &LineageRecorder{
+        captures:   make(map[uuid.UUID]*lineageCapture),
+        byWorkflow: make(map[uuid.UUID]uuid.UUID),
+    }
+
+    // Start retention cleanup worker
+    go recorder.cleanupWorker()
+
+    return recorder
+}
+
+// BeforeExecute opens a lineage graph for the execution
+func (l *LineageRecorder) BeforeExecute(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID) error {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+
+    l.captures[executionID] = &lineageCapture{
+        lineage: &ExecutionLineage{
+            ExecutionID: executionID,
+            WorkflowID:  workflow.ID,
+            Entries:     make([]LineageEntry, 0, len(workflow.Nodes)),
+        },
+        createdAt: time.Now().UTC(),
+    }
+    l.byWorkflow[workflow.ID] = executionID
+
+    return nil
+}
+
+// AfterNode records the upstream connections a node consumed and the
+// top-level fields it produced
+func (l *LineageRecorder) AfterNode(ctx context.Context, node *models.Node, output map[string]interface{}, duration time.Duration) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+
+    executionID, exists := l.byWorkflow[node.WorkflowID]
+    if !exists {
+        return
+    }
+    capture, exists := l.captures[executionID]
+    if !exists {
+        return
+    }
+
+    entry := LineageEntry{
+        NodeID:     node.ID,
+        NodeType:   string(node.Type),
+        RecordedAt: time.Now().UTC(),
+    }
+
+    for _, sourceID := range node.GetInputConnections() {
+        entry.Sources = append(entry.Sources, LineageSource{
+            NodeID: sourceID,
+            Fields: fieldReferences(node.Config, sourceID),
+        })
+    }
+
+    for field := range output {
+        entry.OutputFields = append(entry.OutputFields, field)
+    }
+
+    capture.lineage.Entries = append(capture.lineage.Entries, entry)
+}
+
+// OnError closes the workflow mapping so a failed run does not collect
+// entries from a later execution of the same workflow
+func (l *LineageRecorder) OnError(ctx context.Context, workflow *models.Workflow, node *models.Node, err error) {
+    if workflow == nil {
+        return
+    }
+
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    delete(l.byWorkflow, workflow.ID)
+}
+
+// OnComplete closes the workflow mapping for the finished execution
+func (l *LineageRecorder) OnComplete(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID, duration time.Duration) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    delete(l.byWorkflow, workflow.ID)
+}
+
+// GetLineage returns the captured lineage graph for an execution
+func (l *LineageRecorder) GetLineage(executionID uuid.UUID) (*ExecutionLineage, error) {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+
+    capture, exists := l.captures[executionID]
+    if !exists {
+        return nil, ErrLineageNotFound
+    }
+
+    return capture.lineage, nil
+}
+
+// fieldReferences extracts the fields of an upstream node referenced by a
+// node's configuration, enabling field-level lineage where configs use the
+// standard input mapping convention
+func fieldReferences(config map[string]interface{}, sourceID uuid.UUID) []string {
+    mapping, ok := config["input_mapping"].(map[string]interface{})
+    if !ok {
+        return nil
+    }
+
+    sources, ok := mapping[sourceID.String()].([]interface{})
+    if !ok {
+        return nil
+    }
+
+    fields := make([]string, 0, len(sources))
+    for _, field := range sources {
+        if name, ok := field.(string); ok {
+            fields = append(fields, name)
+        }
+    }
+    return fields
+}
+
+// cleanupWorker periodically drops lineage graphs past retention
+func (l *LineageRecorder) cleanupWorker() {
+    ticker := time.NewTicker(time.Hour)
+    defer ticker.Stop()
+
+    for range ticker.C {
+        cutoff := time.Now().UTC().Add(-lineageRetention)
+
+        l.mu.Lock()
+        for id, capture := range l.captures {
+            if capture.createdAt.Before(cutoff) {
+                delete(l.captures, id)
+            }
+        }
+        l.mu.Unlock()
+    }
+}
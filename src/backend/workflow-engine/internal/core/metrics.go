@@ -0,0 +1,43 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "errors"
+    "sync"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// sharedRegistry is the process-wide metrics registry for all engine
+// components. Constructors previously registered metrics against the default
+// registerer or private registries, which panicked when a second component
+// instance was created; all registration now goes through registerMetrics.
+var (
+    sharedRegistry     = prometheus.NewRegistry()
+    sharedRegistryOnce sync.Once
+)
+
+// MetricsRegistry returns the shared registry used by all engine components,
+// with the standard process and Go runtime collectors attached
+func MetricsRegistry() *prometheus.Registry {
+    sharedRegistryOnce.Do(func() {
+        sharedRegistry.MustRegister(prometheus.NewGoCollector())
+        sharedRegistry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+    })
+    return sharedRegistry
+}
+
+// registerMetrics registers collectors with the shared registry, tolerating
+// duplicate registration so that constructors remain safe to call more than
+// once
+func registerMetrics(collectors ...prometheus.Collector) {
+    for _, collector := range collectors {
+        if err := sharedRegistry.Register(collector); err != nil {
+            var already prometheus.AlreadyRegisteredError
+            if errors.As(err, &already) {
+                continue
+            }
+            panic(err)
+        }
+    }
+}
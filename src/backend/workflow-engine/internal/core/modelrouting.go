@@ -0,0 +1,284 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/google/uuid"                         // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    aipb "github.com/organization/workflow-engine/api/proto/ai/v1"
+    "github.com/organization/workflow-engine/pkg/aiservice"
+)
+
+// Common model routing errors
+var (
+    ErrNoEligibleModel = errors.New("no eligible model for routing policy")
+)
+
+// defaultModelCooldown is how long a failing model is skipped before
+// being retried
+const defaultModelCooldown = 30 * time.Second
+
+// Metrics collectors
+var (
+    aiModelRequestTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_ai_model_request_total",
+            Help: "Total AI task requests by model and status",
+        },
+        []string{"model", "status"},
+    )
+
+    aiModelFailoverTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_ai_model_failover_total",
+            Help: "Total automatic failovers between AI models",
+        },
+        []string{"from_model", "to_model"},
+    )
+)
+
+// ModelRoutingPolicy is a per-node routing policy: the primary model,
+// the fallbacks tried in order when it fails, and an optional cost
+// ceiling that excludes models priced above it
+type ModelRoutingPolicy struct {
+    Primary      string             `json:"primary"`
+    Fallbacks    []string           `json:"fallbacks,omitempty"`
+    MaxCostPer1K float64            `json:"max_cost_per_1k,omitempty"`
+    Pricing      map[string]float64 `json:"pricing,omitempty"`
+}
+
+// ModelRoutingPolicyFromConfig parses the routing policy from a node's
+// "routing" config block, reporting whether one was configured
+func ModelRoutingPolicyFromConfig(config map[string]interface{}) (ModelRoutingPolicy, bool) {
+    routing, ok := config["routing"].(map[string]interface{})
+    if !ok {
+        return ModelRoutingPolicy{}, false
+    }
+
+    policy := ModelRoutingPolicy{}
+    policy.Primary, _ = routing["primary"].(string)
+    if fallbacks, ok := routing["fallbacks"].([]interface{}); ok {
+        for _, entry := range fallbacks {
+            if model, ok := entry.(string); ok {
+                policy.Fallbacks = append(policy.Fallbacks, model)
+            }
+        }
+    }
+    policy.MaxCostPer1K, _ = routing["max_cost_per_1k"].(float64)
+    if pricing, ok := routing["pricing"].(map[string]interface{}); ok {
+        policy.Pricing = make(map[string]float64, len(pricing))
+        for model, price := range pricing {
+            if value, ok := price.(float64); ok {
+                policy.Pricing[model] = value
+            }
+        }
+    }
+    return policy, policy.Primary != ""
+}
+
+// candidates lists the models the policy allows, in preference order,
+// dropping models priced above the cost ceiling
+func (p ModelRoutingPolicy) candidates() []string {
+    models := append([]string{p.Primary}, p.Fallbacks...)
+    if p.MaxCostPer1K <= 0 || p.Pricing == nil {
+        return models
+    }
+
+    eligible := make([]string, 0, len(models))
+    for _, model := range models {
+        if price, known := p.Pricing[model]; known && price > p.MaxCostPer1K {
+            continue
+        }
+        eligible = append(eligible, model)
+    }
+    return eligible
+}
+
+// ModelRouter executes AI tasks under a routing policy, failing over to
+// the next model when one errors or times out and skipping models that
+// recently failed so a down provider is not retried on every task
+type ModelRouter struct {
+    mu             sync.Mutex
+    client         *aiservice.Client
+    batcher        *AIBatcher
+    tokenCharger   TokenCharger
+    usageRecorder  TokenUsageRecorder
+    cooldown       time.Duration
+    unhealthyUntil map[string]time.Time
+}
+
+// TokenCharger charges reported AI token usage against an execution's
+// resource budget; a budget breach fails the task
+type TokenCharger func(workflowID uuid.UUID, tokens int) error
+
+// TokenUsageRecorder records a completed task's token counts against
+// the execution's usage record and the per-model metrics; costPer1K
+// prices the serving model in USD per thousand total tokens
+type TokenUsageRecorder func(workflowID uuid.UUID, model string, usage *aipb.TokenUsage, costPer1K float64)
+
+// NewModelRouter creates a router over the typed AI service client
+func NewModelRouter(client *aiservice.Client, cooldown time.Duration) *ModelRouter {
+    if cooldown <= 0 {
+        cooldown = defaultModelCooldown
+    }
+
+    // Register metrics
+    registerMetrics(aiModelRequestTotal, aiModelFailoverTotal)
+
+    return &ModelRouter{
+        client:         client,
+        cooldown:       cooldown,
+        unhealthyUntil: make(map[string]time.Time),
+    }
+}
+
+// SetTokenCharger wires budget accounting for the token usage reported
+// by completed tasks
+func (r *ModelRouter) SetTokenCharger(charger TokenCharger) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.tokenCharger = charger
+}
+
+// chargeUsage charges the response's token usage against the requesting
+// execution's budget, resolved from the request metadata
+func (r *ModelRouter) chargeUsage(request *aipb.TaskRequest, response *aipb.TaskResponse) error {
+    r.mu.Lock()
+    charger := r.tokenCharger
+    r.mu.Unlock()
+
+    if charger == nil || response.Usage == nil || response.Usage.TotalTokens <= 0 {
+        return nil
+    }
+
+    workflowID, err := uuid.Parse(request.Metadata["workflow_id"])
+    if err != nil {
+        return nil
+    }
+    return charger(workflowID, int(response.Usage.TotalTokens))
+}
+
+// SetTokenUsageRecorder wires token usage accounting for completed
+// tasks so spend is attributed to the execution and the serving model
+func (r *ModelRouter) SetTokenUsageRecorder(recorder TokenUsageRecorder) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.usageRecorder = recorder
+}
+
+// recordUsage attributes the response's token usage to the requesting
+// execution, priced by the policy's entry for the serving model
+func (r *ModelRouter) recordUsage(request *aipb.TaskRequest, model string, response *aipb.TaskResponse, policy ModelRoutingPolicy) {
+    r.mu.Lock()
+    recorder := r.usageRecorder
+    r.mu.Unlock()
+
+    if recorder == nil || response.Usage == nil {
+        return
+    }
+    workflowID, err := uuid.Parse(request.Metadata["workflow_id"])
+    if err != nil {
+        return
+    }
+    recorder(workflowID, model, response.Usage, policy.Pricing[model])
+}
+
+// SetBatcher routes the router's task calls through the batching layer,
+// coalescing concurrent small tasks into batched calls
+func (r *ModelRouter) SetBatcher(batcher *AIBatcher) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.batcher = batcher
+}
+
+// invoke runs one task call, through the batcher when one is configured
+func (r *ModelRouter) invoke(ctx context.Context, request *aipb.TaskRequest) (*aipb.TaskResponse, error) {
+    r.mu.Lock()
+    batcher := r.batcher
+    r.mu.Unlock()
+
+    if batcher != nil {
+        return batcher.Submit(ctx, request)
+    }
+    return r.client.ExecuteTask(ctx, request)
+}
+
+// Execute runs the task against the policy's models in order, returning
+// the first success along with the model that served it
+func (r *ModelRouter) Execute(ctx context.Context, request *aipb.TaskRequest, policy ModelRoutingPolicy) (*aipb.TaskResponse, string, error) {
+    candidates := policy.candidates()
+    if len(candidates) == 0 {
+        return nil, "", ErrNoEligibleModel
+    }
+
+    // Prefer models not in cooldown, but fall back to trying everything
+    // rather than failing outright when all candidates recently failed
+    healthy := r.filterHealthy(candidates)
+    if len(healthy) == 0 {
+        healthy = candidates
+    }
+
+    var lastErr error
+    previous := ""
+    for _, model := range healthy {
+        if previous != "" {
+            aiModelFailoverTotal.WithLabelValues(previous, model).Inc()
+        }
+
+        request.Model = model
+        response, err := r.invoke(ctx, request)
+        if err == nil {
+            r.markHealthy(model)
+            aiModelRequestTotal.WithLabelValues(model, "success").Inc()
+            r.recordUsage(request, model, response, policy)
+            if err := r.chargeUsage(request, response); err != nil {
+                return nil, model, err
+            }
+            return response, model, nil
+        }
+
+        lastErr = err
+        previous = model
+        r.markUnhealthy(model)
+        aiModelRequestTotal.WithLabelValues(model, "failed").Inc()
+
+        if ctx.Err() != nil {
+            break
+        }
+    }
+    return nil, "", fmt.Errorf("all routed models failed: %w", lastErr)
+}
+
+// filterHealthy drops models still in their failure cooldown
+func (r *ModelRouter) filterHealthy(models []string) []string {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    now := time.Now()
+    healthy := make([]string, 0, len(models))
+    for _, model := range models {
+        if until, down := r.unhealthyUntil[model]; down && now.Before(until) {
+            continue
+        }
+        healthy = append(healthy, model)
+    }
+    return healthy
+}
+
+func (r *ModelRouter) markUnhealthy(model string) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.unhealthyUntil[model] = time.Now().Add(r.cooldown)
+}
+
+func (r *ModelRouter) markHealthy(model string) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    delete(r.unhealthyUntil, model)
+}
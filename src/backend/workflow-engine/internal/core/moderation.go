@@ -0,0 +1,209 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "sort"
+    "strings"
+
+    "github.com/google/uuid" // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    aipb "github.com/organization/workflow-engine/api/proto/ai/v1"
+    "github.com/organization/workflow-engine/pkg/aiservice"
+)
+
+// Common moderation errors
+var (
+    ErrContentBlocked = errors.New("content blocked by moderation policy")
+)
+
+// Moderation stages and policy modes
+const (
+    ModerationStageInput  = "input"
+    ModerationStageOutput = "output"
+
+    // ModerationBlock fails the node when content is flagged; the
+    // default. ModerationFlag lets the execution continue but records
+    // the flag in the audit trail
+    ModerationBlock = "block"
+    ModerationFlag  = "flag"
+)
+
+// Metrics collectors
+var (
+    moderationCheckTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_moderation_check_total",
+            Help: "Total moderation checks by stage and outcome",
+        },
+        []string{"stage", "outcome"},
+    )
+)
+
+// init registers moderation metrics
+func init() {
+    registerMetrics(moderationCheckTotal)
+}
+
+// ModerationVerdict is one provider decision
+type ModerationVerdict struct {
+    Flagged    bool     `json:"flagged"`
+    Categories []string `json:"categories,omitempty"`
+}
+
+// ModerationProvider classifies content; implementations call out to a
+// moderation service
+type ModerationProvider interface {
+    Check(ctx context.Context, content string) (ModerationVerdict, error)
+}
+
+// aiModerationProvider moderates through the AI service's
+// classification task type; the model returns the violated categories,
+// one per line, or nothing when the content is clean
+type aiModerationProvider struct {
+    client *aiservice.Client
+    model  string
+}
+
+// NewAIModerationProvider adapts the typed AI service client to the
+// moderation interface
+func NewAIModerationProvider(client *aiservice.Client, model string) ModerationProvider {
+    return &aiModerationProvider{client: client, model: model}
+}
+
+func (p *aiModerationProvider) Check(ctx context.Context, content string) (ModerationVerdict, error) {
+    response, err := p.client.ExecuteTask(ctx, &aipb.TaskRequest{
+        TaskType: aipb.TaskType_TASK_TYPE_CLASSIFICATION,
+        Model:    p.model,
+        Prompt:   content,
+    })
+    if err != nil {
+        return ModerationVerdict{}, fmt.Errorf("moderation check failed: %w", err)
+    }
+
+    verdict := ModerationVerdict{}
+    for _, line := range strings.Split(response.Content, "\n") {
+        if category := strings.TrimSpace(line); category != "" {
+            verdict.Categories = append(verdict.Categories, category)
+        }
+    }
+    verdict.Flagged = len(verdict.Categories) > 0
+    return verdict, nil
+}
+
+// ModerationPolicy is a per-node policy parsed from the "moderation"
+// config block
+type ModerationPolicy struct {
+    Mode        string
+    CheckInput  bool
+    CheckOutput bool
+}
+
+// ModerationPolicyFromConfig parses the policy, reporting whether one
+// was configured. Both stages default to checked when the block is
+// present
+func ModerationPolicyFromConfig(config map[string]interface{}) (ModerationPolicy, bool) {
+    block, ok := config["moderation"].(map[string]interface{})
+    if !ok {
+        return ModerationPolicy{}, false
+    }
+
+    policy := ModerationPolicy{Mode: ModerationBlock, CheckInput: true, CheckOutput: true}
+    if mode, ok := block["mode"].(string); ok && mode != "" {
+        policy.Mode = mode
+    }
+    if check, ok := block["check_input"].(bool); ok {
+        policy.CheckInput = check
+    }
+    if check, ok := block["check_output"].(bool); ok {
+        policy.CheckOutput = check
+    }
+    return policy, true
+}
+
+// ModerationEvent is one audited moderation decision
+type ModerationEvent struct {
+    WorkflowID uuid.UUID `json:"workflow_id"`
+    NodeID     uuid.UUID `json:"node_id"`
+    Tenant     string    `json:"tenant,omitempty"`
+    Stage      string    `json:"stage"`
+    Action     string    `json:"action"`
+    Categories []string  `json:"categories,omitempty"`
+}
+
+// Moderator runs pre/post moderation checks for AI nodes, blocking or
+// flagging per policy and emitting audit events for flagged content
+type Moderator struct {
+    provider ModerationProvider
+    onAudit  func(event ModerationEvent)
+}
+
+// NewModerator creates a moderator; onAudit may be nil
+func NewModerator(provider ModerationProvider, onAudit func(event ModerationEvent)) *Moderator {
+    return &Moderator{provider: provider, onAudit: onAudit}
+}
+
+// Check moderates one piece of content at the given stage. Blocked
+// content returns ErrContentBlocked; flagged content under a flag
+// policy passes but is audited
+func (m *Moderator) Check(ctx context.Context, event ModerationEvent, policy ModerationPolicy, content string) error {
+    if event.Stage == ModerationStageInput && !policy.CheckInput {
+        return nil
+    }
+    if event.Stage == ModerationStageOutput && !policy.CheckOutput {
+        return nil
+    }
+
+    verdict, err := m.provider.Check(ctx, content)
+    if err != nil {
+        moderationCheckTotal.WithLabelValues(event.Stage, "error").Inc()
+        return err
+    }
+    if !verdict.Flagged {
+        moderationCheckTotal.WithLabelValues(event.Stage, "clean").Inc()
+        return nil
+    }
+
+    event.Categories = verdict.Categories
+    if policy.Mode == ModerationFlag {
+        event.Action = "flagged"
+        moderationCheckTotal.WithLabelValues(event.Stage, "flagged").Inc()
+        m.audit(event)
+        return nil
+    }
+
+    event.Action = "blocked"
+    moderationCheckTotal.WithLabelValues(event.Stage, "blocked").Inc()
+    m.audit(event)
+    return fmt.Errorf("%w: %s", ErrContentBlocked, strings.Join(verdict.Categories, ", "))
+}
+
+func (m *Moderator) audit(event ModerationEvent) {
+    if m.onAudit != nil {
+        m.onAudit(event)
+    }
+}
+
+// moderationContent flattens a node payload's string values into one
+// document for the provider, in deterministic key order
+func moderationContent(data map[string]interface{}) string {
+    keys := make([]string, 0, len(data))
+    for key, value := range data {
+        if _, ok := value.(string); ok {
+            keys = append(keys, key)
+        }
+    }
+    sort.Strings(keys)
+
+    var builder strings.Builder
+    for _, key := range keys {
+        if builder.Len() > 0 {
+            builder.WriteString("\n")
+        }
+        builder.WriteString(data[key].(string))
+    }
+    return builder.String()
+}
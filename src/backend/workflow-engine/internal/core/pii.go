@@ -0,0 +1,190 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "fmt"
+    "regexp"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Metrics collectors
+var (
+    piiDetectionTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_pii_detection_total",
+            Help: "Total number of PII detections in node outputs",
+        },
+        []string{"detector", "node_type"},
+    )
+)
+
+// PIIDetector matches a single category of personally identifiable
+// information in string values
+type PIIDetector struct {
+    Name    string
+    Pattern *regexp.Regexp
+}
+
+// DefaultPIIDetectors returns the built-in detector set covering the most
+// common PII categories
+func DefaultPIIDetectors() []PIIDetector {
+    return []PIIDetector{
+        {
+            Name:    "email",
+            Pattern: regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`),
+        },
+        {
+            Name:    "ssn",
+            Pattern: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`),
+        },
+        {
+            Name:    "credit_card",
+            Pattern: regexp.MustCompile(`\b(?:\d[ -]?){13,16}\b`),
+        },
+    }
+}
+
+// ComplianceEvent is raised when PII flows through a node that is not
+// marked as a permitted PII handler
+type ComplianceEvent struct {
+    ExecutionID uuid.UUID `json:"execution_id"`
+    WorkflowID  uuid.UUID `json:"workflow_id"`
+    NodeID      uuid.UUID `json:"node_id"`
+    NodeType    string    `json:"node_type"`
+    Detector    string    `json:"detector"`
+    Field       string    `json:"field"`
+    DetectedAt  time.Time `json:"detected_at"`
+}
+
+// ComplianceEventSink receives compliance events raised by the scanner
+type ComplianceEventSink func(event ComplianceEvent)
+
+// PIIScanner inspects node outputs for PII patterns. It implements
+// ExecutionInterceptor so scanning can be enabled without modifying the
+// execution path. Nodes that legitimately handle PII opt in by setting
+// "handles_pii": true in their configuration
+type PIIScanner struct {
+    mu         sync.RWMutex
+    detectors  []PIIDetector
+    sink       ComplianceEventSink
+    byWorkflow map[uuid.UUID]uuid.UUID
+    findings   map[uuid.UUID][]ComplianceEvent
+}
+
+// NewPIIScanner creates a scanner with the given detectors, falling back
+// to the default detector set when none are provided
+func NewPIIScanner(detectors []PIIDetector, sink ComplianceEventSink) *PIIScanner {
+    if len(detectors) == 0 {
+        detectors = DefaultPIIDetectors()
+    }
+
+    // Register metrics
+    registerMetrics(piiDetectionTotal)
+
+    return &PIIScanner{
+        detectors:  detectors,
+        sink:       sink,
+        byWorkflow: make(map[uuid.UUID]uuid.UUID),
+        findings:   make(map[uuid.UUID][]ComplianceEvent),
+    }
+}
+
+// BeforeExecute maps the workflow to its current execution for tagging
+func (p *PIIScanner) BeforeExecute(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID) error {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    p.byWorkflow[workflow.ID] = executionID
+    return nil
+}
+
+// AfterNode scans the node output for PII and raises compliance events
+// when the node is not marked as a permitted PII handler
+func (p *PIIScanner) AfterNode(ctx context.Context, node *models.Node, output map[string]interface{}, duration time.Duration) {
+    if handlesPII, _ := node.Config["handles_pii"].(bool); handlesPII {
+        return
+    }
+
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    executionID := p.byWorkflow[node.WorkflowID]
+
+    for field, value := range output {
+        text, ok := stringValue(value)
+        if !ok {
+            continue
+        }
+
+        for _, detector := range p.detectors {
+            if !detector.Pattern.MatchString(text) {
+                continue
+            }
+
+            piiDetectionTotal.WithLabelValues(detector.Name, string(node.Type)).Inc()
+
+            event := ComplianceEvent{
+                ExecutionID: executionID,
+                WorkflowID:  node.WorkflowID,
+                NodeID:      node.ID,
+                NodeType:    string(node.Type),
+                Detector:    detector.Name,
+                Field:       field,
+                DetectedAt:  time.Now().UTC(),
+            }
+            p.findings[executionID] = append(p.findings[executionID], event)
+
+            if p.sink != nil {
+                p.sink(event)
+            }
+        }
+    }
+}
+
+// OnError releases the workflow-to-execution mapping
+func (p *PIIScanner) OnError(ctx context.Context, workflow *models.Workflow, node *models.Node, err error) {
+    if workflow == nil {
+        return
+    }
+
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    delete(p.byWorkflow, workflow.ID)
+}
+
+// OnComplete releases the workflow-to-execution mapping
+func (p *PIIScanner) OnComplete(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID, duration time.Duration) {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    delete(p.byWorkflow, workflow.ID)
+}
+
+// Findings returns the compliance events recorded for an execution,
+// allowing executions to be tagged as having carried unexpected PII
+func (p *PIIScanner) Findings(executionID uuid.UUID) []ComplianceEvent {
+    p.mu.RLock()
+    defer p.mu.RUnlock()
+
+    findings := make([]ComplianceEvent, len(p.findings[executionID]))
+    copy(findings, p.findings[executionID])
+    return findings
+}
+
+// stringValue coerces scalar output values into scannable text
+func stringValue(value interface{}) (string, bool) {
+    switch v := value.(type) {
+    case string:
+        return v, true
+    case fmt.Stringer:
+        return v.String(), true
+    default:
+        return "", false
+    }
+}
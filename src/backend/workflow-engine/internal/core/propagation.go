@@ -0,0 +1,77 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/uber/jaeger-client-go" // v2.30.0
+)
+
+// W3C trace context carrier keys
+const (
+    traceparentKey = "traceparent"
+    tracestateKey  = "tracestate"
+)
+
+// InjectTraceContext serializes the span's trace context into a string
+// carrier suitable for persisting alongside queued or scheduled
+// executions. The carrier includes the W3C traceparent header so the
+// context also propagates over gRPC calls to the AI and integration
+// services.
+func InjectTraceContext(span opentracing.Span) map[string]string {
+    if span == nil {
+        return nil
+    }
+
+    carrier := make(map[string]string)
+    tracer := opentracing.GlobalTracer()
+    if err := tracer.Inject(span.Context(), opentracing.TextMap, opentracing.TextMapCarrier(carrier)); err != nil {
+        return nil
+    }
+
+    // Add the W3C representation for interoperability with services using
+    // OpenTelemetry propagation
+    if spanContext, ok := span.Context().(jaeger.SpanContext); ok {
+        flags := "00"
+        if spanContext.IsSampled() {
+            flags = "01"
+        }
+        carrier[traceparentKey] = fmt.Sprintf("00-%032s-%016s-%s",
+            spanContext.TraceID().String(), spanContext.SpanID().String(), flags)
+    }
+
+    return carrier
+}
+
+// ExtractTraceContext restores a previously persisted trace context,
+// returning nil when the carrier is empty or invalid
+func ExtractTraceContext(carrier map[string]string) opentracing.SpanContext {
+    if len(carrier) == 0 {
+        return nil
+    }
+
+    tracer := opentracing.GlobalTracer()
+    spanContext, err := tracer.Extract(opentracing.TextMap, opentracing.TextMapCarrier(carrier))
+    if err != nil {
+        return nil
+    }
+    return spanContext
+}
+
+// ContextWithRestoredSpan starts a span that follows from a persisted
+// trace context and returns a context carrying it, stitching the original
+// API call or cron fire to the eventual execution
+func ContextWithRestoredSpan(ctx context.Context, operation string, carrier map[string]string) (context.Context, opentracing.Span) {
+    tracer := opentracing.GlobalTracer()
+
+    parent := ExtractTraceContext(carrier)
+    if parent == nil {
+        span := tracer.StartSpan(operation)
+        return opentracing.ContextWithSpan(ctx, span), span
+    }
+
+    span := tracer.StartSpan(operation, opentracing.FollowsFrom(parent))
+    return opentracing.ContextWithSpan(ctx, span), span
+}
@@ -0,0 +1,335 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "container/heap"
+    "context"
+    "errors"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// Common queue errors
+var (
+    ErrQueueFull  = errors.New("execution queue is full")
+    ErrPreempted  = errors.New("queued execution preempted by higher priority work")
+    ErrQueueClosed = errors.New("execution queue is closed")
+)
+
+// Queue constants
+const (
+    // defaultQueueCapacity bounds how many executions may wait for a slot
+    defaultQueueCapacity = 1000
+
+    // PriorityLow through PriorityHigh are the standard priority levels
+    PriorityLow    = 0
+    PriorityNormal = 50
+    PriorityHigh   = 100
+)
+
+// Metrics collectors
+var (
+    queueDepth = prometheus.NewGauge(
+        prometheus.GaugeOpts{
+            Name: "workflow_execution_queue_depth",
+            Help: "Number of executions waiting in the priority queue",
+        },
+    )
+
+    queuePreemptionTotal = prometheus.NewCounter(
+        prometheus.CounterOpts{
+            Name: "workflow_execution_preemption_total",
+            Help: "Total number of queued executions preempted by higher priority work",
+        },
+    )
+
+    queueDispatchFailureTotal = prometheus.NewCounter(
+        prometheus.CounterOpts{
+            Name: "workflow_execution_dispatch_failure_total",
+            Help: "Total number of queued executions that failed to start",
+        },
+    )
+)
+
+// queuedExecution is one entry waiting for an execution slot. It carries
+// the trace context and determinism source of the request that enqueued
+// it so dispatch restores the context the caller started with
+type queuedExecution struct {
+    workflowID   uuid.UUID
+    opts         map[string]interface{}
+    priority     int
+    enqueuedAt   time.Time
+    traceContext map[string]string
+    determinism  *Determinism
+    done         chan error
+    index        int
+}
+
+// executionHeap orders queued executions by priority, then FIFO within a
+// priority level
+type executionHeap []*queuedExecution
+
+func (h executionHeap) Len() int { return len(h) }
+
+func (h executionHeap) Less(i, j int) bool {
+    if h[i].priority != h[j].priority {
+        return h[i].priority > h[j].priority
+    }
+    return h[i].enqueuedAt.Before(h[j].enqueuedAt)
+}
+
+func (h executionHeap) Swap(i, j int) {
+    h[i], h[j] = h[j], h[i]
+    h[i].index = i
+    h[j].index = j
+}
+
+func (h *executionHeap) Push(x interface{}) {
+    item := x.(*queuedExecution)
+    item.index = len(*h)
+    *h = append(*h, item)
+}
+
+func (h *executionHeap) Pop() interface{} {
+    old := *h
+    n := len(old)
+    item := old[n-1]
+    old[n-1] = nil
+    *h = old[:n-1]
+    return item
+}
+
+// QueueTarget is the slice of the engine the queue needs: capacity
+// introspection and execution startup. *Engine satisfies it
+type QueueTarget interface {
+    ActiveExecutions() int
+    MaxConcurrentExecutions() int
+    StartWorkflow(ctx context.Context, workflowID uuid.UUID, opts map[string]interface{}) error
+}
+
+var _ QueueTarget = (*Engine)(nil)
+
+// ExecutionQueue holds executions waiting for engine capacity in priority
+// order. When the queue is full, arriving high-priority work preempts the
+// lowest-priority queued (never running) execution
+type ExecutionQueue struct {
+    mu        sync.Mutex
+    engine    QueueTarget
+    pending   executionHeap
+    capacity  int
+    wake      chan struct{}
+    shutdown  chan struct{}
+    monitor   *CapacityMonitor
+    onFailure func(workflowID uuid.UUID, opts map[string]interface{}, err error)
+}
+
+// NewExecutionQueue creates a priority queue in front of the engine and
+// starts its dispatch loop
+func NewExecutionQueue(engine QueueTarget, capacity int) *ExecutionQueue {
+    if capacity <= 0 {
+        capacity = defaultQueueCapacity
+    }
+
+    // Register metrics
+    registerMetrics(queueDepth, queuePreemptionTotal, queueDispatchFailureTotal)
+
+    queue := &ExecutionQueue{
+        engine:   engine,
+        pending:  make(executionHeap, 0),
+        capacity: capacity,
+        wake:     make(chan struct{}, 1),
+        shutdown: make(chan struct{}),
+    }
+    heap.Init(&queue.pending)
+
+    go queue.dispatchLoop()
+
+    return queue
+}
+
+// Enqueue places an execution in the queue and returns a channel that
+// resolves when the execution finishes or is preempted. The request's
+// trace context and determinism source are captured from ctx and restored
+// at dispatch. Priority defaults to PriorityNormal when the "priority"
+// option is absent
+func (q *ExecutionQueue) Enqueue(ctx context.Context, workflowID uuid.UUID, opts map[string]interface{}) (<-chan error, error) {
+    priority := PriorityNormal
+    if p, ok := opts["priority"].(float64); ok {
+        priority = int(p)
+    }
+
+    item := &queuedExecution{
+        workflowID:   workflowID,
+        opts:         opts,
+        priority:     priority,
+        enqueuedAt:   time.Now(),
+        traceContext: InjectTraceContext(opentracing.SpanFromContext(ctx)),
+        determinism:  DeterminismFromContext(ctx),
+        done:         make(chan error, 1),
+    }
+
+    q.mu.Lock()
+    if len(q.pending) >= q.capacity {
+        // Preempt the lowest-priority queued execution if the new work
+        // outranks it; otherwise reject the new work
+        victim := q.lowestPriority()
+        if victim == nil || victim.priority >= priority {
+            q.mu.Unlock()
+            return nil, ErrQueueFull
+        }
+        heap.Remove(&q.pending, victim.index)
+        victim.done <- ErrPreempted
+        queuePreemptionTotal.Inc()
+    }
+    heap.Push(&q.pending, item)
+    queueDepth.Set(float64(len(q.pending)))
+    q.mu.Unlock()
+
+    select {
+    case q.wake <- struct{}{}:
+    default:
+    }
+
+    return item.done, nil
+}
+
+// SetCapacityMonitor attaches a capacity monitor that receives queue
+// wait observations as executions are dispatched
+func (q *ExecutionQueue) SetCapacityMonitor(monitor *CapacityMonitor) {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    q.monitor = monitor
+}
+
+// SetDispatchFailureHandler attaches a callback invoked when a queued
+// execution fails to start. Callers that discard the done channel still
+// get dispatch failures logged or persisted through this hook
+func (q *ExecutionQueue) SetDispatchFailureHandler(handler func(workflowID uuid.UUID, opts map[string]interface{}, err error)) {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    q.onFailure = handler
+}
+
+// Depth returns the number of executions currently queued
+func (q *ExecutionQueue) Depth() int {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+    return len(q.pending)
+}
+
+// Shutdown stops the dispatch loop and fails all queued executions
+func (q *ExecutionQueue) Shutdown() {
+    close(q.shutdown)
+
+    q.mu.Lock()
+    defer q.mu.Unlock()
+
+    for _, item := range q.pending {
+        item.done <- ErrQueueClosed
+    }
+    q.pending = q.pending[:0]
+    queueDepth.Set(0)
+}
+
+// lowestPriority returns the queued execution with the lowest priority,
+// breaking ties by most recently enqueued; callers must hold the lock
+func (q *ExecutionQueue) lowestPriority() *queuedExecution {
+    var victim *queuedExecution
+    for _, item := range q.pending {
+        if victim == nil ||
+            item.priority < victim.priority ||
+            (item.priority == victim.priority && item.enqueuedAt.After(victim.enqueuedAt)) {
+            victim = item
+        }
+    }
+    return victim
+}
+
+// dispatchLoop starts queued executions whenever the engine has capacity
+func (q *ExecutionQueue) dispatchLoop() {
+    ticker := time.NewTicker(100 * time.Millisecond)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-q.wake:
+        case <-ticker.C:
+        case <-q.shutdown:
+            return
+        }
+
+        for q.engine.ActiveExecutions() < q.engine.MaxConcurrentExecutions() {
+            q.mu.Lock()
+            if len(q.pending) == 0 {
+                q.mu.Unlock()
+                break
+            }
+            item := heap.Pop(&q.pending).(*queuedExecution)
+            queueDepth.Set(float64(len(q.pending)))
+            monitor := q.monitor
+            onFailure := q.onFailure
+            q.mu.Unlock()
+
+            if monitor != nil {
+                monitor.ObserveQueueWait(time.Since(item.enqueuedAt))
+            }
+
+            go func(item *queuedExecution) {
+                err := q.dispatch(item)
+                if errors.Is(err, ErrMaxConcurrentExecutions) {
+                    // Lost the capacity race to a directly started run;
+                    // put the work back instead of failing it
+                    q.requeue(item)
+                    return
+                }
+                if err != nil {
+                    queueDispatchFailureTotal.Inc()
+                    if onFailure != nil {
+                        onFailure(item.workflowID, item.opts, err)
+                    }
+                }
+                item.done <- err
+            }(item)
+        }
+    }
+}
+
+// dispatch starts one queued execution under a context rebuilt from what
+// its caller enqueued with: the originating trace continues into the run
+// and a deterministic seed survives the wait in the queue
+func (q *ExecutionQueue) dispatch(item *queuedExecution) error {
+    ctx := context.Background()
+    if item.determinism != nil {
+        ctx = context.WithValue(ctx, determinismContextKey{}, item.determinism)
+    }
+    ctx, span := ContextWithRestoredSpan(ctx, "ExecutionQueue.Dispatch", item.traceContext)
+    defer span.Finish()
+
+    span.SetTag("workflow_id", item.workflowID)
+    return q.engine.StartWorkflow(ctx, item.workflowID, item.opts)
+}
+
+// requeue returns an execution that lost the capacity race to the queue,
+// or fails it with ErrQueueClosed when the queue has shut down meanwhile
+func (q *ExecutionQueue) requeue(item *queuedExecution) {
+    q.mu.Lock()
+    select {
+    case <-q.shutdown:
+        q.mu.Unlock()
+        item.done <- ErrQueueClosed
+        return
+    default:
+    }
+    heap.Push(&q.pending, item)
+    queueDepth.Set(float64(len(q.pending)))
+    q.mu.Unlock()
+
+    select {
+    case q.wake <- struct{}{}:
+    default:
+    }
+}
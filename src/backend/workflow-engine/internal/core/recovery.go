@@ -0,0 +1,217 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "time"
+
+    "github.com/google/uuid"                         // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+    "github.com/opentracing/opentracing-go"          // v1.2.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common recovery errors
+var (
+    ErrRecoveryStoreUnavailable = errors.New("execution store unavailable for recovery")
+)
+
+// Recovery action constants
+const (
+    RecoveryActionResumed = "resumed"
+    RecoveryActionRetried = "retried"
+    RecoveryActionFailed  = "failed"
+
+    // Default limits for the startup recovery scan
+    defaultRecoveryBatchSize  = 100
+    defaultRecoveryMaxRetries = 3
+)
+
+// Metrics collectors
+var (
+    recoveredExecutionsTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_recovered_executions_total",
+            Help: "Total number of orphaned executions recovered on startup",
+        },
+        []string{"action"},
+    )
+
+    recoveryScanDuration = prometheus.NewHistogram(
+        prometheus.HistogramOpts{
+            Name: "workflow_recovery_scan_duration_seconds",
+            Help: "Duration of the startup orphaned execution scan",
+            Buckets: []float64{0.1, 0.5, 1, 2, 5, 10, 30},
+        },
+    )
+)
+
+// ExecutionStore defines the persistence interface required for recovery
+type ExecutionStore interface {
+    ListByStatus(ctx context.Context, status string, limit int) ([]*models.Execution, error)
+    Update(ctx context.Context, execution *models.Execution) error
+    GetWorkflow(ctx context.Context, id uuid.UUID) (*models.Workflow, error)
+}
+
+// InstanceChecker reports whether an engine instance is still alive
+type InstanceChecker interface {
+    IsAlive(ctx context.Context, instanceID string) (bool, error)
+}
+
+// RecoveryConfig holds configuration for startup recovery
+type RecoveryConfig struct {
+    BatchSize  int
+    MaxRetries int
+}
+
+// RecoveryManager scans the execution store on startup for orphaned runs and
+// resumes, retries, or fails them with a recorded recovery reason
+type RecoveryManager struct {
+    store     ExecutionStore
+    instances InstanceChecker
+    executor  WorkflowExecutor
+    config    RecoveryConfig
+}
+
+// NewRecoveryManager creates a new recovery manager instance
+func NewRecoveryManager(store ExecutionStore, instances InstanceChecker, executor WorkflowExecutor, config RecoveryConfig) *RecoveryManager {
+    if config.BatchSize <= 0 {
+        config.BatchSize = defaultRecoveryBatchSize
+    }
+    if config.MaxRetries <= 0 {
+        config.MaxRetries = defaultRecoveryMaxRetries
+    }
+
+    // Register metrics
+    registerMetrics(recoveredExecutionsTotal, recoveryScanDuration)
+
+    return &RecoveryManager{
+        store:     store,
+        instances: instances,
+        executor:  executor,
+        config:    config,
+    }
+}
+
+// RecoverOrphanedExecutions scans for executions marked running whose owning
+// instance is gone and applies the appropriate recovery action
+func (r *RecoveryManager) RecoverOrphanedExecutions(ctx context.Context) error {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "RecoverOrphanedExecutions")
+    defer span.Finish()
+
+    if r.store == nil {
+        return ErrRecoveryStoreUnavailable
+    }
+
+    startTime := time.Now()
+    defer func() {
+        recoveryScanDuration.Observe(time.Since(startTime).Seconds())
+    }()
+
+    executions, err := r.store.ListByStatus(ctx, "running", r.config.BatchSize)
+    if err != nil {
+        return fmt.Errorf("failed to list running executions: %w", err)
+    }
+
+    for _, execution := range executions {
+        orphaned, err := r.isOrphaned(ctx, execution)
+        if err != nil {
+            span.LogKV("warning", "instance liveness check failed", "execution_id", execution.ID, "error", err.Error())
+            continue
+        }
+        if !orphaned {
+            continue
+        }
+
+        action, err := r.recoverExecution(ctx, execution)
+        if err != nil {
+            span.LogKV("error", "recovery failed", "execution_id", execution.ID, "error", err.Error())
+            continue
+        }
+
+        recoveredExecutionsTotal.WithLabelValues(action).Inc()
+    }
+
+    return nil
+}
+
+// isOrphaned reports whether the owning instance of an execution is gone
+func (r *RecoveryManager) isOrphaned(ctx context.Context, execution *models.Execution) (bool, error) {
+    if execution.InstanceID == "" {
+        return true, nil
+    }
+
+    alive, err := r.instances.IsAlive(ctx, execution.InstanceID)
+    if err != nil {
+        return false, fmt.Errorf("failed to check instance %s: %w", execution.InstanceID, err)
+    }
+
+    return !alive, nil
+}
+
+// recoverExecution applies a single recovery action to an orphaned execution
+func (r *RecoveryManager) recoverExecution(ctx context.Context, execution *models.Execution) (string, error) {
+    // Resume checkpointed executions from their last persisted state
+    if execution.HasCheckpoint() {
+        if err := r.restartExecution(ctx, execution, "resumed from checkpoint after instance loss"); err != nil {
+            return "", err
+        }
+        return RecoveryActionResumed, nil
+    }
+
+    // Retry executions that have retry budget remaining
+    if execution.RetryCount < r.config.MaxRetries {
+        execution.RetryCount++
+        if err := r.restartExecution(ctx, execution, "retried after instance loss"); err != nil {
+            return "", err
+        }
+        return RecoveryActionRetried, nil
+    }
+
+    // Mark everything else failed with a recovery reason
+    execution.MarkFailed("orphaned by lost instance, retry budget exhausted")
+    execution.RecoveryReason = "instance lost, max retries exceeded"
+    if err := r.store.Update(ctx, execution); err != nil {
+        return "", fmt.Errorf("failed to mark execution failed: %w", err)
+    }
+
+    return RecoveryActionFailed, nil
+}
+
+// restartExecution re-executes the workflow backing an orphaned execution
+func (r *RecoveryManager) restartExecution(ctx context.Context, execution *models.Execution, reason string) error {
+    workflow, err := r.store.GetWorkflow(ctx, execution.WorkflowID)
+    if err != nil {
+        return fmt.Errorf("failed to load workflow %s: %w", execution.WorkflowID, err)
+    }
+
+    execution.RecoveryReason = reason
+    execution.MarkRunning("")
+
+    // Re-stamp the definition hash in case the workflow changed between
+    // the original run and the recovery
+    if hash, err := workflow.Hash(); err == nil {
+        execution.SetDefinitionHash(hash)
+    }
+
+    if err := r.store.Update(ctx, execution); err != nil {
+        return fmt.Errorf("failed to update execution: %w", err)
+    }
+
+    // Continue the original trace so the recovered run links back to the
+    // API call or schedule firing that started it
+    if carrier := execution.GetTraceContext(); len(carrier) > 0 {
+        var span opentracing.Span
+        ctx, span = ContextWithRestoredSpan(ctx, "RecoverExecution", carrier)
+        defer span.Finish()
+        applyExecutionTags(span, ExecutionSpanTags{
+            WorkflowID:  execution.WorkflowID,
+            ExecutionID: execution.ID,
+        })
+    }
+
+    return r.executor.ExecuteWorkflow(ctx, workflow)
+}
@@ -0,0 +1,117 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "sync"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Metrics collectors
+var (
+    compensationTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_compensation_total",
+            Help: "Total number of compensation actions executed during saga rollback",
+        },
+        []string{"node_type", "status"},
+    )
+)
+
+// compensationEntry records a completed node whose side effects must be
+// undone if a later node fails permanently
+type compensationEntry struct {
+    node   *models.Node
+    output map[string]interface{}
+}
+
+// compensationLog accumulates compensation entries in completion order so
+// rollback can run them in reverse
+type compensationLog struct {
+    mu      sync.Mutex
+    entries []compensationEntry
+}
+
+// record appends a compensation entry for a completed node
+func (l *compensationLog) record(node *models.Node, output map[string]interface{}) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+
+    l.entries = append(l.entries, compensationEntry{node: node, output: output})
+}
+
+// drain returns the recorded entries in reverse completion order
+func (l *compensationLog) drain() []compensationEntry {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+
+    reversed := make([]compensationEntry, 0, len(l.entries))
+    for i := len(l.entries) - 1; i >= 0; i-- {
+        reversed = append(reversed, l.entries[i])
+    }
+    l.entries = nil
+    return reversed
+}
+
+// compensationConfig reads the node's declared compensation action.
+// Nodes opt in by setting "compensation" to the configuration the node
+// executor should run to undo their side effect
+func compensationConfig(node *models.Node) (map[string]interface{}, bool) {
+    config, ok := node.Config["compensation"].(map[string]interface{})
+    if !ok || len(config) == 0 {
+        return nil, false
+    }
+    return config, true
+}
+
+// runCompensations executes recorded compensation actions in reverse
+// completion order, giving workflows saga-style rollback for external
+// side effects. Compensation failures are recorded but do not stop the
+// remaining entries from running
+func (e *Executor) runCompensations(ctx context.Context, log *compensationLog) {
+    entries := log.drain()
+    if len(entries) == 0 {
+        return
+    }
+
+    // Rollback runs under its own deadline so a canceled execution
+    // context cannot leave side effects uncompensated
+    ctx, cancel := context.WithTimeout(context.Background(), defaultExecutionTimeout)
+    defer cancel()
+
+    for _, entry := range entries {
+        config, ok := compensationConfig(entry.node)
+        if !ok {
+            continue
+        }
+
+        executor, exists := e.nodeExecutors[entry.node.Type]
+        if !exists {
+            compensationTotal.WithLabelValues(string(entry.node.Type), "skipped").Inc()
+            continue
+        }
+
+        // Run the node executor against the compensation configuration,
+        // passing the original output so the action can reference the
+        // identifiers of the side effect it is undoing
+        compensationNode := &models.Node{
+            ID:         entry.node.ID,
+            WorkflowID: entry.node.WorkflowID,
+            Type:       entry.node.Type,
+            Name:       entry.node.Name + " (compensation)",
+            Config:     config,
+            CreatedAt:  entry.node.CreatedAt,
+            UpdatedAt:  time.Now().UTC(),
+        }
+
+        if _, err := executor.Execute(ctx, compensationNode, entry.output); err != nil {
+            compensationTotal.WithLabelValues(string(entry.node.Type), "failed").Inc()
+            continue
+        }
+        compensationTotal.WithLabelValues(string(entry.node.Type), "success").Inc()
+    }
+}
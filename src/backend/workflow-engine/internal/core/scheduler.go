@@ -14,7 +14,7 @@ import (
     "github.com/prometheus/client_golang/prometheus" // v1.16.0
     "github.com/opentracing/opentracing-go"         // v1.2.0
 
-    "internal/models"
+    "github.com/organization/workflow-engine/internal/models"
 )
 
 // Common errors
@@ -60,9 +60,15 @@ type scheduleContext struct {
     timer        *time.Timer
     lastRun      time.Time
     nextRun      time.Time
+    paused       bool
+    running      bool
+    allowOverlap bool
     retryBackoff *backoff.ExponentialBackOff
     span         opentracing.Span
+    traceContext map[string]string
     cancel       context.CancelFunc
+    maxConcurrent int
+    activeRuns    int
 }
 
 // SchedulerConfig holds configuration for the scheduler
@@ -72,6 +78,9 @@ type SchedulerConfig struct {
     RetryInitialWait  time.Duration
     RetryMaxWait      time.Duration
     MaintenanceInterval time.Duration
+    // MaxConcurrentScheduled caps how many scheduled executions may run
+    // simultaneously across all schedules; zero means unlimited
+    MaxConcurrentScheduled int
 }
 
 // Scheduler manages workflow scheduling with enhanced reliability and observability
@@ -84,6 +93,66 @@ type Scheduler struct {
     cancel          context.CancelFunc
     backoff         *backoff.ExponentialBackOff
     maintenance     chan struct{}
+    maintenanceMode *MaintenanceManager
+    maxConcurrent   int
+    activeRuns      int
+    waiting         []uuid.UUID
+    capacity        *CapacityMonitor
+    follower        bool
+    stateStore      ScheduleStateStore
+    calendars       *CalendarRegistry
+}
+
+// SetCalendarRegistry attaches the shared calendar registry that
+// calendar schedules resolve calendar_id references against
+func (s *Scheduler) SetCalendarRegistry(registry *CalendarRegistry) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.calendars = registry
+}
+
+// SetMaintenanceManager attaches a maintenance manager; when a maintenance
+// window is active, scheduled executions are skipped globally
+func (s *Scheduler) SetMaintenanceManager(manager *MaintenanceManager) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.maintenanceMode = manager
+}
+
+// SetLeader promotes or demotes this scheduler. A follower keeps its
+// schedules installed but skips firings, so leadership can move between
+// replicas without reinstalling every schedule
+func (s *Scheduler) SetLeader(leader bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.follower = !leader
+}
+
+// RecomputeNextRuns refreshes every cron schedule's intended next fire
+// time from the cron entries. Called on leadership handoff so drift
+// tracking and catch-up logic start from the current clock rather than
+// from times computed while another replica led
+func (s *Scheduler) RecomputeNextRuns() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for _, schedCtx := range s.activeSchedules {
+        if schedCtx.cronID == 0 {
+            continue
+        }
+        entry := s.cronScheduler.Entry(schedCtx.cronID)
+        if !entry.Next.IsZero() {
+            schedCtx.nextRun = entry.Next
+        }
+    }
+}
+
+// SetCapacityMonitor attaches a capacity monitor that receives drift
+// observations each time a schedule fires
+func (s *Scheduler) SetCapacityMonitor(monitor *CapacityMonitor) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.capacity = monitor
 }
 
 // NewScheduler creates a new scheduler instance with the provided configuration
@@ -91,6 +160,15 @@ func NewScheduler(executor *Executor, config SchedulerConfig) *Scheduler {
     if config.Location == nil {
         config.Location = time.UTC
     }
+    if config.RetryInitialWait == 0 {
+        config.RetryInitialWait = time.Second
+    }
+    if config.RetryMaxWait == 0 {
+        config.RetryMaxWait = time.Minute
+    }
+    if config.MaintenanceInterval == 0 {
+        config.MaintenanceInterval = 5 * time.Minute
+    }
 
     ctx, cancel := context.WithCancel(context.Background())
     
@@ -103,6 +181,7 @@ func NewScheduler(executor *Executor, config SchedulerConfig) *Scheduler {
         ctx:            ctx,
         cancel:         cancel,
         maintenance:    make(chan struct{}),
+        maxConcurrent:  config.MaxConcurrentScheduled,
     }
 
     // Configure default backoff
@@ -112,9 +191,7 @@ func NewScheduler(executor *Executor, config SchedulerConfig) *Scheduler {
     scheduler.backoff.MaxElapsedTime = 0 // Never stop retrying
 
     // Register metrics
-    prometheus.MustRegister(scheduledWorkflowTotal)
-    prometheus.MustRegister(scheduledWorkflowExecutionTotal)
-    prometheus.MustRegister(scheduledWorkflowLatency)
+    registerMetrics(scheduledWorkflowTotal, scheduledWorkflowExecutionTotal, scheduledWorkflowLatency)
 
     // Start maintenance worker
     go scheduler.maintenanceWorker(config.MaintenanceInterval)
@@ -135,19 +212,91 @@ func (s *Scheduler) ScheduleWorkflow(ctx context.Context, workflow *models.Workf
     }
 
     s.mu.Lock()
-    defer s.mu.Unlock()
 
-    // Check for existing schedule
+    // Check for existing schedule; callers wanting PUT semantics can set
+    // replace_existing to update the config atomically instead
     if _, exists := s.activeSchedules[workflow.ID]; exists {
-        return ErrScheduleConflict
+        if replace, ok := scheduleConfig["replace_existing"].(bool); !ok || !replace {
+            s.mu.Unlock()
+            return ErrScheduleConflict
+        }
+        s.removeScheduleLocked(workflow.ID)
+    }
+
+    if err := s.installScheduleLocked(span, workflow, scheduleConfig); err != nil {
+        s.mu.Unlock()
+        return err
     }
+    s.mu.Unlock()
+
+    s.persistState("save", func(ctx context.Context) error {
+        return s.stateStoreSnapshot().SaveSchedule(ctx, workflow.ID, scheduleConfig, false)
+    })
+    return nil
+}
 
+// ReplaceSchedule atomically installs a schedule for the workflow,
+// removing any existing one under the same lock so callers get upsert
+// semantics without delete+create races. A replaced schedule starts
+// unpaused
+func (s *Scheduler) ReplaceSchedule(ctx context.Context, workflow *models.Workflow, scheduleConfig map[string]interface{}) error {
+    span, _ := opentracing.StartSpanFromContext(ctx, "ReplaceSchedule")
+    defer span.Finish()
+
+    span.SetTag("workflow_id", workflow.ID)
+
+    // Validate schedule configuration
+    if err := s.validateScheduleConfig(scheduleConfig); err != nil {
+        return fmt.Errorf("invalid schedule configuration: %w", err)
+    }
+
+    s.mu.Lock()
+
+    if _, exists := s.activeSchedules[workflow.ID]; exists {
+        s.removeScheduleLocked(workflow.ID)
+    }
+
+    if err := s.installScheduleLocked(span, workflow, scheduleConfig); err != nil {
+        s.mu.Unlock()
+        return err
+    }
+    s.mu.Unlock()
+
+    s.persistState("save", func(ctx context.Context) error {
+        return s.stateStoreSnapshot().SaveSchedule(ctx, workflow.ID, scheduleConfig, false)
+    })
+    return nil
+}
+
+// cloneBackoff gives each schedule its own backoff state derived from
+// the scheduler's template so retries do not share intervals
+func cloneBackoff(template *backoff.ExponentialBackOff) *backoff.ExponentialBackOff {
+    clone := *template
+    clone.Reset()
+    return &clone
+}
+
+// installScheduleLocked registers a validated schedule. The caller must
+// hold the scheduler lock and guarantee no schedule exists for the
+// workflow
+func (s *Scheduler) installScheduleLocked(span opentracing.Span, workflow *models.Workflow, scheduleConfig map[string]interface{}) error {
     // Create schedule context
     schedCtx := &scheduleContext{
         workflow: workflow,
         config:   scheduleConfig,
-        retryBackoff: s.backoff.Clone(),
+        retryBackoff: cloneBackoff(s.backoff),
         span:     span,
+        traceContext: InjectTraceContext(span),
+    }
+
+    // Overlapping runs are skipped unless explicitly allowed
+    if allowOverlap, ok := scheduleConfig["allow_overlap"].(bool); ok {
+        schedCtx.allowOverlap = allowOverlap
+    }
+
+    // Per-schedule cap on simultaneous runs; zero means unlimited
+    if maxConcurrent, ok := scheduleConfig["max_concurrent"].(float64); ok {
+        schedCtx.maxConcurrent = int(maxConcurrent)
     }
 
     // Handle different schedule types
@@ -169,6 +318,13 @@ func (s *Scheduler) ScheduleWorkflow(ctx context.Context, workflow *models.Workf
         schedCtx.timer = timer
         go s.handleIntervalSchedule(workflow.ID, interval, timer)
 
+    case "calendar":
+        calendar, at, err := calendarConfigFromSchedule(scheduleConfig, s.cronScheduler.Location(), s.calendars)
+        if err != nil {
+            return err
+        }
+        go s.handleCalendarSchedule(workflow.ID, calendar, at)
+
     default:
         return fmt.Errorf("%w: unsupported schedule type", ErrInvalidSchedule)
     }
@@ -190,13 +346,26 @@ func (s *Scheduler) UnscheduleWorkflow(ctx context.Context, workflowID uuid.UUID
     defer span.Finish()
 
     s.mu.Lock()
-    defer s.mu.Unlock()
 
-    schedCtx, exists := s.activeSchedules[workflowID]
-    if !exists {
+    if _, exists := s.activeSchedules[workflowID]; !exists {
+        s.mu.Unlock()
         return ErrScheduleNotFound
     }
 
+    s.removeScheduleLocked(workflowID)
+    s.mu.Unlock()
+
+    s.persistState("delete", func(ctx context.Context) error {
+        return s.stateStoreSnapshot().DeleteSchedule(ctx, workflowID)
+    })
+    return nil
+}
+
+// removeScheduleLocked tears down an existing schedule. The caller must
+// hold the scheduler lock and guarantee the schedule exists
+func (s *Scheduler) removeScheduleLocked(workflowID uuid.UUID) {
+    schedCtx := s.activeSchedules[workflowID]
+
     // Clean up based on schedule type
     if schedCtx.cronID != 0 {
         s.cronScheduler.Remove(schedCtx.cronID)
@@ -215,13 +384,78 @@ func (s *Scheduler) UnscheduleWorkflow(ctx context.Context, workflowID uuid.UUID
     schedCtx.workflow.Metadata["scheduled"] = false
     delete(schedCtx.workflow.Metadata, "schedule_type")
     delete(schedCtx.workflow.Metadata, "schedule_config")
+}
+
+// PauseSchedule suspends execution of a single workflow schedule without
+// removing it
+func (s *Scheduler) PauseSchedule(ctx context.Context, workflowID uuid.UUID) error {
+    span, _ := opentracing.StartSpanFromContext(ctx, "PauseSchedule")
+    defer span.Finish()
+
+    s.mu.Lock()
+
+    schedCtx, exists := s.activeSchedules[workflowID]
+    if !exists {
+        s.mu.Unlock()
+        return ErrScheduleNotFound
+    }
+
+    schedCtx.paused = true
+    schedCtx.workflow.Metadata["schedule_paused"] = true
+    scheduledWorkflowTotal.WithLabelValues("paused", schedCtx.config["type"].(string)).Inc()
+    config := schedCtx.config
+    s.mu.Unlock()
+
+    s.persistState("pause", func(ctx context.Context) error {
+        return s.stateStoreSnapshot().SaveSchedule(ctx, workflowID, config, true)
+    })
+    return nil
+}
+
+// ResumeSchedule resumes a previously paused workflow schedule
+func (s *Scheduler) ResumeSchedule(ctx context.Context, workflowID uuid.UUID) error {
+    span, _ := opentracing.StartSpanFromContext(ctx, "ResumeSchedule")
+    defer span.Finish()
+
+    s.mu.Lock()
+
+    schedCtx, exists := s.activeSchedules[workflowID]
+    if !exists {
+        s.mu.Unlock()
+        return ErrScheduleNotFound
+    }
+
+    schedCtx.paused = false
+    schedCtx.workflow.Metadata["schedule_paused"] = false
+    scheduledWorkflowTotal.WithLabelValues("resumed", schedCtx.config["type"].(string)).Inc()
+    config := schedCtx.config
+    s.mu.Unlock()
 
+    s.persistState("resume", func(ctx context.Context) error {
+        return s.stateStoreSnapshot().SaveSchedule(ctx, workflowID, config, false)
+    })
     return nil
 }
 
+// IsSchedulePaused reports whether a workflow schedule is currently paused
+func (s *Scheduler) IsSchedulePaused(workflowID uuid.UUID) (bool, error) {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
+    schedCtx, exists := s.activeSchedules[workflowID]
+    if !exists {
+        return false, ErrScheduleNotFound
+    }
+
+    return schedCtx.paused, nil
+}
+
 // Start begins the scheduler operation
 func (s *Scheduler) Start() {
     s.cronScheduler.Start()
+
+    // Apply catch-up policies for runs missed while the scheduler was down
+    go s.CatchUpMissedRuns()
 }
 
 // Stop gracefully shuts down the scheduler
@@ -248,19 +482,114 @@ func (s *Scheduler) Stop() {
 func (s *Scheduler) executeScheduledWorkflow(workflowID uuid.UUID) {
     s.mu.RLock()
     schedCtx, exists := s.activeSchedules[workflowID]
+    maintenanceMode := s.maintenanceMode
+    follower := s.follower
     s.mu.RUnlock()
 
     if !exists {
         return
     }
 
+    // Followers keep schedules installed but leave firing to the lease
+    // holder so a fire never happens twice across replicas
+    if follower {
+        scheduledWorkflowExecutionTotal.WithLabelValues("skipped_follower", schedCtx.config["type"].(string)).Inc()
+        return
+    }
+
+    // Skip executions for individually paused schedules
+    if schedCtx.paused {
+        scheduledWorkflowExecutionTotal.WithLabelValues("paused", schedCtx.config["type"].(string)).Inc()
+        return
+    }
+
+    // Skip scheduled executions while a maintenance window is active
+    if maintenanceMode != nil {
+        if paused, _ := maintenanceMode.InMaintenance(); paused {
+            maintenanceMode.RecordSkipped()
+            return
+        }
+    }
+
     startTime := time.Now()
     scheduleType := schedCtx.config["type"].(string)
 
+    // Record how far behind its intended fire time this firing is; the
+    // intended time is only tracked for cron schedules
+    s.mu.RLock()
+    capacity := s.capacity
+    s.mu.RUnlock()
+    if capacity != nil && !schedCtx.nextRun.IsZero() {
+        capacity.ObserveSchedulerDrift(startTime.Sub(schedCtx.nextRun))
+    }
+
+    // Prevent overlapping runs: skip this firing if the previous run for
+    // the schedule is still executing
+    s.mu.Lock()
+    if schedCtx.running && !schedCtx.allowOverlap {
+        s.mu.Unlock()
+        scheduledWorkflowExecutionTotal.WithLabelValues("skipped_overlap", scheduleType).Inc()
+        return
+    }
+
+    // Enforce the per-schedule and global concurrency caps. Workflows at
+    // capacity queue for the next free slot so one noisy schedule cannot
+    // starve the others
+    if (schedCtx.maxConcurrent > 0 && schedCtx.activeRuns >= schedCtx.maxConcurrent) ||
+        (s.maxConcurrent > 0 && s.activeRuns >= s.maxConcurrent) {
+        s.waiting = append(s.waiting, workflowID)
+        s.mu.Unlock()
+        scheduledWorkflowExecutionTotal.WithLabelValues("deferred", scheduleType).Inc()
+        return
+    }
+
+    schedCtx.running = true
+    schedCtx.activeRuns++
+    s.activeRuns++
+    s.mu.Unlock()
+
+    defer func() {
+        s.mu.Lock()
+        schedCtx.running = false
+        schedCtx.activeRuns--
+        s.activeRuns--
+
+        // Hand the freed slot to the longest-waiting workflow; deferred
+        // firings were appended in arrival order, so this is round-robin
+        // fair across schedules
+        var next uuid.UUID
+        if len(s.waiting) > 0 {
+            next = s.waiting[0]
+            s.waiting = s.waiting[1:]
+        }
+        s.mu.Unlock()
+
+        if next != uuid.Nil {
+            go s.executeScheduledWorkflow(next)
+        }
+    }()
+
     // Create execution context
     ctx, cancel := context.WithCancel(s.ctx)
     schedCtx.cancel = cancel
 
+    // Link back to the scheduling span so traces stitch across the async
+    // boundary between scheduling and execution. Fall back to the persisted
+    // trace context when the original span is no longer held in memory
+    var span opentracing.Span
+    if schedCtx.span != nil {
+        span = startFollowingSpan(nil, "ExecuteScheduledWorkflow", schedCtx.span)
+    } else {
+        ctx, span = ContextWithRestoredSpan(ctx, "ExecuteScheduledWorkflow", schedCtx.traceContext)
+    }
+    defer span.Finish()
+    applyExecutionTags(span, ExecutionSpanTags{
+        WorkflowID: workflowID,
+        Version:    schedCtx.workflow.Version,
+    })
+    span.SetTag("schedule_type", scheduleType)
+    ctx = opentracing.ContextWithSpan(ctx, span)
+
     // Execute workflow with tracing and metrics
     err := s.executor.ExecuteWorkflow(ctx, schedCtx.workflow)
     duration := time.Since(startTime).Seconds()
@@ -275,6 +604,12 @@ func (s *Scheduler) executeScheduledWorkflow(workflowID uuid.UUID) {
 
     scheduledWorkflowLatency.WithLabelValues(scheduleType).Observe(duration)
     schedCtx.lastRun = startTime
+
+    // Persist the last-run time so the catch-up scan after a restart
+    // works from real history
+    s.persistState("last_run", func(ctx context.Context) error {
+        return s.stateStoreSnapshot().SaveLastRun(ctx, workflowID, startTime)
+    })
 }
 
 // handleIntervalSchedule manages interval-based scheduling
@@ -360,6 +695,9 @@ func (s *Scheduler) validateScheduleConfig(config map[string]interface{}) error
         if _, err := cron.ParseStandard(cronExpr); err != nil {
             return fmt.Errorf("%w: invalid cron expression: %v", ErrInvalidSchedule, err)
         }
+        if _, err := catchUpPolicyFromConfig(config); err != nil {
+            return err
+        }
 
     case "interval":
         interval, ok := config["interval"].(float64)
@@ -370,6 +708,11 @@ func (s *Scheduler) validateScheduleConfig(config map[string]interface{}) error
             return fmt.Errorf("%w: interval must be greater than 0", ErrInvalidSchedule)
         }
 
+    case "calendar":
+        if _, _, err := calendarConfigFromSchedule(config, s.cronScheduler.Location(), s.calendars); err != nil {
+            return err
+        }
+
     default:
         return fmt.Errorf("%w: unsupported schedule type: %s", ErrInvalidSchedule, scheduleType)
     }
@@ -0,0 +1,138 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "time"
+
+    "github.com/google/uuid"                         // v1.3.0
+    "github.com/opentracing/opentracing-go"          // v1.2.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// persistTimeout bounds each schedule state write so a slow store never
+// blocks the scheduling path
+const persistTimeout = 5 * time.Second
+
+// PersistedSchedule is a schedule as stored by a ScheduleStateStore
+type PersistedSchedule struct {
+    WorkflowID uuid.UUID
+    Config     map[string]interface{}
+    Paused     bool
+    LastRun    time.Time
+}
+
+// ScheduleStateStore persists schedule configurations and last-run times
+// so schedules survive restarts and the catch-up scan works from real
+// history instead of in-memory state lost with the process
+type ScheduleStateStore interface {
+    SaveSchedule(ctx context.Context, workflowID uuid.UUID, config map[string]interface{}, paused bool) error
+    DeleteSchedule(ctx context.Context, workflowID uuid.UUID) error
+    SaveLastRun(ctx context.Context, workflowID uuid.UUID, lastRun time.Time) error
+    LoadSchedules(ctx context.Context) ([]PersistedSchedule, error)
+}
+
+// Metrics collectors
+var scheduleStatePersistTotal = prometheus.NewCounterVec(
+    prometheus.CounterOpts{
+        Name: "schedule_state_persist_total",
+        Help: "Total number of schedule state persistence operations by outcome",
+    },
+    []string{"operation", "status"},
+)
+
+// init registers schedule state metrics
+func init() {
+    registerMetrics(scheduleStatePersistTotal)
+}
+
+// SetStateStore attaches the store that persists schedules and their
+// last-run times across restarts
+func (s *Scheduler) SetStateStore(store ScheduleStateStore) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.stateStore = store
+}
+
+// stateStoreSnapshot returns the attached state store under lock
+func (s *Scheduler) stateStoreSnapshot() ScheduleStateStore {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    return s.stateStore
+}
+
+// persistState runs a best-effort state store write. Persistence failures
+// are surfaced through metrics rather than failing the scheduling
+// operation: the in-memory schedule stays authoritative for this process
+func (s *Scheduler) persistState(operation string, write func(ctx context.Context) error) {
+    store := s.stateStoreSnapshot()
+    if store == nil {
+        return
+    }
+
+    ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+    defer cancel()
+
+    status := "success"
+    if err := write(ctx); err != nil {
+        status = "failed"
+    }
+    scheduleStatePersistTotal.WithLabelValues(operation, status).Inc()
+}
+
+// RestoreSchedules reinstalls persisted schedules, using the loader to
+// resolve each workflow definition. It must run before Start so the
+// restored last-run times feed the catch-up scan. Schedules whose
+// workflow no longer loads are skipped, not fatal: one deleted workflow
+// must not block startup. The number of restored schedules is returned
+func (s *Scheduler) RestoreSchedules(ctx context.Context, load func(ctx context.Context, workflowID uuid.UUID) (*models.Workflow, error)) (int, error) {
+    store := s.stateStoreSnapshot()
+    if store == nil {
+        return 0, nil
+    }
+
+    persisted, err := store.LoadSchedules(ctx)
+    if err != nil {
+        return 0, err
+    }
+
+    restored := 0
+    for _, entry := range persisted {
+        workflow, err := load(ctx, entry.WorkflowID)
+        if err != nil {
+            scheduleStatePersistTotal.WithLabelValues("restore", "skipped").Inc()
+            continue
+        }
+
+        span := opentracing.StartSpan("RestoreSchedule")
+        span.SetTag("workflow_id", entry.WorkflowID)
+
+        s.mu.Lock()
+        if _, exists := s.activeSchedules[entry.WorkflowID]; exists {
+            s.mu.Unlock()
+            span.Finish()
+            continue
+        }
+        if err := s.installScheduleLocked(span, workflow, entry.Config); err != nil {
+            s.mu.Unlock()
+            span.Finish()
+            scheduleStatePersistTotal.WithLabelValues("restore", "failed").Inc()
+            continue
+        }
+
+        // Carry the persisted runtime state into the fresh context so
+        // pause survives restarts and catch-up sees the true last run
+        schedCtx := s.activeSchedules[entry.WorkflowID]
+        schedCtx.paused = entry.Paused
+        schedCtx.lastRun = entry.LastRun
+        s.mu.Unlock()
+        span.Finish()
+
+        scheduleStatePersistTotal.WithLabelValues("restore", "success").Inc()
+        restored++
+    }
+
+    return restored, nil
+}
@@ -0,0 +1,269 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/google/uuid"                         // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+    "github.com/opentracing/opentracing-go"          // v1.2.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common SLA errors
+var (
+    ErrSLANotDefined  = errors.New("no SLA defined for workflow")
+    ErrInvalidSLA     = errors.New("invalid SLA definition")
+)
+
+// SLA breach type constants
+const (
+    SLABreachDuration    = "duration"
+    SLABreachSuccessRate = "success_rate"
+
+    // defaultSLAWindow is the rolling window over which success rate is
+    // evaluated
+    defaultSLAWindow = 100
+)
+
+// Metrics collectors
+var (
+    slaBreachTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_sla_breach_total",
+            Help: "Total number of workflow SLA breaches by type",
+        },
+        []string{"breach_type"},
+    )
+
+    slaEvaluationTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_sla_evaluation_total",
+            Help: "Total number of SLA evaluations by result",
+        },
+        []string{"result"},
+    )
+)
+
+// SLADefinition describes the service level expected of a workflow
+type SLADefinition struct {
+    // MaxDuration is the longest acceptable execution duration
+    MaxDuration time.Duration `json:"max_duration"`
+    // MinSuccessRate is the minimum acceptable success rate over the rolling
+    // evaluation window (0-1)
+    MinSuccessRate float64 `json:"min_success_rate"`
+    // WindowSize is the number of recent executions evaluated for the
+    // success rate target
+    WindowSize int `json:"window_size"`
+}
+
+// SLABreach describes a detected SLA violation
+type SLABreach struct {
+    WorkflowID uuid.UUID     `json:"workflow_id"`
+    BreachType string        `json:"breach_type"`
+    Observed   float64       `json:"observed"`
+    Threshold  float64       `json:"threshold"`
+    DetectedAt time.Time     `json:"detected_at"`
+}
+
+// AlertSink receives SLA breach notifications
+type AlertSink interface {
+    Notify(ctx context.Context, breach SLABreach) error
+}
+
+// AlertSinkFunc adapts a plain function to the AlertSink interface
+type AlertSinkFunc func(ctx context.Context, breach SLABreach) error
+
+// Notify implements AlertSink
+func (f AlertSinkFunc) Notify(ctx context.Context, breach SLABreach) error {
+    return f(ctx, breach)
+}
+
+// slaState tracks SLA evaluation state for a workflow
+type slaState struct {
+    definition SLADefinition
+    results    []bool // rolling window of recent execution outcomes
+}
+
+// SLAMonitor evaluates workflow executions against per-workflow SLA
+// definitions and emits breach alerts
+type SLAMonitor struct {
+    mu    sync.RWMutex
+    slas  map[uuid.UUID]*slaState
+    sinks []AlertSink
+}
+
+// NewSLAMonitor creates a new SLA monitor instance
+func NewSLAMonitor(sinks ...AlertSink) *SLAMonitor {
+    // Register metrics
+    registerMetrics(slaBreachTotal, slaEvaluationTotal)
+
+    return &SLAMonitor{
+        slas:  make(map[uuid.UUID]*slaState),
+        sinks: sinks,
+    }
+}
+
+// SetSLA defines or replaces the SLA for a workflow
+func (m *SLAMonitor) SetSLA(workflowID uuid.UUID, definition SLADefinition) error {
+    if definition.MaxDuration <= 0 && definition.MinSuccessRate <= 0 {
+        return fmt.Errorf("%w: at least one target is required", ErrInvalidSLA)
+    }
+    if definition.MinSuccessRate < 0 || definition.MinSuccessRate > 1 {
+        return fmt.Errorf("%w: success rate must be between 0 and 1", ErrInvalidSLA)
+    }
+    if definition.WindowSize <= 0 {
+        definition.WindowSize = defaultSLAWindow
+    }
+
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    m.slas[workflowID] = &slaState{
+        definition: definition,
+        results:    make([]bool, 0, definition.WindowSize),
+    }
+
+    return nil
+}
+
+// RemoveSLA deletes the SLA definition for a workflow
+func (m *SLAMonitor) RemoveSLA(workflowID uuid.UUID) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    delete(m.slas, workflowID)
+}
+
+// GetSLA returns the SLA definition for a workflow
+func (m *SLAMonitor) GetSLA(workflowID uuid.UUID) (SLADefinition, error) {
+    m.mu.RLock()
+    defer m.mu.RUnlock()
+
+    state, exists := m.slas[workflowID]
+    if !exists {
+        return SLADefinition{}, ErrSLANotDefined
+    }
+
+    return state.definition, nil
+}
+
+// RecordExecution evaluates a completed execution against the workflow's SLA
+// and notifies alert sinks on breach
+func (m *SLAMonitor) RecordExecution(ctx context.Context, workflowID uuid.UUID, duration time.Duration, execErr error) {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "SLAMonitor.RecordExecution")
+    defer span.Finish()
+
+    m.mu.Lock()
+    state, exists := m.slas[workflowID]
+    if !exists {
+        m.mu.Unlock()
+        return
+    }
+
+    // Update the rolling outcome window
+    state.results = append(state.results, execErr == nil)
+    if len(state.results) > state.definition.WindowSize {
+        state.results = state.results[len(state.results)-state.definition.WindowSize:]
+    }
+
+    definition := state.definition
+    successRate := successRate(state.results)
+    m.mu.Unlock()
+
+    breached := false
+
+    // Evaluate duration target
+    if definition.MaxDuration > 0 && duration > definition.MaxDuration {
+        breached = true
+        m.notify(ctx, SLABreach{
+            WorkflowID: workflowID,
+            BreachType: SLABreachDuration,
+            Observed:   duration.Seconds(),
+            Threshold:  definition.MaxDuration.Seconds(),
+            DetectedAt: time.Now().UTC(),
+        })
+    }
+
+    // Evaluate success rate target once the window is full
+    if definition.MinSuccessRate > 0 && len(state.results) >= definition.WindowSize && successRate < definition.MinSuccessRate {
+        breached = true
+        m.notify(ctx, SLABreach{
+            WorkflowID: workflowID,
+            BreachType: SLABreachSuccessRate,
+            Observed:   successRate,
+            Threshold:  definition.MinSuccessRate,
+            DetectedAt: time.Now().UTC(),
+        })
+    }
+
+    if breached {
+        slaEvaluationTotal.WithLabelValues("breach").Inc()
+    } else {
+        slaEvaluationTotal.WithLabelValues("ok").Inc()
+    }
+}
+
+// The monitor observes executions through the executor's interceptor
+// chain so breach evaluation needs no calls on the execution path itself
+var _ ExecutionInterceptor = (*SLAMonitor)(nil)
+
+// BeforeExecute implements ExecutionInterceptor; SLA evaluation never
+// blocks an execution from starting
+func (m *SLAMonitor) BeforeExecute(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID) error {
+    return nil
+}
+
+// AfterNode implements ExecutionInterceptor; SLAs apply to whole
+// executions, not individual nodes
+func (m *SLAMonitor) AfterNode(ctx context.Context, node *models.Node, output map[string]interface{}, duration time.Duration) {
+}
+
+// OnError implements ExecutionInterceptor, recording workflow-level
+// failures against the success rate target
+func (m *SLAMonitor) OnError(ctx context.Context, workflow *models.Workflow, node *models.Node, err error) {
+    if workflow == nil {
+        return
+    }
+    m.RecordExecution(ctx, workflow.ID, 0, err)
+}
+
+// OnComplete implements ExecutionInterceptor, recording successful
+// executions with their observed duration
+func (m *SLAMonitor) OnComplete(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID, duration time.Duration) {
+    m.RecordExecution(ctx, workflow.ID, duration, nil)
+}
+
+// notify dispatches a breach to all alert sinks
+func (m *SLAMonitor) notify(ctx context.Context, breach SLABreach) {
+    slaBreachTotal.WithLabelValues(breach.BreachType).Inc()
+
+    for _, sink := range m.sinks {
+        if err := sink.Notify(ctx, breach); err != nil {
+            span := opentracing.SpanFromContext(ctx)
+            if span != nil {
+                span.LogKV("warning", "SLA alert delivery failed", "error", err.Error())
+            }
+        }
+    }
+}
+
+// successRate computes the success ratio of a result window
+func successRate(results []bool) float64 {
+    if len(results) == 0 {
+        return 1
+    }
+
+    successes := 0
+    for _, ok := range results {
+        if ok {
+            successes++
+        }
+    }
+
+    return float64(successes) / float64(len(results))
+}
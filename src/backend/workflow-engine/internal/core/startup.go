@@ -0,0 +1,56 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "sync"
+)
+
+// Startup steps gating the Kubernetes startup probe. Traffic must not
+// reach the engine until every step has completed
+const (
+    StartupStepDatabase       = "database"
+    StartupStepScheduleReload = "schedule_reload"
+    StartupStepGRPCClients    = "grpc_clients"
+)
+
+// StartupGate tracks which initialization steps have completed so the
+// startup probe only reports ready once the engine is fully warmed up
+type StartupGate struct {
+    mu      sync.RWMutex
+    pending map[string]bool
+}
+
+// NewStartupGate creates a gate that stays not-ready until every listed
+// step is marked complete
+func NewStartupGate(steps ...string) *StartupGate {
+    pending := make(map[string]bool, len(steps))
+    for _, step := range steps {
+        pending[step] = true
+    }
+    return &StartupGate{pending: pending}
+}
+
+// MarkComplete records that one initialization step has finished.
+// Marking a step that was never registered is a no-op so optional
+// subsystems can report unconditionally
+func (g *StartupGate) MarkComplete(step string) {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    delete(g.pending, step)
+}
+
+// Ready reports whether every registered step has completed, along with
+// the names of the steps still pending
+func (g *StartupGate) Ready() (bool, []string) {
+    g.mu.RLock()
+    defer g.mu.RUnlock()
+
+    if len(g.pending) == 0 {
+        return true, nil
+    }
+    pending := make([]string, 0, len(g.pending))
+    for step := range g.pending {
+        pending = append(pending, step)
+    }
+    return false, pending
+}
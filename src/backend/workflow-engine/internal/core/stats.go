@@ -0,0 +1,162 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "sort"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// sparklineRuns is how many recent runs are kept per workflow for the
+// sparkline and percentile calculations
+const sparklineRuns = 30
+
+// RunSample is one finished run in a workflow's recent-run window
+type RunSample struct {
+    ExecutionID uuid.UUID `json:"execution_id"`
+    Success     bool      `json:"success"`
+    DurationMs  float64   `json:"duration_ms"`
+    FinishedAt  time.Time `json:"finished_at"`
+}
+
+// WorkflowStats is a pre-aggregated view of a workflow's execution
+// history, cheap enough to serve directly to the UI
+type WorkflowStats struct {
+    WorkflowID    uuid.UUID   `json:"workflow_id"`
+    TotalRuns     int64       `json:"total_runs"`
+    Failures      int64       `json:"failures"`
+    SuccessRate   float64     `json:"success_rate"`
+    AvgDurationMs float64     `json:"avg_duration_ms"`
+    P95DurationMs float64     `json:"p95_duration_ms"`
+    LastRunAt     time.Time   `json:"last_run_at"`
+    Sparkline     []RunSample `json:"sparkline"`
+}
+
+// workflowRollup accumulates one workflow's stats. The percentile is
+// computed over the recent-run window rather than full history, which is
+// the window the UI displays anyway
+type workflowRollup struct {
+    total       int64
+    failures    int64
+    durationSum float64
+    lastRunAt   time.Time
+    recent      []RunSample
+}
+
+// ExecutionStatsTracker maintains per-workflow execution rollups. It
+// implements ExecutionInterceptor so the executor updates it inline,
+// avoiding history scans when the UI asks for stats
+type ExecutionStatsTracker struct {
+    mu      sync.RWMutex
+    rollups map[uuid.UUID]*workflowRollup
+}
+
+// NewExecutionStatsTracker creates an empty stats tracker
+func NewExecutionStatsTracker() *ExecutionStatsTracker {
+    return &ExecutionStatsTracker{
+        rollups: make(map[uuid.UUID]*workflowRollup),
+    }
+}
+
+// BeforeExecute implements ExecutionInterceptor
+func (t *ExecutionStatsTracker) BeforeExecute(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID) error {
+    return nil
+}
+
+// AfterNode implements ExecutionInterceptor
+func (t *ExecutionStatsTracker) AfterNode(ctx context.Context, node *models.Node, output map[string]interface{}, duration time.Duration) {
+}
+
+// OnError records a failed run
+func (t *ExecutionStatsTracker) OnError(ctx context.Context, workflow *models.Workflow, node *models.Node, err error) {
+    // Node-level errors arrive without the workflow; the workflow-level
+    // callback that follows carries it
+    if workflow == nil {
+        return
+    }
+    t.record(workflow.ID, uuid.Nil, false, 0)
+}
+
+// OnComplete records a successful run with its duration
+func (t *ExecutionStatsTracker) OnComplete(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID, duration time.Duration) {
+    t.record(workflow.ID, executionID, true, duration)
+}
+
+// record folds one finished run into the workflow's rollup
+func (t *ExecutionStatsTracker) record(workflowID, executionID uuid.UUID, success bool, duration time.Duration) {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+
+    rollup, exists := t.rollups[workflowID]
+    if !exists {
+        rollup = &workflowRollup{}
+        t.rollups[workflowID] = rollup
+    }
+
+    rollup.total++
+    if !success {
+        rollup.failures++
+    }
+    rollup.durationSum += duration.Seconds() * 1000
+    rollup.lastRunAt = time.Now().UTC()
+
+    rollup.recent = append(rollup.recent, RunSample{
+        ExecutionID: executionID,
+        Success:     success,
+        DurationMs:  duration.Seconds() * 1000,
+        FinishedAt:  rollup.lastRunAt,
+    })
+    if len(rollup.recent) > sparklineRuns {
+        rollup.recent = rollup.recent[len(rollup.recent)-sparklineRuns:]
+    }
+}
+
+// Stats returns the pre-aggregated stats for a workflow. The second
+// return value is false when the workflow has no recorded runs
+func (t *ExecutionStatsTracker) Stats(workflowID uuid.UUID) (WorkflowStats, bool) {
+    t.mu.RLock()
+    defer t.mu.RUnlock()
+
+    rollup, exists := t.rollups[workflowID]
+    if !exists {
+        return WorkflowStats{}, false
+    }
+
+    stats := WorkflowStats{
+        WorkflowID: workflowID,
+        TotalRuns:  rollup.total,
+        Failures:   rollup.failures,
+        LastRunAt:  rollup.lastRunAt,
+        Sparkline:  make([]RunSample, len(rollup.recent)),
+    }
+    copy(stats.Sparkline, rollup.recent)
+
+    if rollup.total > 0 {
+        stats.SuccessRate = float64(rollup.total-rollup.failures) / float64(rollup.total)
+        stats.AvgDurationMs = rollup.durationSum / float64(rollup.total)
+    }
+    stats.P95DurationMs = recentPercentile(rollup.recent, 0.95)
+
+    return stats, true
+}
+
+// recentPercentile computes a percentile over the recent-run window
+func recentPercentile(samples []RunSample, p float64) float64 {
+    if len(samples) == 0 {
+        return 0
+    }
+
+    durations := make([]float64, len(samples))
+    for i, sample := range samples {
+        durations[i] = sample.DurationMs
+    }
+    sort.Float64s(durations)
+
+    index := int(float64(len(durations)-1) * p)
+    return durations[index]
+}
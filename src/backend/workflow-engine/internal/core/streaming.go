@@ -0,0 +1,146 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "strings"
+    "sync"
+)
+
+// Common streaming errors
+var (
+    ErrStreamClosed = errors.New("chunk stream is closed")
+)
+
+// defaultStreamBuffer bounds how many chunks a producer may run ahead
+// of the slowest consumer before Publish blocks, which is what applies
+// backpressure to the upstream gRPC stream
+const defaultStreamBuffer = 16
+
+// StreamChunk is one increment of a streamed node output
+type StreamChunk struct {
+    Seq   int    `json:"seq"`
+    Delta string `json:"delta"`
+}
+
+// ChunkStream carries partial node output to downstream nodes as it is
+// produced. Streaming-aware consumers range over Chunks; everything
+// else calls Assemble, which blocks until the stream completes and
+// returns the concatenated output
+type ChunkStream struct {
+    mu        sync.Mutex
+    chunks    chan StreamChunk
+    assembled strings.Builder
+    seq       int
+    err       error
+    closed    bool
+    done      chan struct{}
+}
+
+// NewChunkStream creates a stream with the given buffer; zero or
+// negative uses the default
+func NewChunkStream(buffer int) *ChunkStream {
+    if buffer <= 0 {
+        buffer = defaultStreamBuffer
+    }
+    return &ChunkStream{
+        chunks: make(chan StreamChunk, buffer),
+        done:   make(chan struct{}),
+    }
+}
+
+// Publish appends one delta to the stream, blocking when the buffer is
+// full so a slow consumer backpressures the producer
+func (s *ChunkStream) Publish(ctx context.Context, delta string) error {
+    s.mu.Lock()
+    if s.closed {
+        s.mu.Unlock()
+        return ErrStreamClosed
+    }
+    s.seq++
+    chunk := StreamChunk{Seq: s.seq, Delta: delta}
+    s.assembled.WriteString(delta)
+    s.mu.Unlock()
+
+    select {
+    case s.chunks <- chunk:
+        return nil
+    case <-ctx.Done():
+        return ctx.Err()
+    }
+}
+
+// Close finishes the stream, recording a terminal error if the producer
+// failed partway. Closing twice is a no-op
+func (s *ChunkStream) Close(err error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if s.closed {
+        return
+    }
+    s.closed = true
+    s.err = err
+    close(s.chunks)
+    close(s.done)
+}
+
+// Chunks exposes the stream for consumers that process partial output;
+// the channel closes when the producer finishes
+func (s *ChunkStream) Chunks() <-chan StreamChunk {
+    return s.chunks
+}
+
+// Err returns the producer's terminal error once the stream is closed
+func (s *ChunkStream) Err() error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.err
+}
+
+// Assemble blocks until the stream completes and returns the full
+// concatenated output, the final-assembly mode for non-streaming
+// consumers. Chunks still buffered are drained so the producer is never
+// left blocked
+func (s *ChunkStream) Assemble(ctx context.Context) (string, error) {
+    for {
+        select {
+        case _, open := <-s.chunks:
+            if open {
+                continue
+            }
+        case <-s.done:
+        case <-ctx.Done():
+            return "", ctx.Err()
+        }
+
+        s.mu.Lock()
+        defer s.mu.Unlock()
+        return s.assembled.String(), s.err
+    }
+}
+
+// StreamingNodeExecutor marks a node executor that consumes chunk
+// streams directly; executors without it receive assembled output
+type StreamingNodeExecutor interface {
+    NodeExecutor
+    SupportsStreaming() bool
+}
+
+// resolveStreamInputs replaces ChunkStream values in the input with
+// their assembled text for executors that do not consume streams,
+// returning the first producer error encountered
+func resolveStreamInputs(ctx context.Context, input map[string]interface{}) error {
+    for key, value := range input {
+        stream, ok := value.(*ChunkStream)
+        if !ok {
+            continue
+        }
+        assembled, err := stream.Assemble(ctx)
+        if err != nil {
+            return err
+        }
+        input[key] = assembled
+    }
+    return nil
+}
@@ -0,0 +1,70 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "github.com/google/uuid" // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+    aipb "github.com/organization/workflow-engine/api/proto/ai/v1"
+)
+
+// Metrics collectors
+var (
+    aiTokensTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_ai_tokens_total",
+            Help: "Total AI tokens consumed by model, workflow, and kind",
+        },
+        []string{"model", "workflow_id", "kind"},
+    )
+
+    aiTokenCostTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_ai_token_cost_usd_total",
+            Help: "Estimated AI spend in USD by model and workflow",
+        },
+        []string{"model", "workflow_id"},
+    )
+)
+
+// init registers token accounting metrics
+func init() {
+    registerMetrics(aiTokensTotal, aiTokenCostTotal)
+}
+
+// RecordTokenUsage captures one AI response's token counts against the
+// execution's usage record and the per-model counters. costPer1K prices
+// the model in USD per thousand total tokens; zero records tokens
+// without cost attribution
+func (e *Executor) RecordTokenUsage(workflowID uuid.UUID, model string, usage *aipb.TokenUsage, costPer1K float64) {
+    if usage == nil {
+        return
+    }
+
+    cost := costPer1K * float64(usage.TotalTokens) / 1000
+
+    workflow := workflowID.String()
+    aiTokensTotal.WithLabelValues(model, workflow, "prompt").Add(float64(usage.PromptTokens))
+    aiTokensTotal.WithLabelValues(model, workflow, "completion").Add(float64(usage.CompletionTokens))
+    if cost > 0 {
+        aiTokenCostTotal.WithLabelValues(model, workflow).Add(cost)
+    }
+
+    e.mu.RLock()
+    execCtx, active := e.activeExecutions[workflowID]
+    e.mu.RUnlock()
+    if !active {
+        return
+    }
+
+    execCtx.mu.Lock()
+    if execCtx.tokenUsage == nil {
+        execCtx.tokenUsage = &models.TokenUsage{}
+    }
+    execCtx.tokenUsage.PromptTokens += usage.PromptTokens
+    execCtx.tokenUsage.CompletionTokens += usage.CompletionTokens
+    execCtx.tokenUsage.TotalTokens += usage.PromptTokens + usage.CompletionTokens
+    execCtx.tokenUsage.CostUSD += cost
+    execCtx.mu.Unlock()
+}
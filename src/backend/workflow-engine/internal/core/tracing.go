@@ -0,0 +1,53 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "github.com/google/uuid"         // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+)
+
+// ExecutionSpanTags carries the execution context attached to every span
+// so traces can be filtered by workflow, execution, node, and tenant
+type ExecutionSpanTags struct {
+    WorkflowID  uuid.UUID
+    ExecutionID uuid.UUID
+    NodeID      uuid.UUID
+    Tenant      string
+    Version     int
+}
+
+// applyExecutionTags tags a span with the non-empty execution context fields
+func applyExecutionTags(span opentracing.Span, tags ExecutionSpanTags) {
+    if span == nil {
+        return
+    }
+
+    if tags.WorkflowID != uuid.Nil {
+        span.SetTag("workflow_id", tags.WorkflowID.String())
+    }
+    if tags.ExecutionID != uuid.Nil {
+        span.SetTag("execution_id", tags.ExecutionID.String())
+    }
+    if tags.NodeID != uuid.Nil {
+        span.SetTag("node_id", tags.NodeID.String())
+    }
+    if tags.Tenant != "" {
+        span.SetTag("tenant", tags.Tenant)
+    }
+    if tags.Version > 0 {
+        span.SetTag("workflow_version", tags.Version)
+    }
+}
+
+// startFollowingSpan starts a span linked with a FollowsFrom reference to
+// the given parent, stitching traces across async boundaries such as
+// scheduler firings
+func startFollowingSpan(tracer opentracing.Tracer, operation string, parent opentracing.Span) opentracing.Span {
+    if tracer == nil {
+        tracer = opentracing.GlobalTracer()
+    }
+    if parent == nil {
+        return tracer.StartSpan(operation)
+    }
+    return tracer.StartSpan(operation, opentracing.FollowsFrom(parent.Context()))
+}
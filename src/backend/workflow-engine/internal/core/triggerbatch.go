@@ -0,0 +1,200 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Trigger batching constants
+const (
+    // defaultTriggerBatchWindow bounds how long events wait for the
+    // batch to fill before an execution is started anyway
+    defaultTriggerBatchWindow = 5 * time.Second
+
+    // defaultTriggerBatchMaxEvents caps how many events are aggregated
+    // into a single execution
+    defaultTriggerBatchMaxEvents = 100
+)
+
+// Metrics collectors
+var (
+    triggerBatchSize = prometheus.NewHistogram(
+        prometheus.HistogramOpts{
+            Name: "workflow_trigger_batch_size",
+            Help: "Number of trigger events aggregated per execution",
+            Buckets: []float64{1, 2, 5, 10, 25, 50, 100, 250},
+        },
+    )
+
+    triggerBatchedEventTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_trigger_batched_event_total",
+            Help: "Total number of trigger events routed through the batching layer",
+        },
+        []string{"mode"},
+    )
+)
+
+// BatchLauncher starts one workflow execution for a flushed batch.
+// Engine.StartWorkflow satisfies this signature
+type BatchLauncher func(ctx context.Context, workflowID uuid.UUID, opts map[string]interface{}) error
+
+// triggerBatch accumulates events for one workflow's open window
+type triggerBatch struct {
+    events []map[string]interface{}
+    timer  *time.Timer
+}
+
+// TriggerBatcher aggregates high-frequency trigger events into a single
+// execution per window or count. The flushed batch is delivered to the
+// execution under the trigger_batch option so the trigger node can emit
+// it as its array output
+type TriggerBatcher struct {
+    mu      sync.Mutex
+    pending map[uuid.UUID]*triggerBatch
+    launch  BatchLauncher
+    dedup   *Deduplicator
+}
+
+// SetDeduplicator enables content-key deduplication of submitted events
+func (b *TriggerBatcher) SetDeduplicator(dedup *Deduplicator) {
+    b.dedup = dedup
+}
+
+// NewTriggerBatcher creates a trigger batcher that starts executions
+// through the provided launcher
+func NewTriggerBatcher(launch BatchLauncher) *TriggerBatcher {
+    registerMetrics(triggerBatchSize, triggerBatchedEventTotal)
+
+    return &TriggerBatcher{
+        pending: make(map[uuid.UUID]*triggerBatch),
+        launch:  launch,
+    }
+}
+
+// triggerBatchConfig reads the aggregation settings from the workflow's
+// trigger node. Batching is off unless the node declares a batch block:
+//
+//	"batch": {"window_seconds": 10, "max_events": 50}
+func triggerBatchConfig(workflow *models.Workflow) (time.Duration, int, bool) {
+    for _, node := range workflow.GetNodes() {
+        if node.Type != models.TriggerNode {
+            continue
+        }
+        batch, ok := node.Config["batch"].(map[string]interface{})
+        if !ok {
+            return 0, 0, false
+        }
+
+        window := defaultTriggerBatchWindow
+        if seconds, ok := batch["window_seconds"].(float64); ok && seconds > 0 {
+            window = time.Duration(seconds * float64(time.Second))
+        }
+        maxEvents := defaultTriggerBatchMaxEvents
+        if count, ok := batch["max_events"].(float64); ok && count > 0 {
+            maxEvents = int(count)
+        }
+        return window, maxEvents, true
+    }
+    return 0, 0, false
+}
+
+// Submit routes one trigger event. Workflows without batch configuration
+// start an execution immediately; batched workflows aggregate events
+// until the window elapses or the count cap is reached
+func (b *TriggerBatcher) Submit(ctx context.Context, workflow *models.Workflow, event map[string]interface{}) error {
+    // Drop events whose dedup key was already seen within the window
+    if b.dedup != nil {
+        skip, err := b.dedup.ShouldSkip(ctx, workflow, event)
+        if err != nil {
+            return err
+        }
+        if skip {
+            triggerBatchedEventTotal.WithLabelValues("deduplicated").Inc()
+            return nil
+        }
+    }
+
+    window, maxEvents, batched := triggerBatchConfig(workflow)
+    if !batched {
+        triggerBatchedEventTotal.WithLabelValues("immediate").Inc()
+        return b.launch(ctx, workflow.ID, map[string]interface{}{
+            "trigger_event": event,
+        })
+    }
+
+    triggerBatchedEventTotal.WithLabelValues("batched").Inc()
+
+    b.mu.Lock()
+    batch, open := b.pending[workflow.ID]
+    if !open {
+        batch = &triggerBatch{}
+        b.pending[workflow.ID] = batch
+
+        // First event opens the window; the timer flushes whatever has
+        // accumulated when it elapses
+        workflowID := workflow.ID
+        batch.timer = time.AfterFunc(window, func() {
+            b.flush(workflowID)
+        })
+    }
+    batch.events = append(batch.events, event)
+
+    if len(batch.events) >= maxEvents {
+        b.mu.Unlock()
+        b.flush(workflow.ID)
+        return nil
+    }
+    b.mu.Unlock()
+    return nil
+}
+
+// flush closes the workflow's open window and starts one execution with
+// the aggregated events
+func (b *TriggerBatcher) flush(workflowID uuid.UUID) {
+    b.mu.Lock()
+    batch, open := b.pending[workflowID]
+    if !open {
+        b.mu.Unlock()
+        return
+    }
+    delete(b.pending, workflowID)
+    b.mu.Unlock()
+
+    if batch.timer != nil {
+        batch.timer.Stop()
+    }
+    if len(batch.events) == 0 {
+        return
+    }
+
+    triggerBatchSize.Observe(float64(len(batch.events)))
+
+    // The batch runs detached from any single submitter's context
+    b.launch(context.Background(), workflowID, map[string]interface{}{
+        "trigger_batch":      batch.events,
+        "trigger_batch_size": len(batch.events),
+    })
+}
+
+// Close flushes all open windows, starting executions for any events
+// still waiting
+func (b *TriggerBatcher) Close() {
+    b.mu.Lock()
+    workflowIDs := make([]uuid.UUID, 0, len(b.pending))
+    for workflowID := range b.pending {
+        workflowIDs = append(workflowIDs, workflowID)
+    }
+    b.mu.Unlock()
+
+    for _, workflowID := range workflowIDs {
+        b.flush(workflowID)
+    }
+}
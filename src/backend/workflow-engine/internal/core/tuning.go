@@ -0,0 +1,131 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "errors"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// Common tuning errors
+var (
+    ErrInvalidTuningValue = errors.New("invalid tuning value")
+)
+
+// Runtime tuning limits
+const (
+    minTunableConcurrency = 1
+    maxTunableConcurrency = 10000
+    minTunableTimeout     = time.Second
+    maxTunableTimeout     = 24 * time.Hour
+)
+
+// Metrics collectors
+var (
+    tuningChangeTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_engine_tuning_changes_total",
+            Help: "Total number of runtime tuning changes by setting",
+        },
+        []string{"setting"},
+    )
+)
+
+// RuntimeSettings holds the tunable engine settings exposed through the
+// admin API
+type RuntimeSettings struct {
+    MaxConcurrentExecutions int           `json:"max_concurrent_executions"`
+    ExecutionTimeout        time.Duration `json:"execution_timeout"`
+    NodeTimeout             time.Duration `json:"node_timeout"`
+    MaxRetries              int           `json:"max_retries"`
+}
+
+// TuningManager allows operators to adjust engine runtime settings without a
+// restart, with validation and change metrics
+type TuningManager struct {
+    mu       sync.RWMutex
+    settings RuntimeSettings
+}
+
+// NewTuningManager creates a tuning manager seeded with the given settings
+func NewTuningManager(initial RuntimeSettings) *TuningManager {
+    if initial.MaxConcurrentExecutions == 0 {
+        initial.MaxConcurrentExecutions = maxConcurrentExecutions
+    }
+    if initial.ExecutionTimeout == 0 {
+        initial.ExecutionTimeout = defaultExecutionTimeout
+    }
+
+    // Register metrics
+    registerMetrics(tuningChangeTotal)
+
+    return &TuningManager{settings: initial}
+}
+
+// Settings returns a copy of the current runtime settings
+func (t *TuningManager) Settings() RuntimeSettings {
+    t.mu.RLock()
+    defer t.mu.RUnlock()
+    return t.settings
+}
+
+// Apply validates and applies a settings update, returning the effective
+// settings. Zero-valued fields are left unchanged.
+func (t *TuningManager) Apply(update RuntimeSettings) (RuntimeSettings, error) {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+
+    if update.MaxConcurrentExecutions != 0 {
+        if update.MaxConcurrentExecutions < minTunableConcurrency || update.MaxConcurrentExecutions > maxTunableConcurrency {
+            return t.settings, fmt.Errorf("%w: max concurrent executions must be between %d and %d",
+                ErrInvalidTuningValue, minTunableConcurrency, maxTunableConcurrency)
+        }
+        t.settings.MaxConcurrentExecutions = update.MaxConcurrentExecutions
+        tuningChangeTotal.WithLabelValues("max_concurrent_executions").Inc()
+    }
+
+    if update.ExecutionTimeout != 0 {
+        if update.ExecutionTimeout < minTunableTimeout || update.ExecutionTimeout > maxTunableTimeout {
+            return t.settings, fmt.Errorf("%w: execution timeout must be between %s and %s",
+                ErrInvalidTuningValue, minTunableTimeout, maxTunableTimeout)
+        }
+        t.settings.ExecutionTimeout = update.ExecutionTimeout
+        tuningChangeTotal.WithLabelValues("execution_timeout").Inc()
+    }
+
+    if update.NodeTimeout != 0 {
+        if update.NodeTimeout < minTunableTimeout || update.NodeTimeout >= t.settings.ExecutionTimeout {
+            return t.settings, fmt.Errorf("%w: node timeout must be positive and less than execution timeout",
+                ErrInvalidTuningValue)
+        }
+        t.settings.NodeTimeout = update.NodeTimeout
+        tuningChangeTotal.WithLabelValues("node_timeout").Inc()
+    }
+
+    if update.MaxRetries != 0 {
+        if update.MaxRetries < 0 || update.MaxRetries > 10 {
+            return t.settings, fmt.Errorf("%w: max retries must be between 0 and 10", ErrInvalidTuningValue)
+        }
+        t.settings.MaxRetries = update.MaxRetries
+        tuningChangeTotal.WithLabelValues("max_retries").Inc()
+    }
+
+    return t.settings, nil
+}
+
+// MaxConcurrentExecutions returns the current concurrency limit
+func (t *TuningManager) MaxConcurrentExecutions() int {
+    t.mu.RLock()
+    defer t.mu.RUnlock()
+    return t.settings.MaxConcurrentExecutions
+}
+
+// ExecutionTimeout returns the current execution timeout
+func (t *TuningManager) ExecutionTimeout() time.Duration {
+    t.mu.RLock()
+    defer t.mu.RUnlock()
+    return t.settings.ExecutionTimeout
+}
@@ -0,0 +1,264 @@
+// Package core provides the core workflow execution engine components
+package core
+
+import (
+    "context"
+    "errors"
+    "fmt"
+
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/models"
+    aipb "github.com/organization/workflow-engine/api/proto/ai/v1"
+    "github.com/organization/workflow-engine/pkg/aiservice"
+)
+
+// Common vector node errors
+var (
+    ErrEmbedderNotConfigured    = errors.New("embedder not configured")
+    ErrVectorStoreNotConfigured = errors.New("vector store not configured")
+)
+
+// Vector node action types dispatched through the action node's
+// action_type config
+const (
+    ActionEmbed        = "embed"
+    ActionVectorUpsert = "vector_upsert"
+    ActionVectorQuery  = "vector_query"
+)
+
+// defaultTopK bounds query results when the node does not configure one
+const defaultTopK = 5
+
+// VectorItem is one entry written to a vector store
+type VectorItem struct {
+    ID       string                 `json:"id"`
+    Vector   []float32              `json:"vector"`
+    Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// VectorMatch is one query result with its similarity score
+type VectorMatch struct {
+    ID       string                 `json:"id"`
+    Score    float64                `json:"score"`
+    Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// VectorStore defines the persistence interface for embeddings;
+// implementations cover pgvector and hosted stores like Pinecone
+type VectorStore interface {
+    Upsert(ctx context.Context, namespace string, items []VectorItem) error
+    Query(ctx context.Context, namespace string, vector []float32, topK int) ([]VectorMatch, error)
+}
+
+// Embedder turns text into vectors
+type Embedder interface {
+    Embed(ctx context.Context, model, text string) ([]float32, error)
+}
+
+// aiEmbedder embeds through the AI service's embedding task type
+type aiEmbedder struct {
+    client *aiservice.Client
+}
+
+// NewAIEmbedder adapts the typed AI service client to the Embedder
+// interface used by vector nodes
+func NewAIEmbedder(client *aiservice.Client) Embedder {
+    return &aiEmbedder{client: client}
+}
+
+func (e *aiEmbedder) Embed(ctx context.Context, model, text string) ([]float32, error) {
+    response, err := e.client.ExecuteTask(ctx, &aipb.TaskRequest{
+        TaskType: aipb.TaskType_TASK_TYPE_EMBEDDING,
+        Model:    model,
+        Prompt:   text,
+    })
+    if err != nil {
+        return nil, fmt.Errorf("embedding task failed: %w", err)
+    }
+    return response.Vector, nil
+}
+
+// VectorNodeExecutor implements the built-in embed, vector_upsert, and
+// vector_query actions so RAG-style workflows compose from existing
+// node primitives
+type VectorNodeExecutor struct {
+    embedder Embedder
+    store    VectorStore
+    charger  ExternalCallCharger
+}
+
+// ExternalCallCharger charges outbound calls against an execution's
+// resource budget; a budget breach fails the node
+type ExternalCallCharger func(workflowID uuid.UUID, count int) error
+
+// NewVectorNodeExecutor creates the executor; either dependency may be
+// nil, disabling the actions that need it
+func NewVectorNodeExecutor(embedder Embedder, store VectorStore) *VectorNodeExecutor {
+    return &VectorNodeExecutor{embedder: embedder, store: store}
+}
+
+// SetExternalCallCharger wires budget accounting for the outbound calls
+// this executor makes to the AI service and the vector store
+func (v *VectorNodeExecutor) SetExternalCallCharger(charger ExternalCallCharger) {
+    v.charger = charger
+}
+
+// chargeCall charges one outbound call before it is made
+func (v *VectorNodeExecutor) chargeCall(node *models.Node) error {
+    if v.charger == nil {
+        return nil
+    }
+    return v.charger(node.WorkflowID, 1)
+}
+
+// Validate checks that the node's action type is supported and its
+// dependencies are configured
+func (v *VectorNodeExecutor) Validate(node *models.Node) error {
+    actionType, _ := node.Config["action_type"].(string)
+    switch actionType {
+    case ActionEmbed:
+        if v.embedder == nil {
+            return ErrEmbedderNotConfigured
+        }
+    case ActionVectorUpsert:
+        if v.store == nil {
+            return ErrVectorStoreNotConfigured
+        }
+    case ActionVectorQuery:
+        if v.store == nil {
+            return ErrVectorStoreNotConfigured
+        }
+    default:
+        return fmt.Errorf("unsupported action type %q", actionType)
+    }
+    return nil
+}
+
+// Execute dispatches on the node's action type
+func (v *VectorNodeExecutor) Execute(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    if err := v.Validate(node); err != nil {
+        return nil, err
+    }
+
+    switch node.Config["action_type"].(string) {
+    case ActionEmbed:
+        return v.embed(ctx, node, input)
+    case ActionVectorUpsert:
+        return v.upsert(ctx, node, input)
+    default:
+        return v.query(ctx, node, input)
+    }
+}
+
+// embed turns the configured input field into a vector
+func (v *VectorNodeExecutor) embed(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    text, err := textInput(node, input)
+    if err != nil {
+        return nil, err
+    }
+
+    if err := v.chargeCall(node); err != nil {
+        return nil, err
+    }
+    model, _ := node.Config["model"].(string)
+    vector, err := v.embedder.Embed(ctx, model, text)
+    if err != nil {
+        return nil, err
+    }
+    return map[string]interface{}{"vector": vector, "text": text}, nil
+}
+
+// upsert writes the incoming vector (embedding the text first when no
+// vector is present) into the configured namespace
+func (v *VectorNodeExecutor) upsert(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    vector, err := v.resolveVector(ctx, node, input)
+    if err != nil {
+        return nil, err
+    }
+
+    id, _ := input["id"].(string)
+    if id == "" {
+        id = node.ID.String()
+    }
+    metadata, _ := input["metadata"].(map[string]interface{})
+
+    if err := v.chargeCall(node); err != nil {
+        return nil, err
+    }
+    namespace, _ := node.Config["namespace"].(string)
+    item := VectorItem{ID: id, Vector: vector, Metadata: metadata}
+    if err := v.store.Upsert(ctx, namespace, []VectorItem{item}); err != nil {
+        return nil, fmt.Errorf("vector upsert failed: %w", err)
+    }
+    return map[string]interface{}{"id": id, "upserted": 1}, nil
+}
+
+// query searches the configured namespace for the nearest vectors
+func (v *VectorNodeExecutor) query(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    vector, err := v.resolveVector(ctx, node, input)
+    if err != nil {
+        return nil, err
+    }
+
+    topK := defaultTopK
+    if k, ok := node.Config["top_k"].(float64); ok && k > 0 {
+        topK = int(k)
+    }
+
+    if err := v.chargeCall(node); err != nil {
+        return nil, err
+    }
+    namespace, _ := node.Config["namespace"].(string)
+    matches, err := v.store.Query(ctx, namespace, vector, topK)
+    if err != nil {
+        return nil, fmt.Errorf("vector query failed: %w", err)
+    }
+    return map[string]interface{}{"matches": matches}, nil
+}
+
+// resolveVector takes the incoming vector when present, otherwise
+// embeds the node's text input
+func (v *VectorNodeExecutor) resolveVector(ctx context.Context, node *models.Node, input map[string]interface{}) ([]float32, error) {
+    if vector, ok := input["vector"].([]float32); ok {
+        return vector, nil
+    }
+    if raw, ok := input["vector"].([]interface{}); ok {
+        vector := make([]float32, 0, len(raw))
+        for _, value := range raw {
+            f, ok := value.(float64)
+            if !ok {
+                return nil, fmt.Errorf("vector contains non-numeric element")
+            }
+            vector = append(vector, float32(f))
+        }
+        return vector, nil
+    }
+
+    if v.embedder == nil {
+        return nil, ErrEmbedderNotConfigured
+    }
+    text, err := textInput(node, input)
+    if err != nil {
+        return nil, err
+    }
+    if err := v.chargeCall(node); err != nil {
+        return nil, err
+    }
+    model, _ := node.Config["model"].(string)
+    return v.embedder.Embed(ctx, model, text)
+}
+
+// textInput reads the node's text input from the configured field,
+// defaulting to "text"
+func textInput(node *models.Node, input map[string]interface{}) (string, error) {
+    field, _ := node.Config["text_field"].(string)
+    if field == "" {
+        field = "text"
+    }
+    text, ok := input[field].(string)
+    if !ok || text == "" {
+        return "", fmt.Errorf("input field %q is missing or not a string", field)
+    }
+    return text, nil
+}
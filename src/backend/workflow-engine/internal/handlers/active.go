@@ -0,0 +1,46 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+)
+
+// GetActiveExecutions handles GET /api/v1/executions/active requests,
+// returning every in-flight execution with the node it is currently
+// running, grouped by workflow, tenant, and node type so operators can
+// see what is consuming the engine's concurrency budget
+func (h *ExecutionHandler) GetActiveExecutions(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "ExecutionHandler.GetActiveExecutions")
+    defer span.Finish()
+
+    executions := h.engine.ActiveExecutionDetails()
+
+    byWorkflow := make(map[string]int)
+    byTenant := make(map[string]int)
+    byNodeType := make(map[string]int)
+    for _, execution := range executions {
+        byWorkflow[execution.WorkflowID.String()]++
+
+        tenant := execution.Tenant
+        if tenant == "" {
+            tenant = "unknown"
+        }
+        byTenant[tenant]++
+
+        for _, node := range execution.CurrentNodes {
+            byNodeType[string(node.NodeType)]++
+        }
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "total":        len(executions),
+        "capacity":     h.engine.MaxConcurrentExecutions(),
+        "executions":   executions,
+        "by_workflow":  byWorkflow,
+        "by_tenant":    byTenant,
+        "by_node_type": byNodeType,
+    })
+}
@@ -0,0 +1,295 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "encoding/base64"
+    "net/http"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/services"
+    "github.com/organization/workflow-engine/pkg/encryption"
+    "github.com/organization/workflow-engine/pkg/logging"
+    "github.com/organization/workflow-engine/pkg/profiling"
+)
+
+// UpdateSettingsRequest represents a runtime tuning payload. Durations are
+// expressed as strings in Go duration syntax (e.g. "5m").
+type UpdateSettingsRequest struct {
+    MaxConcurrentExecutions int    `json:"max_concurrent_executions"`
+    ExecutionTimeout        string `json:"execution_timeout"`
+    NodeTimeout             string `json:"node_timeout"`
+    MaxRetries              int    `json:"max_retries"`
+}
+
+// LogLevelRequest represents a dynamic log level change payload. When
+// Module is empty the global level is changed
+type LogLevelRequest struct {
+    Level  string `json:"level"`
+    Module string `json:"module,omitempty"`
+}
+
+// ProfilingRequest represents a runtime profiling toggle payload
+type ProfilingRequest struct {
+    Enabled bool `json:"enabled"`
+}
+
+// RotateKeysRequest represents a key rotation payload. KEK optionally
+// supplies the new master key as base64; omitted, a random one is used
+type RotateKeysRequest struct {
+    KEK string `json:"kek,omitempty"`
+}
+
+// AdminHandler handles HTTP requests for engine administration operations
+type AdminHandler struct {
+    tuning      *core.TuningManager
+    logging     *logging.Logger
+    profiling   *profiling.Profiler
+    keys        *encryption.KeyManager
+    compliance  *config.ComplianceReport
+    support     *services.SupportAccessManager
+    maintenance *core.MaintenanceManager
+}
+
+// NewAdminHandler creates a new admin handler instance
+func NewAdminHandler(tuning *core.TuningManager) *AdminHandler {
+    return &AdminHandler{
+        tuning: tuning,
+    }
+}
+
+// SetLogging enables dynamic log level control through this handler
+func (h *AdminHandler) SetLogging(logger *logging.Logger) {
+    h.logging = logger
+}
+
+// SetProfiler enables runtime profiling control through this handler
+func (h *AdminHandler) SetProfiler(profiler *profiling.Profiler) {
+    h.profiling = profiler
+}
+
+// SetKeyManager enables encryption key rotation through this handler
+func (h *AdminHandler) SetKeyManager(keys *encryption.KeyManager) {
+    h.keys = keys
+}
+
+// SetComplianceReport serves the startup compliance report through this
+// handler
+func (h *AdminHandler) SetComplianceReport(report config.ComplianceReport) {
+    h.compliance = &report
+}
+
+// GetCompliance handles GET /api/v1/admin/compliance requests, returning
+// the compliance posture verified when the configuration was loaded
+func (h *AdminHandler) GetCompliance(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.GetCompliance")
+    defer span.Finish()
+
+    if h.compliance == nil {
+        return fiber.NewError(http.StatusNotFound, "compliance reporting not configured")
+    }
+
+    return c.Status(http.StatusOK).JSON(h.compliance)
+}
+
+// RotateKeys handles POST /api/v1/admin/keys/rotate requests, installing
+// a new master KEK and re-wrapping every tenant DEK under it. Tenant
+// DEKs themselves are unchanged, so existing ciphertext stays readable
+// and rotation needs no downtime
+func (h *AdminHandler) RotateKeys(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.RotateKeys")
+    defer span.Finish()
+
+    if h.keys == nil {
+        return fiber.NewError(http.StatusNotFound, "encryption keys not configured")
+    }
+
+    var req RotateKeysRequest
+    if len(c.Body()) > 0 {
+        if err := c.BodyParser(&req); err != nil {
+            ext.Error.Set(span, true)
+            span.SetTag("error", err.Error())
+            return fiber.NewError(http.StatusBadRequest, "invalid request payload")
+        }
+    }
+
+    var newKEK []byte
+    if req.KEK != "" {
+        decoded, err := base64.StdEncoding.DecodeString(req.KEK)
+        if err != nil {
+            return fiber.NewError(http.StatusBadRequest, "KEK must be base64-encoded")
+        }
+        newKEK = decoded
+    }
+
+    version, rewrapped, err := h.keys.RotateKEK(newKEK)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusBadRequest, err.Error())
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "kek_version":    version,
+        "rewrapped_deks": rewrapped,
+    })
+}
+
+// GetSettings handles GET /api/v1/admin/engine/settings requests
+func (h *AdminHandler) GetSettings(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.GetSettings")
+    defer span.Finish()
+
+    settings := h.tuning.Settings()
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "max_concurrent_executions": settings.MaxConcurrentExecutions,
+        "execution_timeout":         settings.ExecutionTimeout.String(),
+        "node_timeout":              settings.NodeTimeout.String(),
+        "max_retries":               settings.MaxRetries,
+    })
+}
+
+// UpdateSettings handles PUT /api/v1/admin/engine/settings requests, applying
+// validated runtime tuning changes without a restart
+func (h *AdminHandler) UpdateSettings(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.UpdateSettings")
+    defer span.Finish()
+
+    var req UpdateSettingsRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusBadRequest, "invalid request payload")
+    }
+
+    update := core.RuntimeSettings{
+        MaxConcurrentExecutions: req.MaxConcurrentExecutions,
+        MaxRetries:              req.MaxRetries,
+    }
+
+    if req.ExecutionTimeout != "" {
+        timeout, err := time.ParseDuration(req.ExecutionTimeout)
+        if err != nil {
+            return fiber.NewError(http.StatusBadRequest, "invalid execution timeout")
+        }
+        update.ExecutionTimeout = timeout
+    }
+
+    if req.NodeTimeout != "" {
+        timeout, err := time.ParseDuration(req.NodeTimeout)
+        if err != nil {
+            return fiber.NewError(http.StatusBadRequest, "invalid node timeout")
+        }
+        update.NodeTimeout = timeout
+    }
+
+    effective, err := h.tuning.Apply(update)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusBadRequest, err.Error())
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "max_concurrent_executions": effective.MaxConcurrentExecutions,
+        "execution_timeout":         effective.ExecutionTimeout.String(),
+        "node_timeout":              effective.NodeTimeout.String(),
+        "max_retries":               effective.MaxRetries,
+    })
+}
+
+// GetProfiling handles GET /api/v1/admin/profiling requests
+func (h *AdminHandler) GetProfiling(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.GetProfiling")
+    defer span.Finish()
+
+    if h.profiling == nil {
+        return fiber.NewError(http.StatusNotFound, "continuous profiling not configured")
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "enabled": h.profiling.Enabled(),
+    })
+}
+
+// UpdateProfiling handles PUT /api/v1/admin/profiling requests, toggling
+// continuous profile capture without a restart
+func (h *AdminHandler) UpdateProfiling(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.UpdateProfiling")
+    defer span.Finish()
+
+    if h.profiling == nil {
+        return fiber.NewError(http.StatusNotFound, "continuous profiling not configured")
+    }
+
+    var req ProfilingRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusBadRequest, "invalid request payload")
+    }
+
+    if req.Enabled {
+        h.profiling.Enable()
+    } else {
+        h.profiling.Disable()
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "enabled": h.profiling.Enabled(),
+    })
+}
+
+// GetLogLevel handles GET /api/v1/admin/logging requests
+func (h *AdminHandler) GetLogLevel(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.GetLogLevel")
+    defer span.Finish()
+
+    if h.logging == nil {
+        return fiber.NewError(http.StatusNotFound, "dynamic logging not enabled")
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "level":   h.logging.Level(),
+        "modules": h.logging.ModuleLevels(),
+    })
+}
+
+// UpdateLogLevel handles PUT /api/v1/admin/logging requests, changing the
+// global or a module's log level without a restart
+func (h *AdminHandler) UpdateLogLevel(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.UpdateLogLevel")
+    defer span.Finish()
+
+    if h.logging == nil {
+        return fiber.NewError(http.StatusNotFound, "dynamic logging not enabled")
+    }
+
+    var req LogLevelRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusBadRequest, "invalid request payload")
+    }
+
+    var err error
+    if req.Module != "" {
+        err = h.logging.SetModuleLevel(req.Module, req.Level)
+    } else {
+        err = h.logging.SetLevel(req.Level)
+    }
+    if err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusBadRequest, err.Error())
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "level":   h.logging.Level(),
+        "modules": h.logging.ModuleLevels(),
+    })
+}
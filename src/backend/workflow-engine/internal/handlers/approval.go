@@ -0,0 +1,125 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "fmt"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+)
+
+// SubmitReviewRequest represents a submit-for-review payload
+type SubmitReviewRequest struct {
+    Approvers []uuid.UUID `json:"approvers" validate:"required,min=1"`
+}
+
+// ApprovalDecisionRequest represents an approve/reject payload
+type ApprovalDecisionRequest struct {
+    Reason string `json:"reason,omitempty"`
+}
+
+// SubmitForReview handles POST /api/v1/workflows/:id/review requests,
+// creating a pending approval request for the current workflow version
+func (h *WorkflowHandler) SubmitForReview(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.SubmitForReview")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    var req SubmitReviewRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    request, err := h.service.SubmitForReview(ctx, userID, workflowID, req.Approvers)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to submit for review: %w", err)
+    }
+
+    return c.Status(http.StatusCreated).JSON(request)
+}
+
+// ApproveWorkflow handles POST /api/v1/approvals/:id/approve requests
+func (h *WorkflowHandler) ApproveWorkflow(c *fiber.Ctx) error {
+    return h.decideApproval(c, true)
+}
+
+// RejectWorkflow handles POST /api/v1/approvals/:id/reject requests
+func (h *WorkflowHandler) RejectWorkflow(c *fiber.Ctx) error {
+    return h.decideApproval(c, false)
+}
+
+// decideApproval records an approve or reject decision on a pending request
+func (h *WorkflowHandler) decideApproval(c *fiber.Ctx, approve bool) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.decideApproval")
+    defer span.Finish()
+
+    approverID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    approvalID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid approval ID")
+    }
+    span.SetTag("approval_id", approvalID)
+
+    var req ApprovalDecisionRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    request, err := h.service.DecideApproval(ctx, approverID, approvalID, approve, req.Reason)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to record approval decision: %w", err)
+    }
+
+    return c.Status(http.StatusOK).JSON(request)
+}
+
+// ListApprovals handles GET /api/v1/workflows/:id/approvals requests,
+// returning the approval audit history of a workflow
+func (h *WorkflowHandler) ListApprovals(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.ListApprovals")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+
+    requests, err := h.service.ListApprovals(ctx, userID, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("failed to list approvals: %w", err)
+    }
+
+    return c.Status(http.StatusOK).JSON(requests)
+}
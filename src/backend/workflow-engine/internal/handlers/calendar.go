@@ -0,0 +1,116 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "errors"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// CalendarHandler manages the shared business calendars that calendar
+// schedules reference by ID
+type CalendarHandler struct {
+    registry *core.CalendarRegistry
+}
+
+// NewCalendarHandler creates a new calendar handler instance
+func NewCalendarHandler(registry *core.CalendarRegistry) *CalendarHandler {
+    return &CalendarHandler{registry: registry}
+}
+
+// CalendarRequest is the create/update payload for named calendars
+type CalendarRequest struct {
+    Name     string   `json:"name"`
+    Timezone string   `json:"timezone"`
+    Holidays []string `json:"holidays"`
+}
+
+// ListCalendars handles GET /api/v1/calendars requests
+func (h *CalendarHandler) ListCalendars(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "CalendarHandler.ListCalendars")
+    defer span.Finish()
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{"calendars": h.registry.List()})
+}
+
+// CreateCalendar handles POST /api/v1/calendars requests
+func (h *CalendarHandler) CreateCalendar(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "CalendarHandler.CreateCalendar")
+    defer span.Finish()
+
+    var request CalendarRequest
+    if err := c.BodyParser(&request); err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid request body")
+    }
+
+    calendar, err := h.registry.Create(request.Name, request.Timezone, request.Holidays)
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, err.Error())
+    }
+    return c.Status(http.StatusCreated).JSON(calendar)
+}
+
+// GetCalendar handles GET /api/v1/calendars/:id requests
+func (h *CalendarHandler) GetCalendar(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "CalendarHandler.GetCalendar")
+    defer span.Finish()
+
+    id, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid calendar ID")
+    }
+
+    calendar, err := h.registry.Get(id)
+    if err != nil {
+        return fiber.NewError(http.StatusNotFound, "calendar not found")
+    }
+    return c.Status(http.StatusOK).JSON(calendar)
+}
+
+// UpdateCalendar handles PUT /api/v1/calendars/:id requests. Installed
+// schedules referencing the calendar resolve the new definition on
+// their next firing computation
+func (h *CalendarHandler) UpdateCalendar(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "CalendarHandler.UpdateCalendar")
+    defer span.Finish()
+
+    id, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid calendar ID")
+    }
+
+    var request CalendarRequest
+    if err := c.BodyParser(&request); err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid request body")
+    }
+
+    calendar, err := h.registry.Update(id, request.Name, request.Timezone, request.Holidays)
+    if err != nil {
+        if errors.Is(err, core.ErrCalendarNotFound) {
+            return fiber.NewError(http.StatusNotFound, "calendar not found")
+        }
+        return fiber.NewError(http.StatusBadRequest, err.Error())
+    }
+    return c.Status(http.StatusOK).JSON(calendar)
+}
+
+// DeleteCalendar handles DELETE /api/v1/calendars/:id requests
+func (h *CalendarHandler) DeleteCalendar(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "CalendarHandler.DeleteCalendar")
+    defer span.Finish()
+
+    id, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid calendar ID")
+    }
+
+    if err := h.registry.Delete(id); err != nil {
+        return fiber.NewError(http.StatusNotFound, "calendar not found")
+    }
+    return c.SendStatus(http.StatusNoContent)
+}
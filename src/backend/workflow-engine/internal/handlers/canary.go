@@ -0,0 +1,188 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "errors"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// CanaryRequest represents a canary activation payload. CanaryVersion must
+// reference an already published revision newer than the stable one
+type CanaryRequest struct {
+    CanaryVersion      int     `json:"canary_version"`
+    Percentage         int     `json:"percentage,omitempty"`
+    ErrorRateThreshold float64 `json:"error_rate_threshold,omitempty"`
+    MinSamples         int     `json:"min_samples,omitempty"`
+}
+
+// SetCanaryController attaches the canary controller backing the
+// per-workflow canary activation endpoints
+func (h *WorkflowHandler) SetCanaryController(controller *core.CanaryController) {
+    h.canary = controller
+}
+
+// StartCanary handles POST /api/v1/workflows/:id/canary requests, routing a
+// percentage of execution traffic to the requested published revision while
+// the rest keeps running the previous one
+func (h *WorkflowHandler) StartCanary(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.StartCanary")
+    defer span.Finish()
+
+    if h.canary == nil {
+        return fiber.NewError(http.StatusNotImplemented, "canary activation is not enabled")
+    }
+
+    userID, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    var req CanaryRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusBadRequest, "invalid request body")
+    }
+
+    stable, err := h.resolveCanaryBaseline(c, userID, workflow, req.CanaryVersion)
+    if err != nil {
+        return err
+    }
+
+    config := core.CanaryConfig{
+        Percentage:         req.Percentage,
+        ErrorRateThreshold: req.ErrorRateThreshold,
+        MinSamples:         req.MinSamples,
+    }
+
+    if err := h.canary.StartCanary(ctx, stable, req.CanaryVersion, config); err != nil {
+        if errors.Is(err, core.ErrCanaryAlreadyActive) {
+            return fiber.NewError(http.StatusConflict, err.Error())
+        }
+        if errors.Is(err, core.ErrInvalidCanaryConfig) {
+            return fiber.NewError(http.StatusBadRequest, err.Error())
+        }
+        return err
+    }
+
+    status, err := h.canary.GetCanaryStatus(workflow.ID)
+    if err != nil {
+        return err
+    }
+
+    return c.Status(http.StatusCreated).JSON(status)
+}
+
+// resolveCanaryBaseline validates the requested canary version against the
+// published revision history and returns the stable workflow the remaining
+// traffic keeps running
+func (h *WorkflowHandler) resolveCanaryBaseline(c *fiber.Ctx, userID uuid.UUID, workflow *models.Workflow, canaryVersion int) (*models.Workflow, error) {
+    revisions, err := h.service.ListRevisions(c.Context(), userID, workflow.ID)
+    if err != nil {
+        return nil, err
+    }
+
+    var canaryRevision, stableRevision *models.WorkflowRevision
+    for _, revision := range revisions {
+        if revision.Version == canaryVersion {
+            canaryRevision = revision
+            continue
+        }
+        if revision.Version < canaryVersion && (stableRevision == nil || revision.Version > stableRevision.Version) {
+            stableRevision = revision
+        }
+    }
+
+    if canaryRevision == nil {
+        return nil, fiber.NewError(http.StatusBadRequest, "canary version has no published revision")
+    }
+    if stableRevision == nil {
+        return nil, fiber.NewError(http.StatusConflict, "no earlier published revision to serve as stable")
+    }
+
+    return stableRevision.Restore()
+}
+
+// PromoteCanary handles POST /api/v1/workflows/:id/canary/promote requests,
+// completing the activation so all traffic runs the canary version
+func (h *WorkflowHandler) PromoteCanary(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.PromoteCanary")
+    defer span.Finish()
+
+    if h.canary == nil {
+        return fiber.NewError(http.StatusNotImplemented, "canary activation is not enabled")
+    }
+
+    _, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    version, err := h.canary.PromoteCanary(workflow.ID)
+    if err != nil {
+        if errors.Is(err, core.ErrCanaryNotActive) {
+            return fiber.NewError(http.StatusNotFound, err.Error())
+        }
+        return err
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{"active_version": version})
+}
+
+// RollbackCanary handles POST /api/v1/workflows/:id/canary/rollback requests,
+// pinning all traffic back to the stable version
+func (h *WorkflowHandler) RollbackCanary(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.RollbackCanary")
+    defer span.Finish()
+
+    if h.canary == nil {
+        return fiber.NewError(http.StatusNotImplemented, "canary activation is not enabled")
+    }
+
+    _, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    version, err := h.canary.RollbackCanary(workflow.ID)
+    if err != nil {
+        if errors.Is(err, core.ErrCanaryNotActive) {
+            return fiber.NewError(http.StatusNotFound, err.Error())
+        }
+        return err
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{"active_version": version})
+}
+
+// GetCanaryStatus handles GET /api/v1/workflows/:id/canary requests
+func (h *WorkflowHandler) GetCanaryStatus(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.GetCanaryStatus")
+    defer span.Finish()
+
+    if h.canary == nil {
+        return fiber.NewError(http.StatusNotImplemented, "canary activation is not enabled")
+    }
+
+    _, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    status, err := h.canary.GetCanaryStatus(workflow.ID)
+    if err != nil {
+        if errors.Is(err, core.ErrCanaryNotActive) {
+            return fiber.NewError(http.StatusNotFound, err.Error())
+        }
+        return err
+    }
+
+    return c.Status(http.StatusOK).JSON(status)
+}
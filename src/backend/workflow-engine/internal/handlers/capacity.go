@@ -0,0 +1,30 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// SetCapacityMonitor enables the capacity endpoint
+func (h *ExecutionHandler) SetCapacityMonitor(capacity *core.CapacityMonitor) {
+    h.capacity = capacity
+}
+
+// GetCapacity handles GET /api/v1/capacity requests, summarizing
+// concurrency headroom, queue wait, scheduler drift, and database pool
+// saturation for autoscaler integration
+func (h *ExecutionHandler) GetCapacity(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "ExecutionHandler.GetCapacity")
+    defer span.Finish()
+
+    if h.capacity == nil {
+        return fiber.NewError(http.StatusNotFound, "capacity monitoring not configured")
+    }
+
+    return c.Status(http.StatusOK).JSON(h.capacity.Report())
+}
@@ -0,0 +1,54 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "net/http"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// ClusterStatusResponse represents the cluster status payload
+type ClusterStatusResponse struct {
+    Instances []*core.InstanceRecord `json:"instances"`
+    Total     int                    `json:"total"`
+    Timestamp time.Time              `json:"timestamp"`
+}
+
+// ClusterHandler handles HTTP requests for cluster status operations
+type ClusterHandler struct {
+    registry *core.InstanceRegistry
+}
+
+// NewClusterHandler creates a new cluster handler instance
+func NewClusterHandler(registry *core.InstanceRegistry) *ClusterHandler {
+    return &ClusterHandler{
+        registry: registry,
+    }
+}
+
+// GetCluster handles GET /api/v1/cluster requests, listing live engine
+// instances with their versions, active execution counts, and scheduler
+// leadership for operators running multiple replicas
+func (h *ClusterHandler) GetCluster(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "ClusterHandler.GetCluster")
+    defer span.Finish()
+
+    instances, err := h.registry.ListInstances(ctx)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusServiceUnavailable, "failed to list cluster instances")
+    }
+
+    span.SetTag("instance_count", len(instances))
+    return c.Status(http.StatusOK).JSON(ClusterStatusResponse{
+        Instances: instances,
+        Total:     len(instances),
+        Timestamp: time.Now().UTC(),
+    })
+}
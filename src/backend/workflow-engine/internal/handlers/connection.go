@@ -0,0 +1,156 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "fmt"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Connection type constants
+const (
+    connectionTypeDefault = "default"
+    connectionTypeOnError = "on_error"
+)
+
+// ConnectionRequest represents a node connection payload. Type selects the
+// connection kind: "default" routes the node's output, "on_error" routes
+// to a fallback branch executed when the source node fails
+type ConnectionRequest struct {
+    SourceNodeID uuid.UUID `json:"source_node_id" validate:"required"`
+    TargetNodeID uuid.UUID `json:"target_node_id" validate:"required"`
+    Type         string    `json:"type,omitempty"`
+
+    // InputMappings routes specific source output fields onto target
+    // input names instead of passing the whole output map through
+    InputMappings []models.InputMapping `json:"input_mappings,omitempty"`
+}
+
+// CreateConnection handles POST /api/v1/workflows/:id/connections requests,
+// connecting a source node's output to a target node's input
+func (h *WorkflowHandler) CreateConnection(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.CreateConnection")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    var req ConnectionRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+    if req.SourceNodeID == uuid.Nil || req.TargetNodeID == uuid.Nil {
+        return fmt.Errorf("%w: source and target node IDs are required", ErrInvalidRequest)
+    }
+
+    workflow, err := h.service.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return ErrNotFound
+    }
+
+    switch req.Type {
+    case "", connectionTypeDefault:
+        req.Type = connectionTypeDefault
+        err = workflow.Connect(req.SourceNodeID, req.TargetNodeID)
+    case connectionTypeOnError:
+        err = workflow.ConnectOnError(req.SourceNodeID, req.TargetNodeID)
+    default:
+        return fmt.Errorf("%w: unsupported connection type %q", ErrInvalidRequest, req.Type)
+    }
+    if err != nil {
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    // Declared input mappings are validated and stored on the target node
+    if len(req.InputMappings) > 0 {
+        if req.Type != connectionTypeDefault {
+            return fmt.Errorf("%w: input mappings are only supported on default connections", ErrInvalidRequest)
+        }
+        target := findNode(workflow, req.TargetNodeID)
+        if target == nil {
+            return fmt.Errorf("%w: target node not found", ErrInvalidRequest)
+        }
+        if err := target.SetInputMappings(req.SourceNodeID, req.InputMappings); err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+    }
+
+    if err := h.service.UpdateWorkflow(ctx, userID, workflow); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to persist connection: %w", err)
+    }
+
+    return c.Status(http.StatusCreated).JSON(fiber.Map{
+        "source_node_id": req.SourceNodeID,
+        "target_node_id": req.TargetNodeID,
+        "type":           req.Type,
+    })
+}
+
+// DeleteConnection handles DELETE /api/v1/workflows/:id/connections requests,
+// removing the connection between two nodes
+func (h *WorkflowHandler) DeleteConnection(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.DeleteConnection")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+
+    var req ConnectionRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    workflow, err := h.service.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return ErrNotFound
+    }
+
+    switch req.Type {
+    case "", connectionTypeDefault:
+        err = workflow.Disconnect(req.SourceNodeID, req.TargetNodeID)
+    case connectionTypeOnError:
+        err = workflow.DisconnectOnError(req.SourceNodeID, req.TargetNodeID)
+    default:
+        return fmt.Errorf("%w: unsupported connection type %q", ErrInvalidRequest, req.Type)
+    }
+    if err != nil {
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    if err := h.service.UpdateWorkflow(ctx, userID, workflow); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to persist connection removal: %w", err)
+    }
+
+    return c.SendStatus(http.StatusNoContent)
+}
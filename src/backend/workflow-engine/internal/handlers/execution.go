@@ -0,0 +1,187 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// ExecuteWorkflowRequest represents the workflow execution payload
+type ExecuteWorkflowRequest struct {
+    // Debug records full node inputs/outputs and template resolution traces
+    // for this specific run, subject to size limits
+    Debug   bool                   `json:"debug"`
+    Options map[string]interface{} `json:"options,omitempty"`
+
+    // Seed fixes random numbers, the clock, and UUID generation for this
+    // run so it can be reproduced exactly
+    Seed *int64 `json:"seed,omitempty"`
+}
+
+// ExecutionDebugResponse represents captured debug data for an execution
+type ExecutionDebugResponse struct {
+    ExecutionID uuid.UUID         `json:"execution_id"`
+    Entries     []core.DebugEntry `json:"entries"`
+    Truncated   bool              `json:"truncated"`
+}
+
+// ExecutionHandler handles HTTP requests for execution operations
+type ExecutionHandler struct {
+    engine   *core.Engine
+    debugger *core.DebugRecorder
+    lineage  *core.LineageRecorder
+    capacity *core.CapacityMonitor
+    queue    *core.ExecutionQueue
+}
+
+// NewExecutionHandler creates a new execution handler instance
+func NewExecutionHandler(engine *core.Engine, debugger *core.DebugRecorder) *ExecutionHandler {
+    return &ExecutionHandler{
+        engine:   engine,
+        debugger: debugger,
+    }
+}
+
+// SetLineageRecorder enables lineage lookups through this handler
+func (h *ExecutionHandler) SetLineageRecorder(lineage *core.LineageRecorder) {
+    h.lineage = lineage
+}
+
+// SetExecutionQueue enables priority queueing when the engine is
+// saturated instead of failing requests outright
+func (h *ExecutionHandler) SetExecutionQueue(queue *core.ExecutionQueue) {
+    h.queue = queue
+}
+
+// ExecuteWorkflow handles POST /api/v1/workflows/:id/execute requests with
+// optional per-run debug capture
+func (h *ExecutionHandler) ExecuteWorkflow(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "ExecutionHandler.ExecuteWorkflow")
+    defer span.Finish()
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    var req ExecuteWorkflowRequest
+    if len(c.Body()) > 0 {
+        if err := c.BodyParser(&req); err != nil {
+            ext.Error.Set(span, true)
+            span.SetTag("error", err.Error())
+            return fiber.NewError(http.StatusBadRequest, "invalid request payload")
+        }
+    }
+
+    executionID := uuid.New()
+    if req.Debug {
+        h.debugger.Enable(executionID)
+        span.SetTag("debug", true)
+    }
+
+    if req.Seed != nil {
+        ctx = core.WithDeterministicRun(ctx, *req.Seed, time.Time{})
+        span.SetTag("deterministic_seed", *req.Seed)
+    }
+
+    opts := req.Options
+    if opts == nil {
+        opts = make(map[string]interface{})
+    }
+    opts["execution_id"] = executionID
+
+    // When the engine is saturated, queue the run instead of competing
+    // for a slot; the queue dispatches in priority order as slots free,
+    // carrying this request's trace context and deterministic seed, and
+    // reports dispatch failures through its failure handler
+    if h.queue != nil && h.engine.ActiveExecutions() >= h.engine.MaxConcurrentExecutions() {
+        if _, err := h.queue.Enqueue(ctx, workflowID, opts); err != nil {
+            ext.Error.Set(span, true)
+            span.SetTag("error", err.Error())
+            if errors.Is(err, core.ErrQueueFull) {
+                return fiber.NewError(http.StatusTooManyRequests, "execution queue is full")
+            }
+            return fmt.Errorf("failed to queue workflow: %w", err)
+        }
+        span.SetTag("queued", true)
+        return c.Status(http.StatusAccepted).JSON(fiber.Map{
+            "execution_id": executionID,
+            "workflow_id":  workflowID,
+            "debug":        req.Debug,
+            "queued":       true,
+        })
+    }
+
+    if err := h.engine.StartWorkflow(ctx, workflowID, opts); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to execute workflow: %w", err)
+    }
+
+    return c.Status(http.StatusAccepted).JSON(fiber.Map{
+        "execution_id": executionID,
+        "workflow_id":  workflowID,
+        "debug":        req.Debug,
+    })
+}
+
+// GetExecutionDebug handles GET /api/v1/executions/:id/debug requests,
+// returning the captured debug trace for a run started with the debug flag
+func (h *ExecutionHandler) GetExecutionDebug(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "ExecutionHandler.GetExecutionDebug")
+    defer span.Finish()
+
+    executionID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusBadRequest, "invalid execution ID")
+    }
+
+    entries, truncated, err := h.debugger.GetCapture(executionID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusNotFound, "no debug capture for execution")
+    }
+
+    return c.Status(http.StatusOK).JSON(ExecutionDebugResponse{
+        ExecutionID: executionID,
+        Entries:     entries,
+        Truncated:   truncated,
+    })
+}
+
+// GetExecutionLineage handles GET /api/v1/executions/:id/lineage requests,
+// returning which upstream node outputs each node consumed during the run
+func (h *ExecutionHandler) GetExecutionLineage(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "ExecutionHandler.GetExecutionLineage")
+    defer span.Finish()
+
+    executionID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusBadRequest, "invalid execution ID")
+    }
+
+    if h.lineage == nil {
+        return fiber.NewError(http.StatusNotFound, "lineage tracking not enabled")
+    }
+
+    lineage, err := h.lineage.GetLineage(executionID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusNotFound, "no lineage recorded for execution")
+    }
+
+    return c.Status(http.StatusOK).JSON(lineage)
+}
@@ -0,0 +1,182 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "fmt"
+    "net/http"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/redaction"
+    "github.com/organization/workflow-engine/pkg/signing"
+)
+
+// WorkflowExport is the portable envelope for an exported workflow
+// definition. Hash is the content hash of the definition as exported —
+// a masked export hashes the redaction placeholders — and Signature is a
+// detached ed25519 signature over that hash when signing is configured
+type WorkflowExport struct {
+    Workflow   *models.Workflow `json:"workflow"`
+    Hash       string           `json:"hash"`
+    Signature  string           `json:"signature,omitempty"`
+    ExportedAt time.Time        `json:"exported_at"`
+}
+
+// SetDefinitionSigning enables signing of exported definitions and
+// signature verification on import. Either side may be nil: a signer-only
+// deployment exports signed definitions, a verifier-only deployment
+// accepts them
+func (h *WorkflowHandler) SetDefinitionSigning(signer *signing.Signer, verifier *signing.Verifier) {
+    h.signer = signer
+    h.verifier = verifier
+}
+
+// ExportWorkflow handles GET /api/v1/workflows/:id/export requests,
+// returning the definition with its content hash and, when a signing key
+// is configured, a signature proving this deployment produced it.
+// Secret-marked config fields are masked unless the caller was authorized
+// for an audited reveal; hash and signature cover the document as
+// exported, so masked and revealed exports both round-trip through import
+func (h *WorkflowHandler) ExportWorkflow(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.ExportWorkflow")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    workflow, err := h.service.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return err
+    }
+
+    reveal, err := h.resolveReveal(c, userID)
+    if err != nil {
+        return err
+    }
+
+    export, err := buildExportEnvelope(workflow, reveal, h.signer)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return err
+    }
+
+    return c.Status(http.StatusOK).JSON(export)
+}
+
+// buildExportEnvelope serializes, hashes, and optionally signs the
+// definition as it is being exported. A masked export is hashed over the
+// redaction placeholders, so the envelope verifies against the document
+// the recipient actually received
+func buildExportEnvelope(workflow *models.Workflow, reveal bool, signer *signing.Signer) (fiber.Map, error) {
+    var hash string
+    var err error
+    if reveal {
+        hash, err = workflow.Hash()
+    } else {
+        hash, err = workflow.MaskedHash(redaction.RedactedValue)
+    }
+    if err != nil {
+        return nil, fmt.Errorf("failed to hash workflow: %w", err)
+    }
+
+    document, err := serializeWorkflow(workflow, reveal, "")
+    if err != nil {
+        return nil, err
+    }
+
+    envelope := fiber.Map{
+        "workflow":    document,
+        "hash":        hash,
+        "exported_at": time.Now().UTC(),
+    }
+    if signer != nil {
+        envelope["signature"] = signer.Sign([]byte(hash))
+    }
+    return envelope, nil
+}
+
+// ImportWorkflow handles POST /api/v1/workflows/import requests. The
+// definition's content hash is recomputed and checked against the
+// envelope, and when a verification key is configured the signature is
+// verified too — unsigned or tampered definitions are rejected
+func (h *WorkflowHandler) ImportWorkflow(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.ImportWorkflow")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    var export WorkflowExport
+    if err := c.BodyParser(&export); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+    if export.Workflow == nil {
+        return fmt.Errorf("%w: workflow definition is required", ErrInvalidRequest)
+    }
+
+    hash, err := verifyExportEnvelope(&export, h.verifier)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return err
+    }
+    span.SetTag("definition_hash", hash)
+
+    created, err := h.service.CreateWorkflow(ctx, userID, export.Workflow)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return err
+    }
+
+    return c.Status(http.StatusCreated).JSON(created)
+}
+
+// verifyExportEnvelope checks an imported envelope's integrity and, when
+// a verification key is configured, its provenance, returning the
+// definition's content hash. Masked exports verify as-is: the hash was
+// computed over the same placeholders the definition now carries
+func verifyExportEnvelope(export *WorkflowExport, verifier *signing.Verifier) (string, error) {
+    // Integrity: the imported definition must hash to the envelope's hash
+    hash, err := export.Workflow.Hash()
+    if err != nil {
+        return "", fmt.Errorf("failed to hash workflow: %w", err)
+    }
+    if hash != export.Hash {
+        return "", fmt.Errorf("%w: definition does not match its hash", ErrInvalidRequest)
+    }
+
+    // Provenance: with a verification key configured, only definitions
+    // signed by a trusted deployment are accepted
+    if verifier != nil {
+        if export.Signature == "" {
+            return "", fmt.Errorf("%w: unsigned definition rejected", ErrInvalidRequest)
+        }
+        if err := verifier.Verify([]byte(hash), export.Signature); err != nil {
+            return "", fmt.Errorf("%w: signature verification failed", ErrInvalidRequest)
+        }
+    }
+    return hash, nil
+}
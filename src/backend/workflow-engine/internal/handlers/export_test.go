@@ -0,0 +1,139 @@
+package handlers
+
+import (
+    "bytes"
+    "encoding/json"
+    "testing"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/redaction"
+    "github.com/organization/workflow-engine/pkg/signing"
+)
+
+// newSecretWorkflow builds a workflow holding a secret-marked config
+// field so tests can observe masking through the export round trip
+func newSecretWorkflow(t *testing.T) *models.Workflow {
+    t.Helper()
+
+    workflow, err := models.NewWorkflow(uuid.New(), "exported", "round-trip fixture")
+    assert.NoError(t, err)
+
+    node, err := models.NewNode(workflow.ID, models.AITaskNode, "summarize", map[string]interface{}{
+        "ai_model": "gpt-4",
+        "api_key":  "super-secret",
+    })
+    assert.NoError(t, err)
+    assert.NoError(t, workflow.AddNode(node))
+
+    return workflow
+}
+
+// newSigningPair builds a signer and its matching verifier
+func newSigningPair(t *testing.T) (*signing.Signer, *signing.Verifier) {
+    t.Helper()
+
+    signer, err := signing.NewSigner(bytes.Repeat([]byte{7}, 32))
+    assert.NoError(t, err)
+    verifier, err := signing.NewVerifier(signer.PublicKey())
+    assert.NoError(t, err)
+    return signer, verifier
+}
+
+// roundTrip marshals an export envelope to JSON and parses it back the
+// way ImportWorkflow receives it
+func roundTrip(t *testing.T, envelope interface{}) *WorkflowExport {
+    t.Helper()
+
+    encoded, err := json.Marshal(envelope)
+    assert.NoError(t, err)
+
+    var export WorkflowExport
+    assert.NoError(t, json.Unmarshal(encoded, &export))
+    return &export
+}
+
+// TestExportImportRoundTripMasked tests that a default export, with
+// secrets masked, is signed over the masked document and verifies on
+// import without revealing the secret
+func TestExportImportRoundTripMasked(t *testing.T) {
+    signer, verifier := newSigningPair(t)
+    workflow := newSecretWorkflow(t)
+
+    envelope, err := buildExportEnvelope(workflow, false, signer)
+    assert.NoError(t, err)
+
+    export := roundTrip(t, envelope)
+    assert.Equal(t, redaction.RedactedValue, export.Workflow.Nodes[0].Config["api_key"],
+        "exported document should carry the placeholder, not the secret")
+
+    hash, err := verifyExportEnvelope(export, verifier)
+    assert.NoError(t, err)
+    assert.Equal(t, export.Hash, hash)
+}
+
+// TestExportImportRoundTripRevealed tests that an authorized revealed
+// export keeps the secret in clear text and still verifies on import
+func TestExportImportRoundTripRevealed(t *testing.T) {
+    signer, verifier := newSigningPair(t)
+    workflow := newSecretWorkflow(t)
+
+    envelope, err := buildExportEnvelope(workflow, true, signer)
+    assert.NoError(t, err)
+
+    export := roundTrip(t, envelope)
+    assert.Equal(t, "super-secret", export.Workflow.Nodes[0].Config["api_key"])
+
+    _, err = verifyExportEnvelope(export, verifier)
+    assert.NoError(t, err)
+}
+
+// TestImportRejectsTamperedDocument tests that editing the definition
+// after export breaks hash verification
+func TestImportRejectsTamperedDocument(t *testing.T) {
+    signer, verifier := newSigningPair(t)
+    workflow := newSecretWorkflow(t)
+
+    envelope, err := buildExportEnvelope(workflow, false, signer)
+    assert.NoError(t, err)
+
+    export := roundTrip(t, envelope)
+    export.Workflow.Nodes[0].Config["ai_model"] = "attacker-model"
+
+    _, err = verifyExportEnvelope(export, verifier)
+    assert.ErrorIs(t, err, ErrInvalidRequest)
+    assert.Contains(t, err.Error(), "does not match its hash")
+}
+
+// TestImportRejectsUnsignedEnvelope tests that a verifier-configured
+// deployment refuses envelopes without a signature
+func TestImportRejectsUnsignedEnvelope(t *testing.T) {
+    _, verifier := newSigningPair(t)
+    workflow := newSecretWorkflow(t)
+
+    envelope, err := buildExportEnvelope(workflow, false, nil)
+    assert.NoError(t, err)
+
+    _, err = verifyExportEnvelope(roundTrip(t, envelope), verifier)
+    assert.ErrorIs(t, err, ErrInvalidRequest)
+    assert.Contains(t, err.Error(), "unsigned")
+}
+
+// TestImportRejectsForeignSignature tests that a signature from an
+// untrusted key fails verification
+func TestImportRejectsForeignSignature(t *testing.T) {
+    signer, _ := newSigningPair(t)
+    foreign, err := signing.NewSigner(bytes.Repeat([]byte{9}, 32))
+    assert.NoError(t, err)
+    verifier, err := signing.NewVerifier(foreign.PublicKey())
+    assert.NoError(t, err)
+
+    envelope, err := buildExportEnvelope(newSecretWorkflow(t), false, signer)
+    assert.NoError(t, err)
+
+    _, err = verifyExportEnvelope(roundTrip(t, envelope), verifier)
+    assert.ErrorIs(t, err, ErrInvalidRequest)
+    assert.Contains(t, err.Error(), "signature verification failed")
+}
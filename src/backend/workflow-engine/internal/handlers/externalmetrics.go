@@ -0,0 +1,139 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "fmt"
+    "net/http"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// externalMetricsGroupVersion is the Kubernetes external metrics API
+// group/version these endpoints implement
+const externalMetricsGroupVersion = "external.metrics.k8s.io/v1beta1"
+
+// External metric names offered to the horizontal pod autoscaler
+const (
+    MetricQueueDepth             = "workflow_engine_queue_depth"
+    MetricActiveExecutions       = "workflow_engine_active_executions"
+    MetricConcurrencyUtilization = "workflow_engine_concurrency_utilization"
+)
+
+// externalMetricValue is one sample in the external metrics API wire
+// format. Value is a Kubernetes quantity string; ratios are expressed in
+// milli-units so they survive the integer-based quantity encoding
+type externalMetricValue struct {
+    MetricName   string            `json:"metricName"`
+    MetricLabels map[string]string `json:"metricLabels"`
+    Timestamp    time.Time         `json:"timestamp"`
+    Value        string            `json:"value"`
+}
+
+// externalMetricValueList is the list envelope the adapter and HPA expect
+type externalMetricValueList struct {
+    Kind       string                `json:"kind"`
+    APIVersion string                `json:"apiVersion"`
+    Metadata   struct{}              `json:"metadata"`
+    Items      []externalMetricValue `json:"items"`
+}
+
+// apiResource is one entry in the discovery document
+type apiResource struct {
+    Name         string   `json:"name"`
+    SingularName string   `json:"singularName"`
+    Namespaced   bool     `json:"namespaced"`
+    Kind         string   `json:"kind"`
+    Verbs        []string `json:"verbs"`
+}
+
+// apiResourceList is the discovery document for the metrics group so
+// adapters can enumerate which metrics this instance serves
+type apiResourceList struct {
+    Kind         string        `json:"kind"`
+    APIVersion   string        `json:"apiVersion"`
+    GroupVersion string        `json:"groupVersion"`
+    Resources    []apiResource `json:"resources"`
+}
+
+// ExternalMetricsHandler serves engine load metrics in the Kubernetes
+// external metrics API format so the engine deployment can autoscale on
+// queue depth and active executions rather than CPU. It implements the
+// read-only subset an external metrics adapter proxies; authentication
+// and aggregation-layer registration stay with the adapter
+type ExternalMetricsHandler struct {
+    capacity *core.CapacityMonitor
+}
+
+// NewExternalMetricsHandler creates an external metrics handler over the
+// capacity monitor
+func NewExternalMetricsHandler(capacity *core.CapacityMonitor) *ExternalMetricsHandler {
+    return &ExternalMetricsHandler{capacity: capacity}
+}
+
+// ListResources handles GET /apis/external.metrics.k8s.io/v1beta1,
+// returning the discovery document enumerating the served metrics
+func (h *ExternalMetricsHandler) ListResources(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "ExternalMetricsHandler.ListResources")
+    defer span.Finish()
+
+    list := apiResourceList{
+        Kind:         "APIResourceList",
+        APIVersion:   "v1",
+        GroupVersion: externalMetricsGroupVersion,
+        Resources:    make([]apiResource, 0, 3),
+    }
+    for _, name := range []string{MetricQueueDepth, MetricActiveExecutions, MetricConcurrencyUtilization} {
+        list.Resources = append(list.Resources, apiResource{
+            Name:       name,
+            Namespaced: true,
+            Kind:       "ExternalMetricValueList",
+            Verbs:      []string{"get"},
+        })
+    }
+
+    return c.Status(http.StatusOK).JSON(list)
+}
+
+// GetMetric handles
+// GET /apis/external.metrics.k8s.io/v1beta1/namespaces/:namespace/:metric,
+// returning the current value of one engine load metric
+func (h *ExternalMetricsHandler) GetMetric(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "ExternalMetricsHandler.GetMetric")
+    defer span.Finish()
+
+    metric := c.Params("metric")
+    span.SetTag("metric", metric)
+
+    report := h.capacity.Report()
+
+    var value string
+    switch metric {
+    case MetricQueueDepth:
+        value = fmt.Sprintf("%d", report.QueueDepth)
+    case MetricActiveExecutions:
+        value = fmt.Sprintf("%d", report.ActiveExecutions)
+    case MetricConcurrencyUtilization:
+        value = fmt.Sprintf("%dm", int64(report.Utilization*1000))
+    default:
+        return fiber.NewError(http.StatusNotFound, fmt.Sprintf("metric %s not found", metric))
+    }
+
+    list := externalMetricValueList{
+        Kind:       "ExternalMetricValueList",
+        APIVersion: externalMetricsGroupVersion,
+        Items: []externalMetricValue{
+            {
+                MetricName:   metric,
+                MetricLabels: map[string]string{},
+                Timestamp:    time.Now().UTC(),
+                Value:        value,
+            },
+        },
+    }
+
+    return c.Status(http.StatusOK).JSON(list)
+}
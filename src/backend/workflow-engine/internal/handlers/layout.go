@@ -0,0 +1,71 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "fmt"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+)
+
+// NodePosition represents one node's canvas coordinates
+type NodePosition struct {
+    X int `json:"x"`
+    Y int `json:"y"`
+}
+
+// LayoutRequest represents a canvas autosave payload. Only positions and
+// canvas metadata are touched; the workflow definition is left alone
+type LayoutRequest struct {
+    Positions map[string]NodePosition `json:"positions,omitempty"`
+    Canvas    map[string]interface{}  `json:"canvas,omitempty"`
+}
+
+// UpdateLayout handles PATCH /api/v1/workflows/:id/layout requests,
+// persisting node positions and canvas metadata without bumping the
+// workflow version or re-validating the whole definition
+func (h *WorkflowHandler) UpdateLayout(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.UpdateLayout")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    var req LayoutRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+    if len(req.Positions) == 0 && req.Canvas == nil {
+        return fmt.Errorf("%w: nothing to update", ErrInvalidRequest)
+    }
+
+    positions := make(map[uuid.UUID][2]int, len(req.Positions))
+    for key, position := range req.Positions {
+        nodeID, err := uuid.Parse(key)
+        if err != nil {
+            return fmt.Errorf("%w: invalid node ID %q", ErrInvalidRequest, key)
+        }
+        positions[nodeID] = [2]int{position.X, position.Y}
+    }
+
+    if err := h.service.UpdateLayout(ctx, userID, workflowID, positions, req.Canvas); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return err
+    }
+
+    return c.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,115 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "errors"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// MaintenancePauseRequest represents a manual maintenance pause payload
+type MaintenancePauseRequest struct {
+    Reason string `json:"reason,omitempty"`
+}
+
+// SetMaintenanceManager attaches the maintenance manager backing the
+// maintenance-mode admin endpoints
+func (h *AdminHandler) SetMaintenanceManager(manager *core.MaintenanceManager) {
+    h.maintenance = manager
+}
+
+// GetMaintenance handles GET /api/v1/admin/maintenance requests, returning
+// the current pause state and the scheduled windows
+func (h *AdminHandler) GetMaintenance(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.GetMaintenance")
+    defer span.Finish()
+
+    if h.maintenance == nil {
+        return fiber.NewError(http.StatusNotFound, "maintenance mode not configured")
+    }
+
+    paused, reason := h.maintenance.InMaintenance()
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "in_maintenance": paused,
+        "reason":         reason,
+        "windows":        h.maintenance.Windows(),
+    })
+}
+
+// AddMaintenanceWindow handles POST /api/v1/admin/maintenance/windows
+// requests, scheduling a window during which scheduled executions are
+// skipped globally
+func (h *AdminHandler) AddMaintenanceWindow(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.AddMaintenanceWindow")
+    defer span.Finish()
+
+    if h.maintenance == nil {
+        return fiber.NewError(http.StatusNotFound, "maintenance mode not configured")
+    }
+
+    var window core.MaintenanceWindow
+    if err := c.BodyParser(&window); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusBadRequest, "invalid request payload")
+    }
+
+    if err := h.maintenance.AddWindow(window); err != nil {
+        if errors.Is(err, core.ErrInvalidWindow) {
+            return fiber.NewError(http.StatusBadRequest, err.Error())
+        }
+        return err
+    }
+
+    return c.Status(http.StatusCreated).JSON(fiber.Map{
+        "windows": h.maintenance.Windows(),
+    })
+}
+
+// PauseMaintenance handles POST /api/v1/admin/maintenance/pause requests,
+// suspending scheduled executions immediately
+func (h *AdminHandler) PauseMaintenance(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.PauseMaintenance")
+    defer span.Finish()
+
+    if h.maintenance == nil {
+        return fiber.NewError(http.StatusNotFound, "maintenance mode not configured")
+    }
+
+    var req MaintenancePauseRequest
+    if len(c.Body()) > 0 {
+        if err := c.BodyParser(&req); err != nil {
+            ext.Error.Set(span, true)
+            return fiber.NewError(http.StatusBadRequest, "invalid request payload")
+        }
+    }
+
+    h.maintenance.Pause(req.Reason)
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "in_maintenance": true,
+        "reason":         req.Reason,
+    })
+}
+
+// ResumeMaintenance handles POST /api/v1/admin/maintenance/resume requests,
+// lifting the manual pause; scheduled windows stay in effect
+func (h *AdminHandler) ResumeMaintenance(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.ResumeMaintenance")
+    defer span.Finish()
+
+    if h.maintenance == nil {
+        return fiber.NewError(http.StatusNotFound, "maintenance mode not configured")
+    }
+
+    h.maintenance.Resume()
+    paused, reason := h.maintenance.InMaintenance()
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "in_maintenance": paused,
+        "reason":         reason,
+    })
+}
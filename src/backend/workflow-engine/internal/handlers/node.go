@@ -0,0 +1,216 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "context"
+    "fmt"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// CreateNodeRequest represents a node creation payload
+type CreateNodeRequest struct {
+    Type      models.NodeType        `json:"type" validate:"required"`
+    Name      string                 `json:"name" validate:"required,min=1,max=100"`
+    Config    map[string]interface{} `json:"config" validate:"required"`
+    PositionX int                    `json:"position_x"`
+    PositionY int                    `json:"position_y"`
+}
+
+// UpdateNodeRequest represents a node update payload
+type UpdateNodeRequest struct {
+    Config    map[string]interface{} `json:"config,omitempty"`
+    PositionX *int                   `json:"position_x,omitempty"`
+    PositionY *int                   `json:"position_y,omitempty"`
+}
+
+// CreateNode handles POST /api/v1/workflows/:id/nodes requests
+func (h *WorkflowHandler) CreateNode(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.CreateNode")
+    defer span.Finish()
+
+    userID, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    var req CreateNodeRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    node, err := models.NewNode(workflow.ID, req.Type, req.Name, req.Config)
+    if err != nil {
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+    if req.PositionX != 0 || req.PositionY != 0 {
+        if err := node.UpdatePosition(req.PositionX, req.PositionY); err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+    }
+
+    if err := workflow.AddNode(node); err != nil {
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    if err := h.service.UpdateWorkflow(ctx, userID, workflow); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to persist node: %w", err)
+    }
+
+    span.SetTag("node_id", node.ID.String())
+    return c.Status(http.StatusCreated).JSON(node)
+}
+
+// GetNodes handles GET /api/v1/workflows/:id/nodes requests. Secret-marked
+// config fields stay masked unless the caller was authorized for an
+// audited reveal
+func (h *WorkflowHandler) GetNodes(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.GetNodes")
+    defer span.Finish()
+
+    userID, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    reveal, err := h.resolveReveal(c, userID)
+    if err != nil {
+        return err
+    }
+
+    document, err := serializeNodes(workflow.GetNodes(), reveal)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return err
+    }
+
+    return c.Status(http.StatusOK).JSON(document)
+}
+
+// UpdateNode handles PUT /api/v1/workflows/:id/nodes/:nodeID requests,
+// updating node configuration and canvas position
+func (h *WorkflowHandler) UpdateNode(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.UpdateNode")
+    defer span.Finish()
+
+    userID, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    nodeID, err := uuid.Parse(c.Params("nodeID"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid node ID")
+    }
+
+    var req UpdateNodeRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    node := findNode(workflow, nodeID)
+    if node == nil {
+        return fiber.NewError(http.StatusNotFound, "node not found")
+    }
+
+    if req.Config != nil {
+        if err := node.UpdateConfig(req.Config); err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+    }
+    if req.PositionX != nil && req.PositionY != nil {
+        if err := node.UpdatePosition(*req.PositionX, *req.PositionY); err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+    }
+
+    if err := h.service.UpdateWorkflow(ctx, userID, workflow); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to persist node update: %w", err)
+    }
+
+    return c.Status(http.StatusOK).JSON(node)
+}
+
+// DeleteNode handles DELETE /api/v1/workflows/:id/nodes/:nodeID requests
+func (h *WorkflowHandler) DeleteNode(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.DeleteNode")
+    defer span.Finish()
+
+    userID, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    nodeID, err := uuid.Parse(c.Params("nodeID"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid node ID")
+    }
+
+    if err := workflow.RemoveNode(nodeID); err != nil {
+        return fiber.NewError(http.StatusNotFound, "node not found")
+    }
+
+    if err := h.service.UpdateWorkflow(ctx, userID, workflow); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to persist node removal: %w", err)
+    }
+
+    return c.SendStatus(http.StatusNoContent)
+}
+
+// GetNodeSchema handles GET /api/v1/node-types/:type/schema requests,
+// returning the configuration schema used to drive config forms
+func (h *WorkflowHandler) GetNodeSchema(c *fiber.Ctx) error {
+    schema, err := models.GetNodeSchema(models.NodeType(c.Params("type")))
+    if err != nil {
+        return fiber.NewError(http.StatusNotFound, err.Error())
+    }
+    return c.Status(http.StatusOK).JSON(schema)
+}
+
+// loadWorkflowForUpdate resolves the authenticated user and target workflow
+// shared by the node CRUD handlers
+func (h *WorkflowHandler) loadWorkflowForUpdate(c *fiber.Ctx, ctx context.Context, span opentracing.Span) (uuid.UUID, *models.Workflow, error) {
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return uuid.Nil, nil, ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return uuid.Nil, nil, fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    workflow, err := h.service.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return uuid.Nil, nil, ErrNotFound
+    }
+
+    return userID, workflow, nil
+}
+
+// findNode locates a node by ID within a workflow
+func findNode(workflow *models.Workflow, nodeID uuid.UUID) *models.Node {
+    for _, node := range workflow.GetNodes() {
+        if node.ID == nodeID {
+            return node
+        }
+    }
+    return nil
+}
@@ -0,0 +1,164 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+
+    "github.com/organization/workflow-engine/internal/services"
+)
+
+// PromptHandler manages the versioned prompt template library
+type PromptHandler struct {
+    service *services.PromptService
+}
+
+// NewPromptHandler creates a new prompt handler instance
+func NewPromptHandler(service *services.PromptService) *PromptHandler {
+    return &PromptHandler{service: service}
+}
+
+// PromptRequest is the create/update payload for prompt templates
+type PromptRequest struct {
+    Name        string `json:"name"`
+    Description string `json:"description"`
+    Content     string `json:"content"`
+}
+
+// ListPrompts handles GET /api/v1/prompt-templates requests, returning
+// the latest version of every template
+func (h *PromptHandler) ListPrompts(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "PromptHandler.ListPrompts")
+    defer span.Finish()
+
+    templates, err := h.service.List(ctx)
+    if err != nil {
+        return fiber.NewError(http.StatusInternalServerError, "failed to list prompt templates")
+    }
+    return c.Status(http.StatusOK).JSON(fiber.Map{"templates": templates})
+}
+
+// CreatePrompt handles POST /api/v1/prompt-templates requests
+func (h *PromptHandler) CreatePrompt(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "PromptHandler.CreatePrompt")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        return fiber.NewError(http.StatusUnauthorized, "authentication required")
+    }
+
+    var request PromptRequest
+    if err := c.BodyParser(&request); err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid request body")
+    }
+
+    template, err := h.service.Create(ctx, request.Name, request.Description, request.Content, userID)
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, err.Error())
+    }
+    return c.Status(http.StatusCreated).JSON(template)
+}
+
+// GetPrompt handles GET /api/v1/prompt-templates/:id requests; the
+// optional version query selects a specific version, defaulting to the
+// latest
+func (h *PromptHandler) GetPrompt(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "PromptHandler.GetPrompt")
+    defer span.Finish()
+
+    id, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid template ID")
+    }
+
+    version := 0
+    if raw := c.Query("version"); raw != "" {
+        version, err = strconv.Atoi(raw)
+        if err != nil || version < 1 {
+            return fiber.NewError(http.StatusBadRequest, "invalid version")
+        }
+    }
+
+    template, err := h.service.Get(ctx, id, version)
+    if err != nil {
+        return promptError(err)
+    }
+    return c.Status(http.StatusOK).JSON(template)
+}
+
+// ListPromptVersions handles GET /api/v1/prompt-templates/:id/versions
+// requests, returning the template's full version history
+func (h *PromptHandler) ListPromptVersions(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "PromptHandler.ListPromptVersions")
+    defer span.Finish()
+
+    id, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid template ID")
+    }
+
+    versions, err := h.service.ListVersions(ctx, id)
+    if err != nil {
+        return promptError(err)
+    }
+    return c.Status(http.StatusOK).JSON(fiber.Map{"versions": versions})
+}
+
+// CreatePromptVersion handles POST /api/v1/prompt-templates/:id/versions
+// requests, appending a new immutable version
+func (h *PromptHandler) CreatePromptVersion(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "PromptHandler.CreatePromptVersion")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        return fiber.NewError(http.StatusUnauthorized, "authentication required")
+    }
+
+    id, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid template ID")
+    }
+
+    var request PromptRequest
+    if err := c.BodyParser(&request); err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid request body")
+    }
+
+    template, err := h.service.NewVersion(ctx, id, request.Content, userID)
+    if err != nil {
+        return promptError(err)
+    }
+    return c.Status(http.StatusCreated).JSON(template)
+}
+
+// DeletePrompt handles DELETE /api/v1/prompt-templates/:id requests,
+// removing the template and its full version history
+func (h *PromptHandler) DeletePrompt(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "PromptHandler.DeletePrompt")
+    defer span.Finish()
+
+    id, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid template ID")
+    }
+
+    if err := h.service.Delete(ctx, id); err != nil {
+        return promptError(err)
+    }
+    return c.SendStatus(http.StatusNoContent)
+}
+
+// promptError maps service errors onto HTTP statuses
+func promptError(err error) error {
+    if errors.Is(err, services.ErrPromptNotFound) {
+        return fiber.NewError(http.StatusNotFound, "prompt template not found")
+    }
+    return fiber.NewError(http.StatusBadRequest, err.Error())
+}
@@ -0,0 +1,108 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "errors"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// Schedule status values accepted by the schedule endpoint
+const (
+    scheduleStatusActive = "active"
+    scheduleStatusPaused = "paused"
+)
+
+// ScheduleStatusRequest represents a schedule status transition payload
+type ScheduleStatusRequest struct {
+    Status string `json:"status"`
+}
+
+// SetScheduler attaches the scheduler backing the per-workflow schedule
+// endpoint
+func (h *WorkflowHandler) SetScheduler(scheduler core.WorkflowScheduler) {
+    h.scheduler = scheduler
+}
+
+// UpdateSchedule handles PATCH /api/v1/workflows/:id/schedule requests,
+// pausing or resuming the workflow's schedule without removing its
+// configuration or history
+func (h *WorkflowHandler) UpdateSchedule(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.UpdateSchedule")
+    defer span.Finish()
+
+    if h.scheduler == nil {
+        return fiber.NewError(http.StatusNotImplemented, "scheduling is not enabled")
+    }
+
+    _, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    var req ScheduleStatusRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusBadRequest, "invalid request body")
+    }
+
+    switch req.Status {
+    case scheduleStatusPaused:
+        err = h.scheduler.PauseSchedule(ctx, workflow.ID)
+    case scheduleStatusActive:
+        err = h.scheduler.ResumeSchedule(ctx, workflow.ID)
+    default:
+        return fiber.NewError(http.StatusBadRequest, "status must be active or paused")
+    }
+    if err != nil {
+        if errors.Is(err, core.ErrScheduleNotFound) {
+            return fiber.NewError(http.StatusNotFound, err.Error())
+        }
+        ext.Error.Set(span, true)
+        return err
+    }
+    span.SetTag("schedule.status", req.Status)
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "workflow_id": workflow.ID,
+        "status":      req.Status,
+    })
+}
+
+// GetSchedule handles GET /api/v1/workflows/:id/schedule requests,
+// reporting whether the workflow's schedule is active or paused
+func (h *WorkflowHandler) GetSchedule(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.GetSchedule")
+    defer span.Finish()
+
+    if h.scheduler == nil {
+        return fiber.NewError(http.StatusNotImplemented, "scheduling is not enabled")
+    }
+
+    _, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    paused, err := h.scheduler.IsSchedulePaused(workflow.ID)
+    if err != nil {
+        if errors.Is(err, core.ErrScheduleNotFound) {
+            return fiber.NewError(http.StatusNotFound, err.Error())
+        }
+        return err
+    }
+
+    status := scheduleStatusActive
+    if paused {
+        status = scheduleStatusPaused
+    }
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "workflow_id": workflow.ID,
+        "status":      status,
+    })
+}
@@ -0,0 +1,122 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "strings"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/redaction"
+)
+
+// SecretRevealEvent is one audited use of ?reveal=true on a GET response
+type SecretRevealEvent struct {
+    UserID uuid.UUID `json:"user_id"`
+    Path   string    `json:"path"`
+}
+
+// SecretRevealPolicy decides who may read secret-marked node config
+// fields in clear text; every authorized reveal is audited
+type SecretRevealPolicy struct {
+    // Authorize reports whether the user may reveal secret fields
+    Authorize func(userID uuid.UUID) bool
+    // OnReveal is called for each authorized reveal; may be nil
+    OnReveal func(event SecretRevealEvent)
+}
+
+// SetSecretRevealPolicy enables audited secret revelation on GET
+// responses; without a policy secret fields are always masked
+func (h *WorkflowHandler) SetSecretRevealPolicy(policy *SecretRevealPolicy) {
+    h.reveal = policy
+}
+
+// resolveReveal evaluates the request's reveal parameter against the
+// handler's policy, auditing authorized reveals and rejecting
+// unauthorized ones
+func (h *WorkflowHandler) resolveReveal(c *fiber.Ctx, userID uuid.UUID) (bool, error) {
+    if !c.QueryBool("reveal") {
+        return false, nil
+    }
+    if h.reveal == nil || h.reveal.Authorize == nil || !h.reveal.Authorize(userID) {
+        return false, fiber.NewError(http.StatusForbidden, "not authorized to reveal secret fields")
+    }
+    if h.reveal.OnReveal != nil {
+        h.reveal.OnReveal(SecretRevealEvent{UserID: userID, Path: c.Path()})
+    }
+    return true, nil
+}
+
+// serializeWorkflow renders a workflow for an API response, masking
+// secret-marked node config fields unless the caller was authorized to
+// reveal them, then applying optional top-level field projection
+func serializeWorkflow(workflow *models.Workflow, reveal bool, fields string) (interface{}, error) {
+    encoded, err := json.Marshal(workflow)
+    if err != nil {
+        return nil, fmt.Errorf("failed to marshal workflow: %w", err)
+    }
+
+    var document map[string]interface{}
+    if err := json.Unmarshal(encoded, &document); err != nil {
+        return nil, fmt.Errorf("failed to unmarshal workflow: %w", err)
+    }
+
+    if !reveal {
+        maskSecretFields(document)
+    }
+
+    if fields == "" {
+        return document, nil
+    }
+    return projectFields(document, strings.Split(fields, ","))
+}
+
+// serializeNodes renders a node list for an API response with the same
+// secret masking applied to full workflow documents
+func serializeNodes(nodes []*models.Node, reveal bool) (interface{}, error) {
+    encoded, err := json.Marshal(nodes)
+    if err != nil {
+        return nil, fmt.Errorf("failed to marshal nodes: %w", err)
+    }
+
+    var documents []interface{}
+    if err := json.Unmarshal(encoded, &documents); err != nil {
+        return nil, fmt.Errorf("failed to unmarshal nodes: %w", err)
+    }
+
+    if !reveal {
+        maskNodeDocuments(documents)
+    }
+    return documents, nil
+}
+
+// maskSecretFields replaces secret-marked config values across the
+// document's nodes with the redaction placeholder
+func maskSecretFields(document map[string]interface{}) {
+    nodes, _ := document["nodes"].([]interface{})
+    maskNodeDocuments(nodes)
+}
+
+// maskNodeDocuments masks secret-marked config values in serialized nodes
+func maskNodeDocuments(nodes []interface{}) {
+    for _, entry := range nodes {
+        node, ok := entry.(map[string]interface{})
+        if !ok {
+            continue
+        }
+        nodeType, _ := node["type"].(string)
+        config, ok := node["config"].(map[string]interface{})
+        if !ok {
+            continue
+        }
+        for _, field := range models.SecretConfigFields(models.NodeType(nodeType)) {
+            if _, exists := config[field]; exists {
+                config[field] = redaction.RedactedValue
+            }
+        }
+    }
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+    "net/http"
+    "net/http/httptest"
+    "testing"
+
+    "github.com/gofiber/fiber/v2"
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/pkg/redaction"
+)
+
+// resolveRevealResult runs resolveReveal inside a fiber request so the
+// query parameter and policy interplay can be asserted
+func resolveRevealResult(t *testing.T, handler *WorkflowHandler, path string, userID uuid.UUID) (bool, error) {
+    t.Helper()
+
+    var reveal bool
+    var revealErr error
+    app := fiber.New()
+    app.Get("/workflows", func(c *fiber.Ctx) error {
+        reveal, revealErr = handler.resolveReveal(c, userID)
+        return c.SendString("ok")
+    })
+
+    resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+    assert.NoError(t, err)
+    resp.Body.Close()
+    return reveal, revealErr
+}
+
+// TestSerializeWorkflowMasksSecrets tests that secret-marked config
+// fields are replaced by the placeholder while other fields pass through
+func TestSerializeWorkflowMasksSecrets(t *testing.T) {
+    workflow := newSecretWorkflow(t)
+
+    serialized, err := serializeWorkflow(workflow, false, "")
+    assert.NoError(t, err)
+
+    document := serialized.(map[string]interface{})
+    nodes := document["nodes"].([]interface{})
+    config := nodes[0].(map[string]interface{})["config"].(map[string]interface{})
+    assert.Equal(t, redaction.RedactedValue, config["api_key"])
+    assert.Equal(t, "gpt-4", config["ai_model"], "non-secret fields should pass through")
+}
+
+// TestSerializeWorkflowReveal tests that an authorized reveal keeps
+// secret fields in clear text
+func TestSerializeWorkflowReveal(t *testing.T) {
+    workflow := newSecretWorkflow(t)
+
+    serialized, err := serializeWorkflow(workflow, true, "")
+    assert.NoError(t, err)
+
+    document := serialized.(map[string]interface{})
+    nodes := document["nodes"].([]interface{})
+    config := nodes[0].(map[string]interface{})["config"].(map[string]interface{})
+    assert.Equal(t, "super-secret", config["api_key"])
+}
+
+// TestSerializeNodesMasksSecrets tests that node list responses apply
+// the same masking as full workflow documents
+func TestSerializeNodesMasksSecrets(t *testing.T) {
+    workflow := newSecretWorkflow(t)
+
+    serialized, err := serializeNodes(workflow.Nodes, false)
+    assert.NoError(t, err)
+
+    nodes := serialized.([]interface{})
+    config := nodes[0].(map[string]interface{})["config"].(map[string]interface{})
+    assert.Equal(t, redaction.RedactedValue, config["api_key"])
+}
+
+// TestResolveRevealWithoutParameter tests that requests not asking for a
+// reveal are served masked regardless of policy
+func TestResolveRevealWithoutParameter(t *testing.T) {
+    handler := &WorkflowHandler{}
+
+    reveal, err := resolveRevealResult(t, handler, "/workflows", uuid.New())
+    assert.NoError(t, err)
+    assert.False(t, reveal)
+}
+
+// TestResolveRevealWithoutPolicy tests that ?reveal=true is forbidden
+// when no reveal policy is configured
+func TestResolveRevealWithoutPolicy(t *testing.T) {
+    handler := &WorkflowHandler{}
+
+    _, err := resolveRevealResult(t, handler, "/workflows?reveal=true", uuid.New())
+    var fiberErr *fiber.Error
+    assert.ErrorAs(t, err, &fiberErr)
+    assert.Equal(t, http.StatusForbidden, fiberErr.Code)
+}
+
+// TestResolveRevealAuthorization tests that the policy decides per user
+// and that every authorized reveal is audited
+func TestResolveRevealAuthorization(t *testing.T) {
+    authorized := uuid.New()
+    var audited []SecretRevealEvent
+
+    handler := &WorkflowHandler{}
+    handler.SetSecretRevealPolicy(&SecretRevealPolicy{
+        Authorize: func(userID uuid.UUID) bool { return userID == authorized },
+        OnReveal:  func(event SecretRevealEvent) { audited = append(audited, event) },
+    })
+
+    reveal, err := resolveRevealResult(t, handler, "/workflows?reveal=true", authorized)
+    assert.NoError(t, err)
+    assert.True(t, reveal)
+    assert.Len(t, audited, 1)
+    assert.Equal(t, authorized, audited[0].UserID)
+    assert.Equal(t, "/workflows", audited[0].Path)
+
+    _, err = resolveRevealResult(t, handler, "/workflows?reveal=true", uuid.New())
+    var fiberErr *fiber.Error
+    assert.ErrorAs(t, err, &fiberErr)
+    assert.Equal(t, http.StatusForbidden, fiberErr.Code)
+    assert.Len(t, audited, 1, "denied requests must not be audited as reveals")
+}
+
+// TestSerializeWorkflowFieldProjection tests that the fields parameter
+// trims the document after masking
+func TestSerializeWorkflowFieldProjection(t *testing.T) {
+    workflow := newSecretWorkflow(t)
+
+    serialized, err := serializeWorkflow(workflow, false, "id,name")
+    assert.NoError(t, err)
+
+    document := serialized.(map[string]interface{})
+    assert.Equal(t, workflow.Name, document["name"])
+    assert.Contains(t, document, "id")
+    assert.NotContains(t, document, "nodes")
+}
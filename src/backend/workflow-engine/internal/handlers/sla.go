@@ -0,0 +1,111 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "errors"
+    "net/http"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// SLARequest represents an SLA definition payload. MaxDuration uses Go
+// duration syntax (e.g. "5m"); MinSuccessRate is a ratio between 0 and 1
+type SLARequest struct {
+    MaxDuration    string  `json:"max_duration,omitempty"`
+    MinSuccessRate float64 `json:"min_success_rate,omitempty"`
+    WindowSize     int     `json:"window_size,omitempty"`
+}
+
+// SetSLAMonitor attaches the SLA monitor backing the per-workflow SLA
+// endpoints
+func (h *WorkflowHandler) SetSLAMonitor(monitor *core.SLAMonitor) {
+    h.sla = monitor
+}
+
+// SetSLA handles PUT /api/v1/workflows/:id/sla requests, defining or
+// replacing the workflow's SLA targets
+func (h *WorkflowHandler) SetSLA(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.SetSLA")
+    defer span.Finish()
+
+    if h.sla == nil {
+        return fiber.NewError(http.StatusNotImplemented, "SLA monitoring is not enabled")
+    }
+
+    _, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    var req SLARequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusBadRequest, "invalid request body")
+    }
+
+    definition := core.SLADefinition{
+        MinSuccessRate: req.MinSuccessRate,
+        WindowSize:     req.WindowSize,
+    }
+    if req.MaxDuration != "" {
+        maxDuration, err := time.ParseDuration(req.MaxDuration)
+        if err != nil {
+            return fiber.NewError(http.StatusBadRequest, "invalid max_duration")
+        }
+        definition.MaxDuration = maxDuration
+    }
+
+    if err := h.sla.SetSLA(workflow.ID, definition); err != nil {
+        return fiber.NewError(http.StatusBadRequest, err.Error())
+    }
+
+    return c.Status(http.StatusOK).JSON(definition)
+}
+
+// GetSLA handles GET /api/v1/workflows/:id/sla requests
+func (h *WorkflowHandler) GetSLA(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.GetSLA")
+    defer span.Finish()
+
+    if h.sla == nil {
+        return fiber.NewError(http.StatusNotImplemented, "SLA monitoring is not enabled")
+    }
+
+    _, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    definition, err := h.sla.GetSLA(workflow.ID)
+    if err != nil {
+        if errors.Is(err, core.ErrSLANotDefined) {
+            return fiber.NewError(http.StatusNotFound, err.Error())
+        }
+        return err
+    }
+
+    return c.Status(http.StatusOK).JSON(definition)
+}
+
+// DeleteSLA handles DELETE /api/v1/workflows/:id/sla requests
+func (h *WorkflowHandler) DeleteSLA(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.DeleteSLA")
+    defer span.Finish()
+
+    if h.sla == nil {
+        return fiber.NewError(http.StatusNotImplemented, "SLA monitoring is not enabled")
+    }
+
+    _, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    h.sla.RemoveSLA(workflow.ID)
+    return c.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,113 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "net/http"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/services"
+)
+
+// SupportGrantRequest represents a support access grant payload. Duration
+// uses Go duration syntax (e.g. "2h") and defaults when omitted
+type SupportGrantRequest struct {
+    UserID     string `json:"user_id"`
+    Reason     string `json:"reason"`
+    ConsentRef string `json:"consent_ref"`
+    Duration   string `json:"duration,omitempty"`
+}
+
+// SetSupportAccess enables support access grant management through this
+// handler
+func (h *AdminHandler) SetSupportAccess(manager *services.SupportAccessManager) {
+    h.support = manager
+}
+
+// CreateSupportGrant handles POST /api/v1/admin/support/grants requests,
+// issuing a consented, time-bounded grant for the calling admin to act
+// within a customer's account
+func (h *AdminHandler) CreateSupportGrant(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.CreateSupportGrant")
+    defer span.Finish()
+
+    if h.support == nil {
+        return fiber.NewError(http.StatusNotFound, "support access not configured")
+    }
+
+    adminID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusUnauthorized, "unauthorized")
+    }
+
+    var req SupportGrantRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusBadRequest, "invalid request payload")
+    }
+
+    userID, err := uuid.Parse(req.UserID)
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid user ID")
+    }
+
+    var duration time.Duration
+    if req.Duration != "" {
+        duration, err = time.ParseDuration(req.Duration)
+        if err != nil {
+            return fiber.NewError(http.StatusBadRequest, "invalid duration")
+        }
+    }
+
+    grant, err := h.support.Grant(adminID, userID, req.Reason, req.ConsentRef, duration)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fiber.NewError(http.StatusBadRequest, err.Error())
+    }
+
+    return c.Status(http.StatusCreated).JSON(grant)
+}
+
+// RevokeSupportGrant handles DELETE /api/v1/admin/support/grants/:id
+// requests, ending a grant before its automatic expiry
+func (h *AdminHandler) RevokeSupportGrant(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.RevokeSupportGrant")
+    defer span.Finish()
+
+    if h.support == nil {
+        return fiber.NewError(http.StatusNotFound, "support access not configured")
+    }
+
+    grantID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid grant ID")
+    }
+
+    if err := h.support.Revoke(grantID); err != nil {
+        ext.Error.Set(span, true)
+        return fiber.NewError(http.StatusNotFound, err.Error())
+    }
+
+    return c.SendStatus(http.StatusNoContent)
+}
+
+// ListSupportGrants handles GET /api/v1/admin/support/grants requests
+func (h *AdminHandler) ListSupportGrants(c *fiber.Ctx) error {
+    span, _ := opentracing.StartSpanFromContext(c.Context(), "AdminHandler.ListSupportGrants")
+    defer span.Finish()
+
+    if h.support == nil {
+        return fiber.NewError(http.StatusNotFound, "support access not configured")
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "grants": h.support.ListGrants(),
+    })
+}
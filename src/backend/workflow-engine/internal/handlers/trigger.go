@@ -0,0 +1,73 @@
+// Package handlers provides HTTP request handlers for the workflow engine
+package handlers
+
+import (
+    "fmt"
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// SetTriggerBatcher enables trigger event submission through this handler
+func (h *WorkflowHandler) SetTriggerBatcher(batcher *core.TriggerBatcher) {
+    h.batcher = batcher
+}
+
+// TriggerWorkflow handles POST /api/v1/workflows/:id/trigger requests,
+// routing one trigger event through the aggregation layer. Workflows
+// whose trigger node declares a batch block have events coalesced into a
+// single execution per window or count
+func (h *WorkflowHandler) TriggerWorkflow(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.TriggerWorkflow")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    if h.batcher == nil {
+        return fiber.NewError(http.StatusNotFound, "trigger ingestion is not configured")
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    event := make(map[string]interface{})
+    if len(c.Body()) > 0 {
+        if err := c.BodyParser(&event); err != nil {
+            ext.Error.Set(span, true)
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+    }
+
+    // Ownership check against the draft, then resolve the revision that
+    // actually runs so drafts never execute and canary routing applies
+    if _, err := h.service.GetWorkflow(ctx, userID, workflowID); err != nil {
+        ext.Error.Set(span, true)
+        return ErrNotFound
+    }
+
+    workflow, err := h.service.GetPublishedWorkflow(ctx, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return ErrNotFound
+    }
+
+    if err := h.batcher.Submit(ctx, workflow, event); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to submit trigger event: %w", err)
+    }
+
+    return c.SendStatus(http.StatusAccepted)
+}
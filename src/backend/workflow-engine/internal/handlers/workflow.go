@@ -4,20 +4,26 @@ package handlers
 import (
     "context"
     "encoding/json"
+    "errors"
     "fmt"
     "net/http"
+    "net/url"
+    "strings"
     "time"
 
     "github.com/gofiber/fiber/v2" // v2.50.0
     "github.com/gofiber/fiber/v2/middleware/cache" // v2.50.0
-    "github.com/gofiber/fiber/v2/middleware/ratelimit" // v2.50.0
+    "github.com/gofiber/fiber/v2/middleware/limiter" // v2.50.0
     "github.com/go-playground/validator/v10" // v10.15.5
     "github.com/google/uuid" // v1.3.0
     "github.com/opentracing/opentracing-go" // v1.2.0
     "github.com/opentracing/opentracing-go/ext"
 
-    "workflow-engine/internal/models"
-    "workflow-engine/internal/services"
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/internal/services"
+    "github.com/organization/workflow-engine/pkg/httpquery"
+    "github.com/organization/workflow-engine/pkg/signing"
 )
 
 // Request validation constants
@@ -52,13 +58,27 @@ type WorkflowHandler struct {
     validator   *validator.Validate
     tracer      opentracing.Tracer
     cache       *cache.Config
-    rateLimiter *ratelimit.Config
+    rateLimiter *limiter.Config
+    stats       *core.ExecutionStatsTracker
+    batcher     *core.TriggerBatcher
+    signer      *signing.Signer
+    verifier    *signing.Verifier
+    reveal      *SecretRevealPolicy
+    sla         *core.SLAMonitor
+    canary      *core.CanaryController
+    scheduler   core.WorkflowScheduler
+}
+
+// SetStatsTracker enables pre-aggregated execution stats lookups through
+// this handler
+func (h *WorkflowHandler) SetStatsTracker(stats *core.ExecutionStatsTracker) {
+    h.stats = stats
 }
 
 // NewWorkflowHandler creates a new workflow handler instance
 func NewWorkflowHandler(service *services.WorkflowService, tracer opentracing.Tracer) *WorkflowHandler {
     // Initialize rate limiter
-    rateLimiter := &ratelimit.Config{
+    rateLimiter := &limiter.Config{
         Max:        rateLimit,
         Expiration: time.Minute,
         KeyGenerator: func(c *fiber.Ctx) string {
@@ -87,7 +107,7 @@ func (h *WorkflowHandler) CreateWorkflow(c *fiber.Ctx) error {
     defer span.Finish()
 
     // Apply rate limiting
-    if err := ratelimit.New(*h.rateLimiter)(c); err != nil {
+    if err := limiter.New(*h.rateLimiter)(c); err != nil {
         ext.Error.Set(span, true)
         span.SetTag("error", err.Error())
         return fiber.NewError(http.StatusTooManyRequests, "rate limit exceeded")
@@ -169,6 +189,391 @@ func (h *WorkflowHandler) CreateWorkflow(c *fiber.Ctx) error {
     return c.Status(http.StatusCreated).JSON(createdWorkflow)
 }
 
+// Pagination defaults for workflow listing
+const (
+    defaultPageSize = 20
+    maxPageSize     = 100
+)
+
+// GetWorkflow handles workflow retrieval with optional field projection via
+// the "fields" query parameter (comma-separated top-level JSON fields)
+func (h *WorkflowHandler) GetWorkflow(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.GetWorkflow")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    workflow, err := h.service.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        switch {
+        case err == services.ErrUnauthorized:
+            return ErrUnauthorized
+        default:
+            return ErrNotFound
+        }
+    }
+
+    // Content-based ETag for conditional requests
+    if hash, err := workflow.Hash(); err == nil {
+        etag := fmt.Sprintf("%q", hash)
+        c.Set("ETag", etag)
+        if c.Get("If-None-Match") == etag {
+            return c.SendStatus(http.StatusNotModified)
+        }
+    }
+
+    // Secret-marked config fields stay masked unless the caller was
+    // authorized for an audited reveal
+    reveal, err := h.resolveReveal(c, userID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return err
+    }
+
+    payload, err := serializeWorkflow(workflow, reveal, c.Query("fields"))
+    if err != nil {
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+    return c.Status(http.StatusOK).JSON(payload)
+}
+
+// ListWorkflows handles paginated workflow listing with optional field
+// projection, returning pagination metadata alongside the page
+func (h *WorkflowHandler) ListWorkflows(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.ListWorkflows")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    reveal, err := h.resolveReveal(c, userID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return err
+    }
+
+    // Cursor-based listing through the shared query helpers; the legacy
+    // page/page_size form stays supported below
+    if c.Query("cursor") != "" || c.Query("limit") != "" {
+        values, err := url.ParseQuery(string(c.Request().URI().QueryString()))
+        if err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+        params, err := httpquery.Parse(values, httpquery.Options{MaxLimit: maxPageSize})
+        if err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+
+        workflows, total, err := h.service.ListWorkflows(ctx, userID, params.Offset, params.Limit)
+        if err != nil {
+            ext.Error.Set(span, true)
+            span.SetTag("error", err.Error())
+            return fmt.Errorf("failed to list workflows: %w", err)
+        }
+
+        items := make([]interface{}, 0, len(workflows))
+        for _, workflow := range workflows {
+            payload, err := serializeWorkflow(workflow, reveal, c.Query("fields"))
+            if err != nil {
+                return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+            }
+            items = append(items, payload)
+        }
+
+        if link := params.LinkHeader(c.Path(), total); link != "" {
+            c.Set("Link", link)
+        }
+        return c.Status(http.StatusOK).JSON(fiber.Map{
+            "items":       items,
+            "limit":       params.Limit,
+            "total":       total,
+            "next_cursor": params.NextCursor(total),
+        })
+    }
+
+    page := c.QueryInt("page", 1)
+    if page < 1 {
+        page = 1
+    }
+    pageSize := c.QueryInt("page_size", defaultPageSize)
+    if pageSize < 1 || pageSize > maxPageSize {
+        pageSize = defaultPageSize
+    }
+
+    workflows, total, err := h.service.ListWorkflows(ctx, userID, (page-1)*pageSize, pageSize)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("failed to list workflows: %w", err)
+    }
+
+    items := make([]interface{}, 0, len(workflows))
+    for _, workflow := range workflows {
+        payload, err := serializeWorkflow(workflow, reveal, c.Query("fields"))
+        if err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+        items = append(items, payload)
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "items":     items,
+        "page":      page,
+        "page_size": pageSize,
+        "total":     total,
+    })
+}
+
+// UpdateWorkflowRequest represents the workflow update payload; omitted
+// fields are left unchanged
+type UpdateWorkflowRequest struct {
+    Name        *string                `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+    Description *string                `json:"description,omitempty" validate:"omitempty,max=500"`
+    Status      *string                `json:"status,omitempty"`
+    Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// UpdateWorkflow handles PUT /api/v1/workflows/:id requests, updating
+// workflow details; the node graph is managed through the node and
+// connection endpoints
+func (h *WorkflowHandler) UpdateWorkflow(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.UpdateWorkflow")
+    defer span.Finish()
+
+    userID, workflow, err := h.loadWorkflowForUpdate(c, ctx, span)
+    if err != nil {
+        return err
+    }
+
+    var req UpdateWorkflowRequest
+    if err := c.BodyParser(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+    if err := h.validator.Struct(&req); err != nil {
+        ext.Error.Set(span, true)
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    if req.Name != nil {
+        workflow.Name = *req.Name
+    }
+    if req.Description != nil {
+        workflow.Description = *req.Description
+    }
+    if req.Status != nil {
+        if err := workflow.UpdateStatus(*req.Status); err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+    }
+    if req.Metadata != nil {
+        if err := workflow.UpdateMetadata(req.Metadata); err != nil {
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+    }
+
+    if err := h.service.UpdateWorkflow(ctx, userID, workflow); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        switch {
+        case err == services.ErrUnauthorized:
+            return ErrUnauthorized
+        case errors.Is(err, services.ErrInvalidRequest):
+            return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        default:
+            return fmt.Errorf("failed to update workflow: %w", err)
+        }
+    }
+
+    reveal, err := h.resolveReveal(c, userID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return err
+    }
+    payload, err := serializeWorkflow(workflow, reveal, c.Query("fields"))
+    if err != nil {
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+    return c.Status(http.StatusOK).JSON(payload)
+}
+
+// DeleteWorkflow handles DELETE /api/v1/workflows/:id requests
+func (h *WorkflowHandler) DeleteWorkflow(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.DeleteWorkflow")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    if err := h.service.DeleteWorkflow(ctx, userID, workflowID); err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        switch {
+        case err == services.ErrUnauthorized:
+            return ErrUnauthorized
+        case errors.Is(err, services.ErrWorkflowNotFound):
+            return ErrNotFound
+        default:
+            return fmt.Errorf("failed to delete workflow: %w", err)
+        }
+    }
+
+    return c.SendStatus(http.StatusNoContent)
+}
+
+// GetWorkflowStatus handles GET /api/v1/workflows/:id/status requests,
+// returning the persisted workflow status
+func (h *WorkflowHandler) GetWorkflowStatus(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.GetWorkflowStatus")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    workflow, err := h.service.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        switch {
+        case err == services.ErrUnauthorized:
+            return ErrUnauthorized
+        default:
+            return ErrNotFound
+        }
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "workflow_id": workflow.ID,
+        "status":      workflow.Status,
+        "version":     workflow.Version,
+        "updated_at":  workflow.UpdatedAt,
+    })
+}
+
+// PublishWorkflow handles POST /api/v1/workflows/:id/publish requests,
+// snapshotting the draft into an immutable revision and activating it
+func (h *WorkflowHandler) PublishWorkflow(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.PublishWorkflow")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    revision, err := h.service.PublishWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        switch {
+        case err == services.ErrUnauthorized:
+            return ErrUnauthorized
+        case errors.Is(err, services.ErrWorkflowNotFound):
+            return ErrNotFound
+        default:
+            return fmt.Errorf("failed to publish workflow: %w", err)
+        }
+    }
+
+    return c.Status(http.StatusOK).JSON(fiber.Map{
+        "revision_id":  revision.ID,
+        "version":      revision.Version,
+        "hash":         revision.Hash,
+        "published_at": revision.PublishedAt,
+    })
+}
+
+// GetWorkflowStats handles GET /api/v1/workflows/:id/stats requests,
+// serving pre-aggregated execution stats maintained by the executor so
+// the UI avoids history scans
+func (h *WorkflowHandler) GetWorkflowStats(c *fiber.Ctx) error {
+    span, ctx := opentracing.StartSpanFromContext(c.Context(), "WorkflowHandler.GetWorkflowStats")
+    defer span.Finish()
+
+    userID, ok := c.Locals("userID").(uuid.UUID)
+    if !ok {
+        ext.Error.Set(span, true)
+        return ErrUnauthorized
+    }
+
+    if h.stats == nil {
+        return fiber.NewError(http.StatusNotFound, "execution stats are not configured")
+    }
+
+    workflowID, err := uuid.Parse(c.Params("id"))
+    if err != nil {
+        return fiber.NewError(http.StatusBadRequest, "invalid workflow ID")
+    }
+    span.SetTag("workflow_id", workflowID)
+
+    // Ownership check before exposing stats
+    if _, err := h.service.GetWorkflow(ctx, userID, workflowID); err != nil {
+        ext.Error.Set(span, true)
+        return ErrNotFound
+    }
+
+    stats, exists := h.stats.Stats(workflowID)
+    if !exists {
+        stats = core.WorkflowStats{WorkflowID: workflowID, Sparkline: []core.RunSample{}}
+    }
+
+    return c.Status(http.StatusOK).JSON(stats)
+}
+
+// projectFields reduces a serialized workflow document to the requested
+// top-level JSON fields
+func projectFields(document map[string]interface{}, fields []string) (map[string]interface{}, error) {
+    projected := make(map[string]interface{}, len(fields))
+    for _, field := range fields {
+        field = strings.TrimSpace(field)
+        value, exists := document[field]
+        if !exists {
+            return nil, fmt.Errorf("unknown field: %s", field)
+        }
+        projected[field] = value
+    }
+
+    return projected, nil
+}
+
 // validateWorkflowRequest performs comprehensive request validation
 func (h *WorkflowHandler) validateWorkflowRequest(req *CreateWorkflowRequest) error {
     if err := h.validator.Struct(req); err != nil {
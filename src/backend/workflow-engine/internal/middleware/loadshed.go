@@ -0,0 +1,115 @@
+// Package middleware provides HTTP middleware for the workflow engine API
+package middleware
+
+import (
+    "net/http"
+    "sync"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// Load-shedding defaults
+const (
+    // defaultShedThreshold is the engine saturation ratio above which
+    // non-critical requests are rejected
+    defaultShedThreshold = 0.9
+
+    // defaultRecheckInterval bounds how often saturation is recomputed
+    defaultRecheckInterval = time.Second
+)
+
+// Metrics collectors
+var (
+    shedRequestsTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_api_shed_requests_total",
+            Help: "Total number of requests rejected by load shedding",
+        },
+        []string{"path"},
+    )
+
+    engineSaturationGauge = prometheus.NewGauge(
+        prometheus.GaugeOpts{
+            Name: "workflow_engine_saturation_ratio",
+            Help: "Current engine saturation as active executions over capacity",
+        },
+    )
+
+    registerOnce sync.Once
+)
+
+// SaturationSource reports the engine's current and maximum execution counts
+type SaturationSource interface {
+    ActiveExecutions() int
+    MaxConcurrentExecutions() int
+}
+
+// LoadShedConfig holds configuration for the load-shedding middleware
+type LoadShedConfig struct {
+    // Threshold is the saturation ratio (0-1) above which requests are shed
+    Threshold float64
+    // ExemptPaths lists path prefixes that are never shed, such as health
+    // and metrics endpoints
+    ExemptPaths []string
+}
+
+// NewLoadShedder returns a Fiber middleware that rejects requests with 503
+// when engine saturation exceeds the configured threshold, protecting
+// in-flight executions from resource starvation
+func NewLoadShedder(source SaturationSource, config LoadShedConfig) fiber.Handler {
+    if config.Threshold <= 0 || config.Threshold > 1 {
+        config.Threshold = defaultShedThreshold
+    }
+    if config.ExemptPaths == nil {
+        config.ExemptPaths = []string{"/health", "/metrics"}
+    }
+
+    registerOnce.Do(func() {
+        prometheus.MustRegister(shedRequestsTotal)
+        prometheus.MustRegister(engineSaturationGauge)
+    })
+
+    var (
+        mu          sync.Mutex
+        lastCheck   time.Time
+        lastShedding bool
+    )
+
+    return func(c *fiber.Ctx) error {
+        for _, prefix := range config.ExemptPaths {
+            if len(c.Path()) >= len(prefix) && c.Path()[:len(prefix)] == prefix {
+                return c.Next()
+            }
+        }
+
+        mu.Lock()
+        if time.Since(lastCheck) >= defaultRecheckInterval {
+            saturation := saturationRatio(source)
+            engineSaturationGauge.Set(saturation)
+            lastShedding = saturation >= config.Threshold
+            lastCheck = time.Now()
+        }
+        shedding := lastShedding
+        mu.Unlock()
+
+        if shedding {
+            shedRequestsTotal.WithLabelValues(c.Path()).Inc()
+            c.Set("Retry-After", "5")
+            return fiber.NewError(http.StatusServiceUnavailable, "engine saturated, request shed")
+        }
+
+        return c.Next()
+    }
+}
+
+// saturationRatio computes the current engine saturation
+func saturationRatio(source SaturationSource) float64 {
+    max := source.MaxConcurrentExecutions()
+    if max <= 0 {
+        return 0
+    }
+
+    return float64(source.ActiveExecutions()) / float64(max)
+}
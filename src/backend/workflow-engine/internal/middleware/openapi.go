@@ -0,0 +1,281 @@
+// Package middleware provides HTTP middleware for the workflow engine API
+package middleware
+
+import (
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "strings"
+    "sync"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// FieldError is one payload validation failure, located by a JSON
+// Pointer (RFC 6901) into the request body
+type FieldError struct {
+    Pointer string `json:"pointer"`
+    Message string `json:"message"`
+}
+
+// operation is one method+path pattern with its request body schema
+type operation struct {
+    method   string
+    segments []string
+    schema   *models.ConfigSchema
+}
+
+// OpenAPIValidator validates request payloads against per-operation
+// schemas before they reach the handlers, reporting every field-level
+// failure instead of stopping at the first. The schemas use the same
+// JSON Schema subset node configuration schemas do, so nested node
+// config objects validate with precise pointers
+type OpenAPIValidator struct {
+    mu         sync.RWMutex
+    operations []operation
+}
+
+// NewOpenAPIValidator creates a validator preloaded with the spec for
+// the workflow API's write operations
+func NewOpenAPIValidator() *OpenAPIValidator {
+    v := &OpenAPIValidator{}
+
+    v.Register(http.MethodPost, "/api/v1/workflows", &models.ConfigSchema{
+        Title: "Create Workflow Request",
+        Properties: map[string]*models.SchemaProperty{
+            "name":        {Type: "string", Description: "Workflow name"},
+            "description": {Type: "string", Description: "Workflow description"},
+            "metadata":    {Type: "object", Description: "Arbitrary workflow metadata"},
+            "nodes": {
+                Type: "array",
+                Items: &models.SchemaProperty{
+                    Type: "object",
+                    Properties: map[string]*models.SchemaProperty{
+                        "type":   {Type: "string", Enum: []interface{}{"trigger", "action", "condition", "ai_task"}},
+                        "name":   {Type: "string"},
+                        "config": {Type: "object"},
+                    },
+                },
+            },
+        },
+        Required: []string{"name"},
+    })
+
+    v.Register(http.MethodPost, "/api/v1/workflows/:id/nodes", &models.ConfigSchema{
+        Title: "Create Node Request",
+        Properties: map[string]*models.SchemaProperty{
+            "type":   {Type: "string", Enum: []interface{}{"trigger", "action", "condition", "ai_task"}},
+            "name":   {Type: "string"},
+            "config": {Type: "object"},
+        },
+        Required: []string{"type", "name"},
+    })
+
+    v.Register(http.MethodPost, "/api/v1/workflows/:id/connections", &models.ConfigSchema{
+        Title: "Create Connection Request",
+        Properties: map[string]*models.SchemaProperty{
+            "source_node_id":  {Type: "string"},
+            "target_node_id":  {Type: "string"},
+            "connection_type": {Type: "string"},
+            "input_mappings": {
+                Type: "array",
+                Items: &models.SchemaProperty{
+                    Type: "object",
+                    Properties: map[string]*models.SchemaProperty{
+                        "source":   {Type: "string"},
+                        "target":   {Type: "string"},
+                        "required": {Type: "boolean"},
+                    },
+                },
+            },
+        },
+        Required: []string{"source_node_id", "target_node_id"},
+    })
+
+    return v
+}
+
+// Register adds or replaces the request body schema for an operation.
+// Path parameters use the :name form
+func (v *OpenAPIValidator) Register(method, path string, schema *models.ConfigSchema) {
+    v.mu.Lock()
+    defer v.mu.Unlock()
+
+    segments := strings.Split(strings.Trim(path, "/"), "/")
+    for i, op := range v.operations {
+        if op.method == method && strings.Join(op.segments, "/") == strings.Join(segments, "/") {
+            v.operations[i].schema = schema
+            return
+        }
+    }
+    v.operations = append(v.operations, operation{
+        method:   method,
+        segments: segments,
+        schema:   schema,
+    })
+}
+
+// Handler returns the fiber middleware enforcing the registered schemas
+func (v *OpenAPIValidator) Handler() fiber.Handler {
+    return func(c *fiber.Ctx) error {
+        schema := v.lookup(c.Method(), c.Path())
+        if schema == nil || len(c.Body()) == 0 {
+            return c.Next()
+        }
+
+        var payload map[string]interface{}
+        if err := json.Unmarshal(c.Body(), &payload); err != nil {
+            return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+                "error": "request body is not valid JSON",
+            })
+        }
+
+        failures := validateAgainstSchema(schema, payload)
+        if len(failures) > 0 {
+            return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+                "error":   "request validation failed",
+                "details": failures,
+            })
+        }
+
+        return c.Next()
+    }
+}
+
+// lookup finds the schema registered for the request's operation
+func (v *OpenAPIValidator) lookup(method, path string) *models.ConfigSchema {
+    v.mu.RLock()
+    defer v.mu.RUnlock()
+
+    segments := strings.Split(strings.Trim(path, "/"), "/")
+    for _, op := range v.operations {
+        if op.method != method || len(op.segments) != len(segments) {
+            continue
+        }
+        matched := true
+        for i, segment := range op.segments {
+            if strings.HasPrefix(segment, ":") {
+                continue
+            }
+            if segment != segments[i] {
+                matched = false
+                break
+            }
+        }
+        if matched {
+            return op.schema
+        }
+    }
+    return nil
+}
+
+// validateAgainstSchema walks the payload collecting every violation
+// rather than stopping at the first
+func validateAgainstSchema(schema *models.ConfigSchema, payload map[string]interface{}) []FieldError {
+    failures := make([]FieldError, 0)
+
+    for _, required := range schema.Required {
+        if _, ok := payload[required]; !ok {
+            failures = append(failures, FieldError{
+                Pointer: "/" + escapePointer(required),
+                Message: "required property is missing",
+            })
+        }
+    }
+
+    for key, value := range payload {
+        property, exists := schema.Properties[key]
+        if !exists {
+            // Unknown keys are allowed for forward compatibility
+            continue
+        }
+        failures = append(failures, validateProperty(property, "/"+escapePointer(key), value)...)
+    }
+
+    return failures
+}
+
+// validateProperty checks one value against its schema property,
+// recursing into objects and arrays with extended pointers
+func validateProperty(property *models.SchemaProperty, pointer string, value interface{}) []FieldError {
+    failures := make([]FieldError, 0)
+
+    if !matchesSchemaType(property.Type, value) {
+        return append(failures, FieldError{
+            Pointer: pointer,
+            Message: fmt.Sprintf("expected type %s, got %T", property.Type, value),
+        })
+    }
+
+    if len(property.Enum) > 0 {
+        allowed := false
+        for _, candidate := range property.Enum {
+            if candidate == value {
+                allowed = true
+                break
+            }
+        }
+        if !allowed {
+            failures = append(failures, FieldError{
+                Pointer: pointer,
+                Message: fmt.Sprintf("value %v is not one of the allowed values", value),
+            })
+        }
+    }
+
+    switch property.Type {
+    case "object":
+        nested, _ := value.(map[string]interface{})
+        for key, child := range property.Properties {
+            childValue, exists := nested[key]
+            if !exists {
+                continue
+            }
+            failures = append(failures, validateProperty(child, pointer+"/"+escapePointer(key), childValue)...)
+        }
+    case "array":
+        if property.Items != nil {
+            items, _ := value.([]interface{})
+            for i, item := range items {
+                failures = append(failures, validateProperty(property.Items, fmt.Sprintf("%s/%d", pointer, i), item)...)
+            }
+        }
+    }
+
+    return failures
+}
+
+// matchesSchemaType reports whether a decoded JSON value satisfies a
+// JSON Schema primitive type
+func matchesSchemaType(schemaType string, value interface{}) bool {
+    switch schemaType {
+    case "string":
+        _, ok := value.(string)
+        return ok
+    case "number":
+        _, ok := value.(float64)
+        return ok
+    case "integer":
+        v, ok := value.(float64)
+        return ok && v == float64(int64(v))
+    case "boolean":
+        _, ok := value.(bool)
+        return ok
+    case "object":
+        _, ok := value.(map[string]interface{})
+        return ok
+    case "array":
+        _, ok := value.([]interface{})
+        return ok
+    default:
+        return true
+    }
+}
+
+// escapePointer escapes a key for use in a JSON Pointer (RFC 6901)
+func escapePointer(key string) string {
+    key = strings.ReplaceAll(key, "~", "~0")
+    return strings.ReplaceAll(key, "/", "~1")
+}
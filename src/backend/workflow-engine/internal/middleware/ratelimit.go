@@ -0,0 +1,189 @@
+// Package middleware provides HTTP middleware for the workflow engine API
+package middleware
+
+import (
+    "context"
+    "net/http"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// Endpoint classes for rate limiting
+const (
+    // ClassDefault covers cheap reads and writes
+    ClassDefault = "default"
+
+    // ClassExpensive covers endpoints that start executions or ingest
+    // whole definitions
+    ClassExpensive = "expensive"
+)
+
+// Default endpoint limits
+var (
+    defaultLimitDefault   = EndpointLimit{PerMinute: 300, Burst: 50}
+    defaultLimitExpensive = EndpointLimit{PerMinute: 30, Burst: 5}
+)
+
+// expensiveSuffixes classify routes into the expensive class
+var expensiveSuffixes = []string{"/execute", "/import", "/trigger"}
+
+// Metrics collectors
+var (
+    rateLimitedTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_api_rate_limited_total",
+            Help: "Total number of requests rejected by rate limiting",
+        },
+        []string{"class"},
+    )
+
+    rateLimitRegisterOnce sync.Once
+)
+
+// EndpointLimit is a sustained rate with a burst allowance
+type EndpointLimit struct {
+    PerMinute int
+    Burst     int
+}
+
+// TenantLimitStore resolves per-tenant limit overrides; the Postgres
+// implementation reads the tenant settings table
+type TenantLimitStore interface {
+    GetRateLimit(ctx context.Context, tenant, class string) (EndpointLimit, bool, error)
+}
+
+// RateLimitConfig tunes the limiter; zero values apply the defaults
+type RateLimitConfig struct {
+    Default   EndpointLimit
+    Expensive EndpointLimit
+}
+
+// tokenBucket is one caller's refillable budget
+type tokenBucket struct {
+    tokens float64
+    last   time.Time
+}
+
+// SoftRateLimiter applies per-endpoint-class token bucket limits with
+// burst allowances. Expensive endpoints get stricter limits than cheap
+// reads, and tenants can carry overrides in the tenant settings table
+type SoftRateLimiter struct {
+    mu      sync.Mutex
+    buckets map[string]*tokenBucket
+    store   TenantLimitStore
+    config  RateLimitConfig
+}
+
+// NewSoftRateLimiter creates a limiter; a nil store disables overrides
+func NewSoftRateLimiter(store TenantLimitStore, config RateLimitConfig) *SoftRateLimiter {
+    if config.Default.PerMinute <= 0 {
+        config.Default = defaultLimitDefault
+    }
+    if config.Expensive.PerMinute <= 0 {
+        config.Expensive = defaultLimitExpensive
+    }
+
+    rateLimitRegisterOnce.Do(func() {
+        prometheus.MustRegister(rateLimitedTotal)
+    })
+
+    return &SoftRateLimiter{
+        buckets: make(map[string]*tokenBucket),
+        store:   store,
+        config:  config,
+    }
+}
+
+// Handler returns the fiber middleware enforcing the limits
+func (l *SoftRateLimiter) Handler() fiber.Handler {
+    return func(c *fiber.Ctx) error {
+        if !strings.HasPrefix(c.Path(), "/api/") {
+            return c.Next()
+        }
+
+        class := classifyEndpoint(c.Path())
+        tenant := c.Get("X-Tenant-ID", c.Get("X-API-Key", c.IP()))
+        limit := l.resolveLimit(c.Context(), tenant, class)
+
+        if !l.take(tenant+":"+class, limit) {
+            rateLimitedTotal.WithLabelValues(class).Inc()
+            c.Set("Retry-After", strconv.Itoa(retryAfterSeconds(limit)))
+            return fiber.NewError(http.StatusTooManyRequests, "rate limit exceeded")
+        }
+
+        return c.Next()
+    }
+}
+
+// classifyEndpoint maps a request path to its limit class
+func classifyEndpoint(path string) string {
+    for _, suffix := range expensiveSuffixes {
+        if strings.HasSuffix(path, suffix) {
+            return ClassExpensive
+        }
+    }
+    return ClassDefault
+}
+
+// resolveLimit returns the tenant's override for the class when one is
+// stored, falling back to the configured defaults
+func (l *SoftRateLimiter) resolveLimit(ctx context.Context, tenant, class string) EndpointLimit {
+    if l.store != nil {
+        if override, found, err := l.store.GetRateLimit(ctx, tenant, class); err == nil && found {
+            return override
+        }
+    }
+    if class == ClassExpensive {
+        return l.config.Expensive
+    }
+    return l.config.Default
+}
+
+// take withdraws one token from the caller's bucket, refilling it at the
+// sustained rate up to the burst allowance
+func (l *SoftRateLimiter) take(key string, limit EndpointLimit) bool {
+    burst := float64(limit.Burst)
+    if burst < 1 {
+        burst = 1
+    }
+    refillPerSecond := float64(limit.PerMinute) / 60
+
+    l.mu.Lock()
+    defer l.mu.Unlock()
+
+    now := time.Now()
+    bucket, exists := l.buckets[key]
+    if !exists {
+        bucket = &tokenBucket{tokens: burst, last: now}
+        l.buckets[key] = bucket
+    }
+
+    bucket.tokens += now.Sub(bucket.last).Seconds() * refillPerSecond
+    if bucket.tokens > burst {
+        bucket.tokens = burst
+    }
+    bucket.last = now
+
+    if bucket.tokens < 1 {
+        return false
+    }
+    bucket.tokens--
+    return true
+}
+
+// retryAfterSeconds estimates how long until one token is available
+func retryAfterSeconds(limit EndpointLimit) int {
+    if limit.PerMinute <= 0 {
+        return 60
+    }
+    seconds := 60 / limit.PerMinute
+    if seconds < 1 {
+        seconds = 1
+    }
+    return seconds
+}
@@ -0,0 +1,42 @@
+// Package middleware provides HTTP middleware for the workflow engine API
+package middleware
+
+import (
+    "net/http"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/services"
+)
+
+// SupportAccess returns middleware that swaps the request's effective
+// user when an admin presents a valid support grant in the
+// X-Support-Grant header. The original admin identity stays available
+// under the impersonator local, and every use is audited by the manager
+func SupportAccess(manager *services.SupportAccessManager) fiber.Handler {
+    return func(c *fiber.Ctx) error {
+        grantHeader := c.Get("X-Support-Grant")
+        if grantHeader == "" {
+            return c.Next()
+        }
+
+        grantID, err := uuid.Parse(grantHeader)
+        if err != nil {
+            return fiber.NewError(http.StatusBadRequest, "invalid support grant")
+        }
+        adminID, ok := c.Locals("userID").(uuid.UUID)
+        if !ok {
+            return fiber.NewError(http.StatusUnauthorized, "unauthorized")
+        }
+
+        userID, err := manager.Use(grantID, adminID, c.Path())
+        if err != nil {
+            return fiber.NewError(http.StatusForbidden, err.Error())
+        }
+
+        c.Locals("impersonator", adminID)
+        c.Locals("userID", userID)
+        return c.Next()
+    }
+}
@@ -0,0 +1,38 @@
+// Package middleware provides HTTP middleware for the workflow engine API
+package middleware
+
+import (
+    "context"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+)
+
+// tenantContextKey is the request-context key carrying the tenant ID
+const tenantContextKey = "tenancy.tenant_id"
+
+// Tenancy tags each request's context with the tenant from the
+// X-Tenant-ID header so downstream layers can route by tenant without
+// re-reading headers
+func Tenancy() fiber.Handler {
+    return func(c *fiber.Ctx) error {
+        if tenant := c.Get("X-Tenant-ID"); tenant != "" {
+            c.Context().SetUserValue(tenantContextKey, tenant)
+        }
+        return c.Next()
+    }
+}
+
+// WithTenant returns a context carrying the given tenant, for callers
+// outside the HTTP path such as scheduled executions
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+    // The key is a plain string because fasthttp user values, which carry
+    // the tenant on the HTTP path, are looked up by interface{} equality
+    return context.WithValue(ctx, tenantContextKey, tenantID)
+}
+
+// TenantFromContext returns the tenant a request was made for, empty
+// when it carried none
+func TenantFromContext(ctx context.Context) string {
+    tenant, _ := ctx.Value(tenantContextKey).(string)
+    return tenant
+}
@@ -0,0 +1,123 @@
+// Package models provides the core data models for the workflow engine
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid" // v1.3.0
+)
+
+// Approval request statuses
+const (
+	ApprovalPending  = "pending"
+	ApprovalApproved = "approved"
+	ApprovalRejected = "rejected"
+)
+
+// Common approval errors
+var (
+	ErrNotAnApprover   = errors.New("user is not in the approver list")
+	ErrAlreadyDecided  = errors.New("approval request has already been decided")
+	ErrSelfApproval    = errors.New("requester cannot approve their own change")
+	ErrNoApprovers     = errors.New("at least one approver is required")
+)
+
+// ApprovalRequest tracks a request to publish a specific workflow version,
+// forming the change-management audit record of who approved what and when
+type ApprovalRequest struct {
+	ID             uuid.UUID   `json:"id"`
+	WorkflowID     uuid.UUID   `json:"workflow_id"`
+	Version        int         `json:"version"`
+	Hash           string      `json:"hash"`
+	RequestedBy    uuid.UUID   `json:"requested_by"`
+	Approvers      []uuid.UUID `json:"approvers"`
+	Status         string      `json:"status"`
+	DecidedBy      uuid.UUID   `json:"decided_by,omitempty"`
+	DecisionReason string      `json:"decision_reason,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+	DecidedAt      time.Time   `json:"decided_at,omitempty"`
+
+	mu sync.Mutex // Protects concurrent decision attempts
+}
+
+// NewApprovalRequest creates a pending approval request for the current
+// version of a workflow
+func NewApprovalRequest(workflow *Workflow, requestedBy uuid.UUID, approvers []uuid.UUID) (*ApprovalRequest, error) {
+	if workflow == nil {
+		return nil, errors.New("workflow is required")
+	}
+	if requestedBy == uuid.Nil {
+		return nil, errors.New("requester ID is required")
+	}
+	if len(approvers) == 0 {
+		return nil, ErrNoApprovers
+	}
+
+	hash, err := workflow.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash workflow: %w", err)
+	}
+
+	return &ApprovalRequest{
+		ID:          uuid.New(),
+		WorkflowID:  workflow.ID,
+		Version:     workflow.Version,
+		Hash:        hash,
+		RequestedBy: requestedBy,
+		Approvers:   approvers,
+		Status:      ApprovalPending,
+		CreatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+// Approve records an approval decision by one of the listed approvers
+func (a *ApprovalRequest) Approve(approverID uuid.UUID, reason string) error {
+	return a.decide(approverID, ApprovalApproved, reason)
+}
+
+// Reject records a rejection decision by one of the listed approvers
+func (a *ApprovalRequest) Reject(approverID uuid.UUID, reason string) error {
+	return a.decide(approverID, ApprovalRejected, reason)
+}
+
+// decide applies an approval decision with authorization checks
+func (a *ApprovalRequest) decide(approverID uuid.UUID, status, reason string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.Status != ApprovalPending {
+		return fmt.Errorf("%w: status is %s", ErrAlreadyDecided, a.Status)
+	}
+	if approverID == a.RequestedBy {
+		return ErrSelfApproval
+	}
+
+	authorized := false
+	for _, approver := range a.Approvers {
+		if approver == approverID {
+			authorized = true
+			break
+		}
+	}
+	if !authorized {
+		return fmt.Errorf("%w: %s", ErrNotAnApprover, approverID)
+	}
+
+	a.Status = status
+	a.DecidedBy = approverID
+	a.DecisionReason = reason
+	a.DecidedAt = time.Now().UTC()
+	return nil
+}
+
+// IsApprovedFor reports whether this request approves publishing the given
+// workflow version, guarding against edits made after approval
+func (a *ApprovalRequest) IsApprovedFor(hash string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.Status == ApprovalApproved && a.Hash == hash
+}
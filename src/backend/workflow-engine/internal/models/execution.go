@@ -0,0 +1,210 @@
+// Package models provides the core data models for the workflow engine
+package models
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid" // v1.3.0
+	"sync"
+	"time"
+)
+
+// ExecutionStatusMap defines valid execution statuses
+var ExecutionStatusMap = map[string]bool{
+	"pending":   true,
+	"running":   true,
+	"completed": true,
+	"failed":    true,
+	"canceled":  true,
+}
+
+// TokenUsage accumulates the AI tokens a run consumed across its AI
+// task nodes, for usage metering and cost attribution
+type TokenUsage struct {
+	PromptTokens     int64   `json:"prompt_tokens"`
+	CompletionTokens int64   `json:"completion_tokens"`
+	TotalTokens      int64   `json:"total_tokens"`
+	CostUSD          float64 `json:"cost_usd,omitempty"`
+}
+
+// Common execution errors
+var (
+	ErrInvalidExecutionStatus = errors.New("invalid execution status")
+	ErrExecutionNotRunning    = errors.New("execution is not running")
+)
+
+// Execution represents a persisted record of a single workflow run with
+// thread-safe operations
+type Execution struct {
+	ID             uuid.UUID              `json:"id"`
+	WorkflowID     uuid.UUID              `json:"workflow_id"`
+	InstanceID     string                 `json:"instance_id"`
+	Status         string                 `json:"status"`
+	Checkpoint     map[string]interface{} `json:"checkpoint,omitempty"`
+	TraceContext   map[string]string      `json:"trace_context,omitempty"`
+	Error          string                 `json:"error,omitempty"`
+	DefinitionHash string                 `json:"definition_hash,omitempty"`
+	TokenUsage     *TokenUsage            `json:"token_usage,omitempty"`
+	RecoveryReason string                 `json:"recovery_reason,omitempty"`
+	RetryCount     int                    `json:"retry_count"`
+	StartedAt      time.Time              `json:"started_at"`
+	CompletedAt    time.Time              `json:"completed_at,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+
+	mu sync.RWMutex // Protects concurrent access to execution data
+}
+
+// NewExecution creates a new Execution instance for the given workflow
+func NewExecution(workflowID uuid.UUID, instanceID string) (*Execution, error) {
+	if workflowID == uuid.Nil {
+		return nil, errors.New("workflow ID is required")
+	}
+
+	now := time.Now().UTC()
+	execution := &Execution{
+		ID:         uuid.New(),
+		WorkflowID: workflowID,
+		InstanceID: instanceID,
+		Status:     "pending",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	return execution, nil
+}
+
+// Validate performs comprehensive validation of the execution record
+func (e *Execution) Validate() error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if !ExecutionStatusMap[e.Status] {
+		return fmt.Errorf("%w: %s", ErrInvalidExecutionStatus, e.Status)
+	}
+
+	if e.WorkflowID == uuid.Nil {
+		return errors.New("execution workflow ID is required")
+	}
+
+	return nil
+}
+
+// MarkRunning transitions the execution into the running state
+func (e *Execution) MarkRunning(instanceID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.Status = "running"
+	e.InstanceID = instanceID
+	e.StartedAt = time.Now().UTC()
+	e.UpdatedAt = e.StartedAt
+}
+
+// MarkCompleted transitions the execution into the completed state
+func (e *Execution) MarkCompleted() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.Status = "completed"
+	e.CompletedAt = time.Now().UTC()
+	e.UpdatedAt = e.CompletedAt
+}
+
+// MarkFailed transitions the execution into the failed state with a reason
+func (e *Execution) MarkFailed(reason string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.Status = "failed"
+	e.Error = reason
+	e.CompletedAt = time.Now().UTC()
+	e.UpdatedAt = e.CompletedAt
+}
+
+// SetDefinitionHash records the content hash of the workflow definition
+// this run executed, so operators can prove which definition produced it
+func (e *Execution) SetDefinitionHash(hash string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.DefinitionHash = hash
+	e.UpdatedAt = time.Now().UTC()
+}
+
+// AddTokenUsage accumulates one AI response's token counts and cost
+// onto the run's usage record
+func (e *Execution) AddTokenUsage(promptTokens, completionTokens int64, costUSD float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.TokenUsage == nil {
+		e.TokenUsage = &TokenUsage{}
+	}
+	e.TokenUsage.PromptTokens += promptTokens
+	e.TokenUsage.CompletionTokens += completionTokens
+	e.TokenUsage.TotalTokens += promptTokens + completionTokens
+	e.TokenUsage.CostUSD += costUSD
+	e.UpdatedAt = time.Now().UTC()
+}
+
+// GetDefinitionHash returns the recorded definition content hash
+func (e *Execution) GetDefinitionHash() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.DefinitionHash
+}
+
+// HasCheckpoint reports whether the execution has persisted checkpoint state
+// that allows it to be resumed
+func (e *Execution) HasCheckpoint() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return len(e.Checkpoint) > 0
+}
+
+// SetCheckpoint stores intermediate execution state for later resumption
+func (e *Execution) SetCheckpoint(checkpoint map[string]interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.Checkpoint = checkpoint
+	e.UpdatedAt = time.Now().UTC()
+}
+
+// SetTraceContext stores the serialized trace context of the span that
+// enqueued or scheduled this execution so the trace can be continued when
+// the run eventually starts
+func (e *Execution) SetTraceContext(carrier map[string]string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.TraceContext = carrier
+	e.UpdatedAt = time.Now().UTC()
+}
+
+// GetTraceContext returns a copy of the persisted trace context carrier
+func (e *Execution) GetTraceContext() map[string]string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	carrier := make(map[string]string, len(e.TraceContext))
+	for k, v := range e.TraceContext {
+		carrier[k] = v
+	}
+	return carrier
+}
+
+// GetCheckpoint returns a copy of the execution checkpoint state
+func (e *Execution) GetCheckpoint() map[string]interface{} {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	checkpoint := make(map[string]interface{}, len(e.Checkpoint))
+	for k, v := range e.Checkpoint {
+		checkpoint[k] = v
+	}
+	return checkpoint
+}
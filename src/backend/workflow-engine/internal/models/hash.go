@@ -0,0 +1,133 @@
+// Package models provides the core data models for the workflow engine
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid" // v1.3.0
+)
+
+// hashableNode is the stable projection of a node used for content
+// hashing; timestamps are deliberately excluded
+type hashableNode struct {
+	ID                uuid.UUID              `json:"id"`
+	Type              NodeType               `json:"type"`
+	Name              string                 `json:"name"`
+	Config            map[string]interface{} `json:"config"`
+	InputConnections  []string               `json:"input_connections"`
+	OutputConnections []string               `json:"output_connections"`
+	PositionX         int                    `json:"position_x"`
+	PositionY         int                    `json:"position_y"`
+}
+
+// hashableWorkflow is the stable projection of a workflow used for content
+// hashing; version and timestamps are deliberately excluded so that no-op
+// saves and re-imports produce identical hashes
+type hashableWorkflow struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Status      string                 `json:"status"`
+	Nodes       []hashableNode         `json:"nodes"`
+	Metadata    map[string]interface{} `json:"metadata"`
+}
+
+// Hash returns a deterministic content hash over the workflow's nodes,
+// connections, configuration, and metadata. Two workflows with identical
+// content hash identically regardless of when or in what order they were
+// built, making the hash suitable for ETags, idempotent imports, and
+// detecting updates that should not bump Version.
+func (w *Workflow) Hash() (string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.hashLocked("")
+}
+
+// MaskedHash returns the content hash of the workflow as it appears in a
+// masked export, with secret-marked config fields replaced by the given
+// placeholder. Signing a default export over this hash lets the recipient
+// verify the masked document they actually received.
+func (w *Workflow) MaskedHash(placeholder string) (string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.hashLocked(placeholder)
+}
+
+// hashLocked computes the content hash, masking secret config fields with
+// the placeholder when it is non-empty; callers must hold the lock
+func (w *Workflow) hashLocked(placeholder string) (string, error) {
+	projection := hashableWorkflow{
+		Name:        w.Name,
+		Description: w.Description,
+		Status:      w.Status,
+		Nodes:       make([]hashableNode, 0, len(w.Nodes)),
+		Metadata:    w.Metadata,
+	}
+
+	for _, node := range w.Nodes {
+		config := node.Config
+		if placeholder != "" {
+			config = maskedConfig(node.Type, config, placeholder)
+		}
+		projection.Nodes = append(projection.Nodes, hashableNode{
+			ID:                node.ID,
+			Type:              node.Type,
+			Name:              node.Name,
+			Config:            config,
+			InputConnections:  sortedConnectionIDs(node.GetInputConnections()),
+			OutputConnections: sortedConnectionIDs(node.GetOutputConnections()),
+			PositionX:         node.PositionX,
+			PositionY:         node.PositionY,
+		})
+	}
+
+	// Node order on the canvas is not semantically meaningful, so sort by
+	// ID to keep the hash stable across reorderings
+	sort.Slice(projection.Nodes, func(i, j int) bool {
+		return projection.Nodes[i].ID.String() < projection.Nodes[j].ID.String()
+	})
+
+	// json.Marshal emits map keys in sorted order, so the encoding of the
+	// config and metadata maps is deterministic
+	encoded, err := json.Marshal(projection)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal workflow for hashing: %w", err)
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// maskedConfig returns a copy of the config with secret-marked fields
+// replaced by the placeholder, leaving the original untouched
+func maskedConfig(nodeType NodeType, config map[string]interface{}, placeholder string) map[string]interface{} {
+	fields := SecretConfigFields(nodeType)
+	if len(fields) == 0 {
+		return config
+	}
+
+	masked := make(map[string]interface{}, len(config))
+	for key, value := range config {
+		masked[key] = value
+	}
+	for _, field := range fields {
+		if _, exists := masked[field]; exists {
+			masked[field] = placeholder
+		}
+	}
+	return masked
+}
+
+// sortedConnectionIDs returns the connection IDs as sorted strings so the
+// hash is independent of connection insertion order
+func sortedConnectionIDs(ids []uuid.UUID) []string {
+	sorted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		sorted = append(sorted, id.String())
+	}
+	sort.Strings(sorted)
+	return sorted
+}
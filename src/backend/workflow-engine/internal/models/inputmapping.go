@@ -0,0 +1,149 @@
+// Package models provides the core data models for the workflow engine
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid" // v1.3.0
+)
+
+// Common input mapping errors
+var (
+	ErrInvalidInputMapping  = errors.New("invalid input mapping")
+	ErrMissingRequiredInput = errors.New("missing required input")
+)
+
+// InputMapping routes one upstream output field to a downstream input
+// name on a connection. Defaults fill in when the upstream field is
+// absent, and required mappings fail the run instead of silently
+// dropping the field
+type InputMapping struct {
+	Source   string      `json:"source"`
+	Target   string      `json:"target"`
+	Default  interface{} `json:"default,omitempty"`
+	Required bool        `json:"required,omitempty"`
+}
+
+// SetInputMappings declares how the output of a connected source node
+// maps onto this node's input, replacing any previous declaration.
+// Mappings are validated at save time; an empty slice clears them
+func (n *Node) SetInputMappings(sourceNodeID uuid.UUID, mappings []InputMapping) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.hasInputConnection(sourceNodeID) {
+		return fmt.Errorf("%w: no input connection from %s", ErrInvalidInputMapping, sourceNodeID)
+	}
+	if err := validateInputMappings(mappings); err != nil {
+		return err
+	}
+
+	if len(mappings) == 0 {
+		delete(n.InputMappings, sourceNodeID.String())
+		n.UpdatedAt = time.Now().UTC()
+		return nil
+	}
+
+	if n.InputMappings == nil {
+		n.InputMappings = make(map[string][]InputMapping)
+	}
+	copied := make([]InputMapping, len(mappings))
+	copy(copied, mappings)
+	n.InputMappings[sourceNodeID.String()] = copied
+	n.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// GetInputMappings returns a copy of the mappings declared for a source
+// node, or nil when the connection passes its whole output through
+func (n *Node) GetInputMappings(sourceNodeID uuid.UUID) []InputMapping {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	mappings, exists := n.InputMappings[sourceNodeID.String()]
+	if !exists {
+		return nil
+	}
+	copied := make([]InputMapping, len(mappings))
+	copy(copied, mappings)
+	return copied
+}
+
+// MapInput applies the mappings declared for a source node to its
+// output, returning the downstream input fields. Connections without
+// mappings keep the previous behavior of passing the whole output map
+func (n *Node) MapInput(sourceNodeID uuid.UUID, output map[string]interface{}) (map[string]interface{}, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	mappings, exists := n.InputMappings[sourceNodeID.String()]
+	if !exists || len(mappings) == 0 {
+		return output, nil
+	}
+
+	input := make(map[string]interface{}, len(mappings))
+	for _, mapping := range mappings {
+		if value, present := output[mapping.Source]; present {
+			input[mapping.Target] = value
+			continue
+		}
+		if mapping.Default != nil {
+			input[mapping.Target] = mapping.Default
+			continue
+		}
+		if mapping.Required {
+			return nil, fmt.Errorf("%w: %s (mapped from %s)", ErrMissingRequiredInput, mapping.Target, mapping.Source)
+		}
+	}
+	return input, nil
+}
+
+// hasInputConnection reports whether the node receives input from the
+// given source. The caller must hold the node lock
+func (n *Node) hasInputConnection(sourceNodeID uuid.UUID) bool {
+	for _, id := range n.InputConnections {
+		if id == sourceNodeID {
+			return true
+		}
+	}
+	return false
+}
+
+// validateInputMappingsLocked checks that declared mappings reference
+// existing input connections and are internally consistent. The caller
+// must hold the node lock
+func (n *Node) validateInputMappingsLocked() error {
+	for key, mappings := range n.InputMappings {
+		sourceID, err := uuid.Parse(key)
+		if err != nil {
+			return fmt.Errorf("%w: invalid source node ID %q", ErrInvalidInputMapping, key)
+		}
+		if !n.hasInputConnection(sourceID) {
+			return fmt.Errorf("%w: no input connection from %s", ErrInvalidInputMapping, sourceID)
+		}
+		if err := validateInputMappings(mappings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateInputMappings checks one connection's mapping entries
+func validateInputMappings(mappings []InputMapping) error {
+	targets := make(map[string]bool, len(mappings))
+	for i, mapping := range mappings {
+		if mapping.Source == "" {
+			return fmt.Errorf("%w: entry %d has an empty source field", ErrInvalidInputMapping, i)
+		}
+		if mapping.Target == "" {
+			return fmt.Errorf("%w: entry %d has an empty target field", ErrInvalidInputMapping, i)
+		}
+		if targets[mapping.Target] {
+			return fmt.Errorf("%w: duplicate target field %q", ErrInvalidInputMapping, mapping.Target)
+		}
+		targets[mapping.Target] = true
+	}
+	return nil
+}
@@ -54,6 +54,8 @@ type Node struct {
 	Config           map[string]interface{} `json:"config"`
 	InputConnections []uuid.UUID           `json:"input_connections"`
 	OutputConnections []uuid.UUID          `json:"output_connections"`
+	ErrorConnections []uuid.UUID           `json:"error_connections,omitempty"`
+	InputMappings    map[string][]InputMapping `json:"input_mappings,omitempty"`
 	PositionX        int                   `json:"position_x"`
 	PositionY        int                   `json:"position_y"`
 	CreatedAt        time.Time             `json:"created_at"`
@@ -117,6 +119,10 @@ func (n *Node) Validate() error {
 		return ErrInvalidPosition
 	}
 
+	if err := n.validateInputMappingsLocked(); err != nil {
+		return fmt.Errorf("input mapping validation failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -170,6 +176,80 @@ func (n *Node) AddOutputConnection(targetNodeID uuid.UUID) error {
 	return nil
 }
 
+// AddErrorConnection adds an on-error connection with validation. Error
+// connections route execution to a fallback branch when this node fails
+func (n *Node) AddErrorConnection(targetNodeID uuid.UUID) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if targetNodeID == uuid.Nil {
+		return errors.New("invalid target node ID")
+	}
+
+	if len(n.ErrorConnections) >= MaxConnections {
+		return ErrConnectionLimit
+	}
+
+	// Check for duplicate connections
+	for _, conn := range n.ErrorConnections {
+		if conn == targetNodeID {
+			return ErrDuplicateConnection
+		}
+	}
+
+	n.ErrorConnections = append(n.ErrorConnections, targetNodeID)
+	n.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// RemoveErrorConnection removes an on-error connection if present
+func (n *Node) RemoveErrorConnection(targetNodeID uuid.UUID) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for i, conn := range n.ErrorConnections {
+		if conn == targetNodeID {
+			n.ErrorConnections = append(n.ErrorConnections[:i], n.ErrorConnections[i+1:]...)
+			n.UpdatedAt = time.Now().UTC()
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: no error connection to %s", ErrInvalidConnection, targetNodeID)
+}
+
+// RemoveInputConnection removes an input connection if present
+func (n *Node) RemoveInputConnection(sourceNodeID uuid.UUID) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for i, conn := range n.InputConnections {
+		if conn == sourceNodeID {
+			n.InputConnections = append(n.InputConnections[:i], n.InputConnections[i+1:]...)
+			n.UpdatedAt = time.Now().UTC()
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: no input connection from %s", ErrInvalidConnection, sourceNodeID)
+}
+
+// RemoveOutputConnection removes an output connection if present
+func (n *Node) RemoveOutputConnection(targetNodeID uuid.UUID) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for i, conn := range n.OutputConnections {
+		if conn == targetNodeID {
+			n.OutputConnections = append(n.OutputConnections[:i], n.OutputConnections[i+1:]...)
+			n.UpdatedAt = time.Now().UTC()
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: no output connection to %s", ErrInvalidConnection, targetNodeID)
+}
+
 // validateNodeType checks if the given node type is supported
 func validateNodeType(nodeType NodeType) error {
 	if !NodeTypeMap[nodeType] {
@@ -194,24 +274,13 @@ func validateNodeConfig(nodeType NodeType, config map[string]interface{}) error
 		return fmt.Errorf("%w: configuration exceeds size limit", ErrInvalidConfig)
 	}
 
-	// Type-specific validation
-	switch nodeType {
-	case TriggerNode:
-		if _, ok := config["trigger_type"]; !ok {
-			return fmt.Errorf("%w: trigger_type is required", ErrInvalidConfig)
-		}
-	case ActionNode:
-		if _, ok := config["action_type"]; !ok {
-			return fmt.Errorf("%w: action_type is required", ErrInvalidConfig)
-		}
-	case ConditionNode:
-		if _, ok := config["condition"]; !ok {
-			return fmt.Errorf("%w: condition is required", ErrInvalidConfig)
-		}
-	case AITaskNode:
-		if _, ok := config["ai_model"]; !ok {
-			return fmt.Errorf("%w: ai_model is required", ErrInvalidConfig)
-		}
+	// Type-specific validation against the registered schema
+	schema, err := GetNodeSchema(nodeType)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+	if err := schema.Validate(config); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidConfig, err)
 	}
 
 	return nil
@@ -237,6 +306,16 @@ func (n *Node) GetOutputConnections() []uuid.UUID {
 	return connections
 }
 
+// GetErrorConnections returns a copy of on-error connections
+func (n *Node) GetErrorConnections() []uuid.UUID {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	connections := make([]uuid.UUID, len(n.ErrorConnections))
+	copy(connections, n.ErrorConnections)
+	return connections
+}
+
 // UpdateConfig updates the node configuration with validation
 func (n *Node) UpdateConfig(config map[string]interface{}) error {
 	n.mu.Lock()
@@ -0,0 +1,101 @@
+// Package models provides the core data models for the workflow engine
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid" // v1.3.0
+)
+
+// Common prompt template errors
+var (
+	ErrEmptyPromptContent  = errors.New("prompt template content is empty")
+	ErrUndeclaredVariable  = errors.New("prompt references an undeclared variable")
+	ErrMissingVariable     = errors.New("prompt variable has no value")
+)
+
+// promptVariablePattern matches {{variable}} placeholders
+var promptVariablePattern = regexp.MustCompile(`\{\{\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+
+// PromptTemplate is one named, versioned prompt that AI task nodes
+// reference by ID and version, so prompts can be updated and audited
+// independently of workflow definitions. Versions are immutable; edits
+// create a new version
+type PromptTemplate struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Version     int       `json:"version"`
+	Content     string    `json:"content"`
+	Variables   []string  `json:"variables"`
+	CreatedBy   uuid.UUID `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// NewPromptTemplate creates the first version of a prompt template,
+// deriving the declared variables from the content's placeholders
+func NewPromptTemplate(name, description, content string, createdBy uuid.UUID) (*PromptTemplate, error) {
+	template := &PromptTemplate{
+		ID:          uuid.New(),
+		Name:        name,
+		Description: description,
+		Version:     1,
+		Content:     content,
+		CreatedBy:   createdBy,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+	template.Variables = ExtractPromptVariables(content)
+	return template, nil
+}
+
+// Validate checks the template's invariants
+func (t *PromptTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return errors.New("prompt template name is required")
+	}
+	if strings.TrimSpace(t.Content) == "" {
+		return ErrEmptyPromptContent
+	}
+	return nil
+}
+
+// Render substitutes the values into the template's placeholders,
+// failing when a placeholder has no value so half-rendered prompts
+// never reach a model
+func (t *PromptTemplate) Render(values map[string]string) (string, error) {
+	var missing []string
+	rendered := promptVariablePattern.ReplaceAllStringFunc(t.Content, func(match string) string {
+		name := promptVariablePattern.FindStringSubmatch(match)[1]
+		value, ok := values[name]
+		if !ok {
+			missing = append(missing, name)
+			return match
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrMissingVariable, strings.Join(missing, ", "))
+	}
+	return rendered, nil
+}
+
+// ExtractPromptVariables lists the distinct placeholder names in
+// content, in order of first appearance
+func ExtractPromptVariables(content string) []string {
+	seen := make(map[string]bool)
+	variables := make([]string, 0)
+	for _, match := range promptVariablePattern.FindAllStringSubmatch(content, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			variables = append(variables, match[1])
+		}
+	}
+	return variables
+}
@@ -0,0 +1,75 @@
+// Package models provides the core data models for the workflow engine
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid" // v1.3.0
+)
+
+// Common revision errors
+var (
+	ErrEmptySnapshot = errors.New("revision snapshot is empty")
+)
+
+// WorkflowRevision is an immutable snapshot of a workflow taken at publish
+// time. Scheduled and in-flight executions run against the latest revision
+// while users continue editing the live draft, which only takes effect on
+// the next publish.
+type WorkflowRevision struct {
+	ID          uuid.UUID       `json:"id"`
+	WorkflowID  uuid.UUID       `json:"workflow_id"`
+	Version     int             `json:"version"`
+	Hash        string          `json:"hash"`
+	Snapshot    json.RawMessage `json:"snapshot"`
+	PublishedBy uuid.UUID       `json:"published_by"`
+	PublishedAt time.Time       `json:"published_at"`
+}
+
+// NewWorkflowRevision snapshots the current state of a workflow into an
+// immutable revision record
+func NewWorkflowRevision(workflow *Workflow, publishedBy uuid.UUID) (*WorkflowRevision, error) {
+	if workflow == nil {
+		return nil, errors.New("workflow is required")
+	}
+	if publishedBy == uuid.Nil {
+		return nil, errors.New("publisher ID is required")
+	}
+
+	snapshot, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot workflow: %w", err)
+	}
+
+	hash, err := workflow.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash workflow: %w", err)
+	}
+
+	return &WorkflowRevision{
+		ID:          uuid.New(),
+		WorkflowID:  workflow.ID,
+		Version:     workflow.Version,
+		Hash:        hash,
+		Snapshot:    snapshot,
+		PublishedBy: publishedBy,
+		PublishedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Restore reconstructs the workflow as it existed at publish time
+func (r *WorkflowRevision) Restore() (*Workflow, error) {
+	if len(r.Snapshot) == 0 {
+		return nil, ErrEmptySnapshot
+	}
+
+	workflow := &Workflow{}
+	if err := json.Unmarshal(r.Snapshot, workflow); err != nil {
+		return nil, fmt.Errorf("failed to restore revision snapshot: %w", err)
+	}
+
+	return workflow, nil
+}
@@ -0,0 +1,220 @@
+// Package models provides the core data models for the workflow engine
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Common schema errors
+var (
+	ErrSchemaViolation = errors.New("node configuration does not match schema")
+	ErrUnknownSchema   = errors.New("no schema registered for node type")
+)
+
+// SchemaProperty describes a single field of a node configuration using a
+// JSON Schema-compatible subset, sufficient for validation and for the
+// frontend to generate configuration forms
+type SchemaProperty struct {
+	Type        string                     `json:"type"` // string, number, integer, boolean, object, array
+	Description string                     `json:"description,omitempty"`
+	Enum        []interface{}              `json:"enum,omitempty"`
+	Properties  map[string]*SchemaProperty `json:"properties,omitempty"`
+	Items       *SchemaProperty            `json:"items,omitempty"`
+	Secret      bool                       `json:"secret,omitempty"` // masked in API responses by default
+}
+
+// ConfigSchema describes the expected configuration for a node type
+type ConfigSchema struct {
+	Title      string                     `json:"title"`
+	Properties map[string]*SchemaProperty `json:"properties"`
+	Required   []string                   `json:"required"`
+}
+
+// schemaRegistry holds the configuration schema for each node type;
+// executors register richer schemas on top of the built-in defaults
+var (
+	schemaRegistry   = make(map[NodeType]*ConfigSchema)
+	schemaRegistryMu sync.RWMutex
+)
+
+func init() {
+	// Built-in schemas mirror the minimal requirements each node type has
+	// always enforced; executor packages replace these with full schemas
+	RegisterNodeSchema(TriggerNode, &ConfigSchema{
+		Title: "Trigger Node Configuration",
+		Properties: map[string]*SchemaProperty{
+			"trigger_type": {Type: "string", Description: "Type of trigger that starts the workflow"},
+		},
+		Required: []string{"trigger_type"},
+	})
+	RegisterNodeSchema(ActionNode, &ConfigSchema{
+		Title: "Action Node Configuration",
+		Properties: map[string]*SchemaProperty{
+			"action_type": {Type: "string", Description: "Type of action to perform"},
+		},
+		Required: []string{"action_type"},
+	})
+	RegisterNodeSchema(ConditionNode, &ConfigSchema{
+		Title: "Condition Node Configuration",
+		Properties: map[string]*SchemaProperty{
+			"condition": {Type: "string", Description: "Boolean expression evaluated against workflow data"},
+		},
+		Required: []string{"condition"},
+	})
+	RegisterNodeSchema(AITaskNode, &ConfigSchema{
+		Title: "AI Task Node Configuration",
+		Properties: map[string]*SchemaProperty{
+			"ai_model": {Type: "string", Description: "Identifier of the AI model to invoke"},
+			"api_key":  {Type: "string", Description: "Provider API key overriding the service default", Secret: true},
+		},
+		Required: []string{"ai_model"},
+	})
+}
+
+// RegisterNodeSchema registers or replaces the configuration schema for a
+// node type; executors call this during initialization
+func RegisterNodeSchema(nodeType NodeType, schema *ConfigSchema) {
+	schemaRegistryMu.Lock()
+	defer schemaRegistryMu.Unlock()
+
+	schemaRegistry[nodeType] = schema
+}
+
+// GetNodeSchema returns the configuration schema registered for a node
+// type, used by the API to drive configuration form generation
+func GetNodeSchema(nodeType NodeType) (*ConfigSchema, error) {
+	schemaRegistryMu.RLock()
+	defer schemaRegistryMu.RUnlock()
+
+	schema, exists := schemaRegistry[nodeType]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSchema, nodeType)
+	}
+	return schema, nil
+}
+
+// SecretConfigFields returns the configuration property names marked
+// secret for a node type, empty when no schema is registered
+func SecretConfigFields(nodeType NodeType) []string {
+	schemaRegistryMu.RLock()
+	defer schemaRegistryMu.RUnlock()
+
+	schema, exists := schemaRegistry[nodeType]
+	if !exists {
+		return nil
+	}
+
+	var fields []string
+	for name, property := range schema.Properties {
+		if property.Secret {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// Validate checks a configuration map against the schema, reporting the
+// offending path and expected type on failure
+func (s *ConfigSchema) Validate(config map[string]interface{}) error {
+	for _, required := range s.Required {
+		if _, ok := config[required]; !ok {
+			return fmt.Errorf("%w: missing required property %q", ErrSchemaViolation, required)
+		}
+	}
+
+	for key, value := range config {
+		property, exists := s.Properties[key]
+		if !exists {
+			// Unknown keys are allowed for forward compatibility
+			continue
+		}
+		if err := property.validate(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks a single value against a property definition, carrying
+// the JSON path for precise error reporting
+func (p *SchemaProperty) validate(path string, value interface{}) error {
+	if !matchesType(p.Type, value) {
+		return fmt.Errorf("%w: property %q expected type %s, got %T", ErrSchemaViolation, path, p.Type, value)
+	}
+
+	if len(p.Enum) > 0 {
+		allowed := false
+		for _, candidate := range p.Enum {
+			if candidate == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%w: property %q value %v is not one of the allowed values", ErrSchemaViolation, path, value)
+		}
+	}
+
+	switch p.Type {
+	case "object":
+		nested, _ := value.(map[string]interface{})
+		for key, child := range p.Properties {
+			childValue, exists := nested[key]
+			if !exists {
+				continue
+			}
+			if err := child.validate(path+"."+key, childValue); err != nil {
+				return err
+			}
+		}
+	case "array":
+		if p.Items != nil {
+			items, _ := value.([]interface{})
+			for i, item := range items {
+				if err := p.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchesType reports whether a decoded JSON value satisfies a JSON Schema
+// primitive type
+func matchesType(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		switch value.(type) {
+		case float64, float32, int, int64:
+			return true
+		}
+		return false
+	case "integer":
+		switch v := value.(type) {
+		case int, int64:
+			return true
+		case float64:
+			return v == float64(int64(v))
+		}
+		return false
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	default:
+		return true
+	}
+}
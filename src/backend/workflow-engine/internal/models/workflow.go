@@ -36,8 +36,9 @@ var (
 	ErrInvalidStatus           = errors.New("invalid workflow status")
 	ErrInvalidStatusTransition = errors.New("invalid workflow status transition")
 	ErrNoTriggerNode          = errors.New("workflow must have at least one trigger node")
-	ErrInvalidConnection      = errors.New("invalid node connection in workflow")
 	ErrMetadataTooLarge      = errors.New("workflow metadata exceeds size limit")
+	ErrSelfConnection        = errors.New("node cannot connect to itself")
+	ErrConnectionCycle       = errors.New("connection would create a cycle")
 )
 
 // Workflow represents a complete workflow definition with thread-safe operations
@@ -50,6 +51,7 @@ type Workflow struct {
 	Nodes         []*Node                `json:"nodes"`
 	Metadata      map[string]interface{} `json:"metadata"`
 	Version       int                    `json:"version"`
+	PublishedVersion int                 `json:"published_version"`
 	LastExecutedAt time.Time             `json:"last_executed_at"`
 	CreatedAt     time.Time             `json:"created_at"`
 	UpdatedAt     time.Time             `json:"updated_at"`
@@ -177,6 +179,203 @@ func (w *Workflow) AddNode(node *Node) error {
 	return nil
 }
 
+// RemoveNode removes a node from the workflow and detaches its connections
+// from the remaining nodes
+func (w *Workflow) RemoveNode(nodeID uuid.UUID) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	index := -1
+	for i, node := range w.Nodes {
+		if node.ID == nodeID {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return fmt.Errorf("%w: node %s not found", ErrInvalidConnection, nodeID)
+	}
+
+	w.Nodes = append(w.Nodes[:index], w.Nodes[index+1:]...)
+
+	// Detach dangling connections to the removed node
+	for _, node := range w.Nodes {
+		node.RemoveInputConnection(nodeID)
+		node.RemoveOutputConnection(nodeID)
+		node.RemoveErrorConnection(nodeID)
+	}
+
+	w.Version++
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Connect atomically links the source node's output to the target node's
+// input, validating that both nodes exist, the connection is not a
+// self-connection, and it would not introduce a cycle
+func (w *Workflow) Connect(sourceID, targetID uuid.UUID) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if sourceID == targetID {
+		return ErrSelfConnection
+	}
+
+	source, target, err := w.findConnectionEndpoints(sourceID, targetID)
+	if err != nil {
+		return err
+	}
+
+	if w.pathExists(targetID, sourceID) {
+		return fmt.Errorf("%w: %s is reachable from %s", ErrConnectionCycle, sourceID, targetID)
+	}
+
+	if err := source.AddOutputConnection(targetID); err != nil {
+		return err
+	}
+	if err := target.AddInputConnection(sourceID); err != nil {
+		// Roll back the half-applied connection to keep both sides in sync
+		source.RemoveOutputConnection(targetID)
+		return err
+	}
+
+	w.Version++
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Disconnect atomically removes the connection between the source node's
+// output and the target node's input
+func (w *Workflow) Disconnect(sourceID, targetID uuid.UUID) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	source, target, err := w.findConnectionEndpoints(sourceID, targetID)
+	if err != nil {
+		return err
+	}
+
+	if err := source.RemoveOutputConnection(targetID); err != nil {
+		return err
+	}
+	if err := target.RemoveInputConnection(sourceID); err != nil {
+		// Restore the output side so the connection stays consistent
+		source.AddOutputConnection(targetID)
+		return err
+	}
+
+	w.Version++
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// ConnectOnError links the source node's on-error output to the target
+// node so a failure in the source routes to a fallback branch. The target
+// deliberately gets no input connection: error edges are followed by the
+// executor's error routing only, so the fallback stays out of the success
+// graph and never runs when the source succeeds. The error branch must
+// not be able to loop back into the failed node
+func (w *Workflow) ConnectOnError(sourceID, targetID uuid.UUID) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if sourceID == targetID {
+		return ErrSelfConnection
+	}
+
+	source, _, err := w.findConnectionEndpoints(sourceID, targetID)
+	if err != nil {
+		return err
+	}
+
+	if w.pathExists(targetID, sourceID) {
+		return fmt.Errorf("%w: error branch from %s loops back into %s", ErrConnectionCycle, sourceID, sourceID)
+	}
+
+	if err := source.AddErrorConnection(targetID); err != nil {
+		return err
+	}
+
+	w.Version++
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// DisconnectOnError removes an on-error connection between the source and
+// target nodes
+func (w *Workflow) DisconnectOnError(sourceID, targetID uuid.UUID) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	source, _, err := w.findConnectionEndpoints(sourceID, targetID)
+	if err != nil {
+		return err
+	}
+
+	if err := source.RemoveErrorConnection(targetID); err != nil {
+		return err
+	}
+
+	w.Version++
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// findConnectionEndpoints resolves both endpoints of a connection; callers
+// must hold the workflow lock
+func (w *Workflow) findConnectionEndpoints(sourceID, targetID uuid.UUID) (*Node, *Node, error) {
+	var source, target *Node
+	for _, node := range w.Nodes {
+		switch node.ID {
+		case sourceID:
+			source = node
+		case targetID:
+			target = node
+		}
+	}
+
+	if source == nil {
+		return nil, nil, fmt.Errorf("%w: source node %s not found", ErrInvalidConnection, sourceID)
+	}
+	if target == nil {
+		return nil, nil, fmt.Errorf("%w: target node %s not found", ErrInvalidConnection, targetID)
+	}
+
+	return source, target, nil
+}
+
+// pathExists reports whether toID is reachable from fromID by following
+// output and on-error connections; callers must hold the workflow lock
+func (w *Workflow) pathExists(fromID, toID uuid.UUID) bool {
+	nodeMap := make(map[uuid.UUID]*Node, len(w.Nodes))
+	for _, node := range w.Nodes {
+		nodeMap[node.ID] = node
+	}
+
+	visited := make(map[uuid.UUID]bool)
+	stack := []uuid.UUID{fromID}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current == toID {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		if node, exists := nodeMap[current]; exists {
+			stack = append(stack, node.GetOutputConnections()...)
+			stack = append(stack, node.GetErrorConnections()...)
+		}
+	}
+
+	return false
+}
+
 // UpdateStatus updates the workflow status with transition validation
 func (w *Workflow) UpdateStatus(newStatus string) error {
 	w.mu.Lock()
@@ -210,6 +409,24 @@ func (w *Workflow) UpdateStatus(newStatus string) error {
 	return nil
 }
 
+// MarkPublished records that the current draft version has been published
+func (w *Workflow) MarkPublished() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.PublishedVersion = w.Version
+	w.UpdatedAt = time.Now().UTC()
+}
+
+// HasUnpublishedChanges reports whether the draft has been edited since
+// the last publish
+func (w *Workflow) HasUnpublishedChanges() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.Version > w.PublishedVersion
+}
+
 // UpdateMetadata updates the workflow metadata with size validation
 func (w *Workflow) UpdateMetadata(metadata map[string]interface{}) error {
 	w.mu.Lock()
@@ -230,6 +447,51 @@ func (w *Workflow) UpdateMetadata(metadata map[string]interface{}) error {
 	return nil
 }
 
+// UpdateLayout applies node positions and canvas metadata in one pass
+// without bumping Version or re-validating the workflow, so UI drag
+// operations stay cheap. Unknown node IDs are rejected before anything
+// is applied
+func (w *Workflow) UpdateLayout(positions map[uuid.UUID][2]int, canvas map[string]interface{}) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	nodes := make(map[uuid.UUID]*Node, len(w.Nodes))
+	for _, node := range w.Nodes {
+		nodes[node.ID] = node
+	}
+	for nodeID, position := range positions {
+		if _, exists := nodes[nodeID]; !exists {
+			return fmt.Errorf("%w: unknown node %s", ErrInvalidConnection, nodeID)
+		}
+		if position[0] < 0 || position[1] < 0 {
+			return fmt.Errorf("invalid position for node %s", nodeID)
+		}
+	}
+
+	for nodeID, position := range positions {
+		if err := nodes[nodeID].UpdatePosition(position[0], position[1]); err != nil {
+			return err
+		}
+	}
+
+	if canvas != nil {
+		canvasJSON, err := json.Marshal(canvas)
+		if err != nil {
+			return fmt.Errorf("failed to marshal canvas metadata: %w", err)
+		}
+		if len(canvasJSON) > MaxMetadataSize {
+			return ErrMetadataTooLarge
+		}
+		if w.Metadata == nil {
+			w.Metadata = make(map[string]interface{})
+		}
+		w.Metadata["canvas"] = canvas
+	}
+
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
 // UpdateLastExecuted updates the last execution timestamp
 func (w *Workflow) UpdateLastExecuted() {
 	w.mu.Lock()
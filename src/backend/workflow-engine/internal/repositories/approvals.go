@@ -0,0 +1,202 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "errors"
+    "fmt"
+
+    "github.com/google/uuid"
+    "github.com/lib/pq"
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common approval store errors
+var (
+    ErrApprovalNotFound = errors.New("approval request not found")
+)
+
+// SQL statements for the workflow approvals table
+const (
+    insertApprovalSQL = `
+        INSERT INTO workflow_approvals (id, workflow_id, version, hash, requested_by, approvers, status, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `
+    updateApprovalSQL = `
+        UPDATE workflow_approvals
+        SET status = $2, decided_by = $3, decision_reason = $4, decided_at = $5
+        WHERE id = $1
+    `
+    getApprovalSQL = `
+        SELECT id, workflow_id, version, hash, requested_by, approvers, status,
+               COALESCE(decided_by, '00000000-0000-0000-0000-000000000000'), COALESCE(decision_reason, ''), COALESCE(decided_at, 'epoch')
+        FROM workflow_approvals
+        WHERE id = $1
+    `
+    listApprovalsSQL = `
+        SELECT id, workflow_id, version, hash, requested_by, approvers, status,
+               COALESCE(decided_by, '00000000-0000-0000-0000-000000000000'), COALESCE(decision_reason, ''), COALESCE(decided_at, 'epoch')
+        FROM workflow_approvals
+        WHERE workflow_id = $1
+        ORDER BY created_at DESC
+    `
+)
+
+// PostgresApprovalStore persists workflow approval requests as the
+// change-management audit trail
+type PostgresApprovalStore struct {
+    db *sql.DB
+}
+
+// NewApprovalStore creates a new PostgreSQL-backed approval store
+func NewApprovalStore(cfg *config.DatabaseConfig) (*PostgresApprovalStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PostgresApprovalStore{db: db}, nil
+}
+
+// NewApprovalStoreWithDB creates an approval store over an existing connection pool
+func NewApprovalStoreWithDB(db *sql.DB) *PostgresApprovalStore {
+    return &PostgresApprovalStore{db: db}
+}
+
+// Save persists a new approval request
+func (s *PostgresApprovalStore) Save(ctx context.Context, request *models.ApprovalRequest) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    approvers := make([]string, 0, len(request.Approvers))
+    for _, approver := range request.Approvers {
+        approvers = append(approvers, approver.String())
+    }
+
+    _, err := s.db.ExecContext(ctx, insertApprovalSQL,
+        request.ID,
+        request.WorkflowID,
+        request.Version,
+        request.Hash,
+        request.RequestedBy,
+        pq.Array(approvers),
+        request.Status,
+        request.CreatedAt,
+    )
+    if err != nil {
+        return fmt.Errorf("failed to save approval request: %w", err)
+    }
+
+    return nil
+}
+
+// Update records an approval decision
+func (s *PostgresApprovalStore) Update(ctx context.Context, request *models.ApprovalRequest) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    result, err := s.db.ExecContext(ctx, updateApprovalSQL,
+        request.ID,
+        request.Status,
+        request.DecidedBy,
+        request.DecisionReason,
+        request.DecidedAt,
+    )
+    if err != nil {
+        return fmt.Errorf("failed to update approval request: %w", err)
+    }
+
+    affected, err := result.RowsAffected()
+    if err != nil {
+        return fmt.Errorf("failed to check update result: %w", err)
+    }
+    if affected == 0 {
+        return ErrApprovalNotFound
+    }
+
+    return nil
+}
+
+// Get returns a single approval request by ID
+func (s *PostgresApprovalStore) Get(ctx context.Context, id uuid.UUID) (*models.ApprovalRequest, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    return scanApproval(s.db.QueryRowContext(ctx, getApprovalSQL, id))
+}
+
+// ListByWorkflow returns the approval history of a workflow, newest first
+func (s *PostgresApprovalStore) ListByWorkflow(ctx context.Context, workflowID uuid.UUID) ([]*models.ApprovalRequest, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listApprovalsSQL, workflowID)
+    if err != nil {
+        return nil, fmt.Errorf("failed to query approval requests: %w", err)
+    }
+    defer rows.Close()
+
+    requests := make([]*models.ApprovalRequest, 0)
+    for rows.Next() {
+        request, err := scanApproval(rows)
+        if err != nil {
+            return nil, err
+        }
+        requests = append(requests, request)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("failed to iterate approval rows: %w", err)
+    }
+
+    return requests, nil
+}
+
+// Close closes the underlying database connection
+func (s *PostgresApprovalStore) Close() error {
+    return s.db.Close()
+}
+
+// rowScanner abstracts sql.Row and sql.Rows for shared scanning
+type rowScanner interface {
+    Scan(dest ...interface{}) error
+}
+
+// scanApproval scans a single approval request row
+func scanApproval(row rowScanner) (*models.ApprovalRequest, error) {
+    request := &models.ApprovalRequest{}
+    var approvers pq.StringArray
+
+    err := row.Scan(
+        &request.ID,
+        &request.WorkflowID,
+        &request.Version,
+        &request.Hash,
+        &request.RequestedBy,
+        &approvers,
+        &request.Status,
+        &request.DecidedBy,
+        &request.DecisionReason,
+        &request.DecidedAt,
+    )
+    if err == sql.ErrNoRows {
+        return nil, ErrApprovalNotFound
+    }
+    if err != nil {
+        return nil, fmt.Errorf("failed to scan approval row: %w", err)
+    }
+
+    request.Approvers = make([]uuid.UUID, 0, len(approvers))
+    for _, raw := range approvers {
+        approver, err := uuid.Parse(raw)
+        if err != nil {
+            return nil, fmt.Errorf("failed to parse approver ID: %w", err)
+        }
+        request.Approvers = append(request.Approvers, approver)
+    }
+
+    return request, nil
+}
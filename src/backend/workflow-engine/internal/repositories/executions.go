@@ -0,0 +1,311 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "encoding/json"
+    "fmt"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/encryption"
+)
+
+// SQL statements for the workflow_executions table
+const (
+    listExecutionsByStatusSQL = `
+        SELECT id, workflow_id, instance_id, status, checkpoint, trace_context,
+               error, definition_hash, recovery_reason, retry_count,
+               started_at, completed_at, created_at, updated_at
+        FROM workflow_executions
+        WHERE status = $1
+        ORDER BY started_at
+        LIMIT $2
+    `
+    insertExecutionSQL = `
+        INSERT INTO workflow_executions (
+            id, workflow_id, instance_id, status, checkpoint, trace_context,
+            error, definition_hash, recovery_reason, retry_count,
+            started_at, completed_at, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+    `
+    updateExecutionSQL = `
+        UPDATE workflow_executions SET
+            instance_id = $2,
+            status = $3,
+            checkpoint = $4,
+            trace_context = $5,
+            error = $6,
+            definition_hash = $7,
+            recovery_reason = $8,
+            retry_count = $9,
+            started_at = $10,
+            completed_at = $11,
+            updated_at = $12
+        WHERE id = $1
+    `
+)
+
+// envelopeMarkerKey marks a persisted value as envelope-encrypted; the
+// value under it is the serialized envelope
+const envelopeMarkerKey = "__envelope"
+
+// decodeEnvelope rehydrates an envelope stored inside a JSON document
+func decodeEnvelope(raw interface{}) (*encryption.Envelope, error) {
+    encoded, err := json.Marshal(raw)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read envelope: %w", err)
+    }
+    envelope := &encryption.Envelope{}
+    if err := json.Unmarshal(encoded, envelope); err != nil {
+        return nil, fmt.Errorf("failed to read envelope: %w", err)
+    }
+    return envelope, nil
+}
+
+// PostgresExecutionStore persists execution records so orphaned runs can be
+// recovered after an instance loss
+type PostgresExecutionStore struct {
+    db        *sql.DB
+    workflows *PostgresRepository
+    encryptor *encryption.EnvelopeEncryptor
+}
+
+// The store must satisfy the recovery manager's persistence contract
+// and the executor's lifecycle recording contract
+var (
+    _ core.ExecutionStore    = (*PostgresExecutionStore)(nil)
+    _ core.ExecutionRecorder = (*PostgresExecutionStore)(nil)
+)
+
+// NewExecutionStore creates a new PostgreSQL-backed execution store. Workflow
+// definitions are resolved through the workflow repository so recovered runs
+// re-execute against the same node graph the API serves
+func NewExecutionStore(cfg *config.DatabaseConfig, workflows *PostgresRepository) (*PostgresExecutionStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PostgresExecutionStore{db: db, workflows: workflows}, nil
+}
+
+// SetPayloadEncryptor enables envelope encryption of persisted
+// checkpoint payloads with per-tenant keys
+func (s *PostgresExecutionStore) SetPayloadEncryptor(encryptor *encryption.EnvelopeEncryptor) {
+    s.encryptor = encryptor
+}
+
+// sealCheckpoint envelope-encrypts a checkpoint payload when an
+// encryptor is configured, keyed by the tenant the run belongs to
+func (s *PostgresExecutionStore) sealCheckpoint(checkpoint map[string]interface{}) (map[string]interface{}, error) {
+    if s.encryptor == nil || len(checkpoint) == 0 {
+        return checkpoint, nil
+    }
+
+    tenant, _ := checkpoint["tenant_id"].(string)
+    envelope, err := s.encryptor.Encrypt(tenant, checkpoint)
+    if err != nil {
+        return nil, fmt.Errorf("failed to encrypt checkpoint: %w", err)
+    }
+    return map[string]interface{}{envelopeMarkerKey: envelope}, nil
+}
+
+// openCheckpoint decrypts an envelope-encrypted checkpoint in place;
+// plaintext checkpoints from before encryption was enabled pass through
+func (s *PostgresExecutionStore) openCheckpoint(execution *models.Execution) error {
+    raw, sealed := execution.Checkpoint[envelopeMarkerKey]
+    if !sealed {
+        return nil
+    }
+    if s.encryptor == nil {
+        return fmt.Errorf("execution %s checkpoint is encrypted but no payload encryptor is configured", execution.ID)
+    }
+
+    envelope, err := decodeEnvelope(raw)
+    if err != nil {
+        return err
+    }
+
+    checkpoint, err := s.encryptor.Decrypt(envelope)
+    if err != nil {
+        return fmt.Errorf("failed to decrypt checkpoint for execution %s: %w", execution.ID, err)
+    }
+    execution.Checkpoint = checkpoint
+    return nil
+}
+
+// ListByStatus returns up to limit executions in the given status
+func (s *PostgresExecutionStore) ListByStatus(ctx context.Context, status string, limit int) ([]*models.Execution, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listExecutionsByStatusSQL, status, limit)
+    if err != nil {
+        return nil, fmt.Errorf("failed to query executions: %w", err)
+    }
+    defer rows.Close()
+
+    executions := make([]*models.Execution, 0)
+    for rows.Next() {
+        execution, err := scanExecution(rows)
+        if err != nil {
+            return nil, err
+        }
+        if err := s.openCheckpoint(execution); err != nil {
+            return nil, err
+        }
+        executions = append(executions, execution)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("failed to iterate execution rows: %w", err)
+    }
+
+    return executions, nil
+}
+
+// Create persists a new execution record
+func (s *PostgresExecutionStore) Create(ctx context.Context, execution *models.Execution) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    sealed, err := s.sealCheckpoint(execution.Checkpoint)
+    if err != nil {
+        return err
+    }
+    checkpoint, err := json.Marshal(sealed)
+    if err != nil {
+        return fmt.Errorf("failed to marshal checkpoint: %w", err)
+    }
+    traceContext, err := json.Marshal(execution.TraceContext)
+    if err != nil {
+        return fmt.Errorf("failed to marshal trace context: %w", err)
+    }
+
+    _, err = s.db.ExecContext(ctx, insertExecutionSQL,
+        execution.ID,
+        execution.WorkflowID,
+        execution.InstanceID,
+        execution.Status,
+        checkpoint,
+        traceContext,
+        execution.Error,
+        execution.DefinitionHash,
+        execution.RecoveryReason,
+        execution.RetryCount,
+        nullableTime(execution.StartedAt),
+        nullableTime(execution.CompletedAt),
+        execution.CreatedAt,
+        time.Now().UTC(),
+    )
+    if err != nil {
+        return fmt.Errorf("failed to create execution: %w", err)
+    }
+
+    return nil
+}
+
+// Update persists the current state of an execution record
+func (s *PostgresExecutionStore) Update(ctx context.Context, execution *models.Execution) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    sealed, err := s.sealCheckpoint(execution.Checkpoint)
+    if err != nil {
+        return err
+    }
+    checkpoint, err := json.Marshal(sealed)
+    if err != nil {
+        return fmt.Errorf("failed to marshal checkpoint: %w", err)
+    }
+    traceContext, err := json.Marshal(execution.TraceContext)
+    if err != nil {
+        return fmt.Errorf("failed to marshal trace context: %w", err)
+    }
+
+    _, err = s.db.ExecContext(ctx, updateExecutionSQL,
+        execution.ID,
+        execution.InstanceID,
+        execution.Status,
+        checkpoint,
+        traceContext,
+        execution.Error,
+        execution.DefinitionHash,
+        execution.RecoveryReason,
+        execution.RetryCount,
+        nullableTime(execution.StartedAt),
+        nullableTime(execution.CompletedAt),
+        time.Now().UTC(),
+    )
+    if err != nil {
+        return fmt.Errorf("failed to update execution: %w", err)
+    }
+
+    return nil
+}
+
+// GetWorkflow resolves the workflow definition backing an execution
+func (s *PostgresExecutionStore) GetWorkflow(ctx context.Context, id uuid.UUID) (*models.Workflow, error) {
+    return s.workflows.Get(ctx, id)
+}
+
+// Close closes the underlying database connection
+func (s *PostgresExecutionStore) Close() error {
+    return s.db.Close()
+}
+
+// scanExecution hydrates one execution row
+func scanExecution(rows *sql.Rows) (*models.Execution, error) {
+    execution := &models.Execution{}
+    var checkpoint, traceContext []byte
+    var startedAt, completedAt sql.NullTime
+
+    if err := rows.Scan(
+        &execution.ID,
+        &execution.WorkflowID,
+        &execution.InstanceID,
+        &execution.Status,
+        &checkpoint,
+        &traceContext,
+        &execution.Error,
+        &execution.DefinitionHash,
+        &execution.RecoveryReason,
+        &execution.RetryCount,
+        &startedAt,
+        &completedAt,
+        &execution.CreatedAt,
+        &execution.UpdatedAt,
+    ); err != nil {
+        return nil, fmt.Errorf("failed to scan execution row: %w", err)
+    }
+
+    if len(checkpoint) > 0 {
+        if err := json.Unmarshal(checkpoint, &execution.Checkpoint); err != nil {
+            return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+        }
+    }
+    if len(traceContext) > 0 {
+        if err := json.Unmarshal(traceContext, &execution.TraceContext); err != nil {
+            return nil, fmt.Errorf("failed to unmarshal trace context: %w", err)
+        }
+    }
+    if startedAt.Valid {
+        execution.StartedAt = startedAt.Time
+    }
+    if completedAt.Valid {
+        execution.CompletedAt = completedAt.Time
+    }
+
+    return execution, nil
+}
+
+// nullableTime maps zero times to SQL NULL
+func nullableTime(t time.Time) sql.NullTime {
+    return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
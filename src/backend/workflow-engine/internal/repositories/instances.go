@@ -0,0 +1,140 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "errors"
+    "fmt"
+    "time"
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// Common instance store errors
+var (
+    ErrInstanceStoreClosed = errors.New("instance store is closed")
+)
+
+// SQL statements for the instances table
+const (
+    upsertInstanceSQL = `
+        INSERT INTO engine_instances (id, hostname, version, active_executions, scheduler_leader, started_at, last_heartbeat)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (id) DO UPDATE SET
+            active_executions = EXCLUDED.active_executions,
+            scheduler_leader = EXCLUDED.scheduler_leader,
+            last_heartbeat = EXCLUDED.last_heartbeat
+    `
+    listInstancesSQL = `
+        SELECT id, hostname, version, active_executions, scheduler_leader, started_at, last_heartbeat
+        FROM engine_instances
+        ORDER BY started_at
+    `
+    deleteInstanceSQL = `
+        DELETE FROM engine_instances WHERE id = $1
+    `
+)
+
+// PostgresInstanceStore persists engine instance registrations and heartbeats
+type PostgresInstanceStore struct {
+    db *sql.DB
+}
+
+// NewInstanceStore creates a new PostgreSQL-backed instance store
+func NewInstanceStore(cfg *config.DatabaseConfig) (*PostgresInstanceStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PostgresInstanceStore{db: db}, nil
+}
+
+// NewInstanceStoreWithDB creates an instance store over an existing connection pool
+func NewInstanceStoreWithDB(db *sql.DB) *PostgresInstanceStore {
+    return &PostgresInstanceStore{db: db}
+}
+
+// Stats reports connection pool statistics for capacity monitoring
+func (s *PostgresInstanceStore) Stats() sql.DBStats {
+    return s.db.Stats()
+}
+
+// Upsert registers or refreshes an instance record
+func (s *PostgresInstanceStore) Upsert(ctx context.Context, record *core.InstanceRecord) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    _, err := s.db.ExecContext(ctx, upsertInstanceSQL,
+        record.ID,
+        record.Hostname,
+        record.Version,
+        record.ActiveExecutions,
+        record.SchedulerLeader,
+        record.StartedAt,
+        record.LastHeartbeat,
+    )
+    if err != nil {
+        return fmt.Errorf("failed to upsert instance: %w", err)
+    }
+
+    return nil
+}
+
+// List returns all registered instance records
+func (s *PostgresInstanceStore) List(ctx context.Context) ([]*core.InstanceRecord, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listInstancesSQL)
+    if err != nil {
+        return nil, fmt.Errorf("failed to query instances: %w", err)
+    }
+    defer rows.Close()
+
+    records := make([]*core.InstanceRecord, 0)
+    for rows.Next() {
+        record := &core.InstanceRecord{}
+        var startedAt, lastHeartbeat time.Time
+        if err := rows.Scan(
+            &record.ID,
+            &record.Hostname,
+            &record.Version,
+            &record.ActiveExecutions,
+            &record.SchedulerLeader,
+            &startedAt,
+            &lastHeartbeat,
+        ); err != nil {
+            return nil, fmt.Errorf("failed to scan instance row: %w", err)
+        }
+        record.StartedAt = startedAt
+        record.LastHeartbeat = lastHeartbeat
+        records = append(records, record)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("failed to iterate instance rows: %w", err)
+    }
+
+    return records, nil
+}
+
+// Delete removes an instance record on graceful shutdown
+func (s *PostgresInstanceStore) Delete(ctx context.Context, instanceID string) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    _, err := s.db.ExecContext(ctx, deleteInstanceSQL, instanceID)
+    if err != nil {
+        return fmt.Errorf("failed to delete instance: %w", err)
+    }
+
+    return nil
+}
+
+// Close closes the underlying database connection
+func (s *PostgresInstanceStore) Close() error {
+    return s.db.Close()
+}
@@ -0,0 +1,131 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "fmt"
+    "time"
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/pkg/encryption"
+)
+
+// SQL statements for the encryption key tables
+const (
+    upsertTenantKeySQL = `
+        INSERT INTO tenant_keys (tenant_id, kek_version, wrapped_dek, updated_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (tenant_id)
+        DO UPDATE SET kek_version = $2, wrapped_dek = $3, updated_at = $4
+    `
+    insertKEKSQL = `
+        INSERT INTO encryption_keks (version, sealed_kek, created_at)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (version) DO NOTHING
+    `
+    listTenantKeysSQL = `SELECT tenant_id, kek_version, wrapped_dek FROM tenant_keys`
+    listKEKsSQL       = `SELECT version, sealed_kek FROM encryption_keks ORDER BY version`
+)
+
+// PostgresKeyStore persists wrapped tenant DEKs and rotated KEKs so
+// envelopes written before a restart or by another replica stay
+// decryptable
+type PostgresKeyStore struct {
+    db *sql.DB
+}
+
+// The store must satisfy the key manager's persistence contract
+var _ encryption.KeyStore = (*PostgresKeyStore)(nil)
+
+// NewKeyStore creates a new PostgreSQL-backed encryption key store
+func NewKeyStore(cfg *config.DatabaseConfig) (*PostgresKeyStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PostgresKeyStore{db: db}, nil
+}
+
+// SaveTenantKey upserts a tenant's wrapped DEK
+func (s *PostgresKeyStore) SaveTenantKey(ctx context.Context, key encryption.StoredTenantKey) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    _, err := s.db.ExecContext(ctx, upsertTenantKeySQL,
+        key.TenantID, key.KEKVersion, key.WrappedDEK, time.Now().UTC())
+    if err != nil {
+        return fmt.Errorf("failed to save tenant key: %w", err)
+    }
+    return nil
+}
+
+// SaveKEK persists a sealed KEK version
+func (s *PostgresKeyStore) SaveKEK(ctx context.Context, kek encryption.StoredKEK) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    _, err := s.db.ExecContext(ctx, insertKEKSQL, kek.Version, kek.SealedKEK, time.Now().UTC())
+    if err != nil {
+        return fmt.Errorf("failed to save KEK: %w", err)
+    }
+    return nil
+}
+
+// LoadKEKs returns every persisted KEK version
+func (s *PostgresKeyStore) LoadKEKs(ctx context.Context) ([]encryption.StoredKEK, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listKEKsSQL)
+    if err != nil {
+        return nil, fmt.Errorf("failed to load KEKs: %w", err)
+    }
+    defer rows.Close()
+
+    keks := make([]encryption.StoredKEK, 0)
+    for rows.Next() {
+        var kek encryption.StoredKEK
+        if err := rows.Scan(&kek.Version, &kek.SealedKEK); err != nil {
+            return nil, fmt.Errorf("failed to scan KEK row: %w", err)
+        }
+        keks = append(keks, kek)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("failed to iterate KEK rows: %w", err)
+    }
+    return keks, nil
+}
+
+// LoadTenantKeys returns every persisted wrapped tenant DEK
+func (s *PostgresKeyStore) LoadTenantKeys(ctx context.Context) ([]encryption.StoredTenantKey, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listTenantKeysSQL)
+    if err != nil {
+        return nil, fmt.Errorf("failed to load tenant keys: %w", err)
+    }
+    defer rows.Close()
+
+    keys := make([]encryption.StoredTenantKey, 0)
+    for rows.Next() {
+        var key encryption.StoredTenantKey
+        if err := rows.Scan(&key.TenantID, &key.KEKVersion, &key.WrappedDEK); err != nil {
+            return nil, fmt.Errorf("failed to scan tenant key row: %w", err)
+        }
+        keys = append(keys, key)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("failed to iterate tenant key rows: %w", err)
+    }
+    return keys, nil
+}
+
+// Close releases the store's database resources
+func (s *PostgresKeyStore) Close() error {
+    return s.db.Close()
+}
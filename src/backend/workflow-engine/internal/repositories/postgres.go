@@ -9,12 +9,15 @@ import (
     "fmt"
     "time"
     
-    "github.com/lib/pq" // v1.10.9
+    "github.com/google/uuid" // v1.3.0
+    _ "github.com/lib/pq" // v1.10.9 - registers the postgres driver
     "github.com/sony/gobreaker" // v2.1.0
     "github.com/avast/retry-go" // v3.0.0
     
-    "internal/config"
-    "internal/models"
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/chaos"
+    "github.com/organization/workflow-engine/pkg/encryption"
 )
 
 // Common errors
@@ -44,6 +47,49 @@ const (
         INSERT INTO node_connections (source_node_id, target_node_id, type, created_at)
         VALUES ($1, $2, $3, $4)
     `
+    getWorkflowSQL = `
+        SELECT id, user_id, name, description, status, metadata, version, created_at, updated_at
+        FROM workflows
+        WHERE id = $1
+    `
+    listWorkflowsSQL = `
+        SELECT id, user_id, name, description, status, metadata, version, created_at, updated_at
+        FROM workflows
+        WHERE user_id = $1
+        ORDER BY created_at DESC
+        OFFSET $2 LIMIT $3
+    `
+    countWorkflowsSQL = `
+        SELECT COUNT(*) FROM workflows WHERE user_id = $1
+    `
+    getNodesSQL = `
+        SELECT id, type, name, config, position_x, position_y, created_at, updated_at
+        FROM workflow_nodes
+        WHERE workflow_id = $1
+    `
+    getConnectionsSQL = `
+        SELECT c.source_node_id, c.target_node_id, c.type
+        FROM node_connections c
+        JOIN workflow_nodes n ON n.id = c.source_node_id
+        WHERE n.workflow_id = $1
+    `
+    updateWorkflowSQL = `
+        UPDATE workflows
+        SET name = $2, description = $3, status = $4, metadata = $5, version = $6, updated_at = $7
+        WHERE id = $1
+    `
+    deleteNodesSQL       = `DELETE FROM workflow_nodes WHERE workflow_id = $1`
+    deleteConnectionsSQL = `
+        DELETE FROM node_connections
+        WHERE source_node_id IN (SELECT id FROM workflow_nodes WHERE workflow_id = $1)
+    `
+    deleteWorkflowSQL = `DELETE FROM workflows WHERE id = $1`
+)
+
+// Connection type discriminators for the node_connections table
+const (
+    connectionTypeStandard = "standard"
+    connectionTypeError    = "error"
 )
 
 // PostgresRepository provides an enterprise-grade PostgreSQL implementation
@@ -52,6 +98,7 @@ type PostgresRepository struct {
     breaker       *gobreaker.CircuitBreaker
     preparedStmts map[string]*sql.Stmt
     cfg           *config.DatabaseConfig
+    secrets       *encryption.EnvelopeEncryptor
 }
 
 // NewPostgresRepository creates a new PostgreSQL repository instance
@@ -149,9 +196,23 @@ func (r *PostgresRepository) prepareStatements() error {
     return nil
 }
 
+// withBreaker adapts an error-only operation to the circuit breaker's
+// value-returning contract
+func (r *PostgresRepository) withBreaker(op func() error) error {
+    _, err := r.breaker.Execute(func() (interface{}, error) {
+        return nil, op()
+    })
+    return err
+}
+
 // CreateWorkflow persists a new workflow with its nodes
 func (r *PostgresRepository) CreateWorkflow(ctx context.Context, workflow *models.Workflow) error {
-    return r.breaker.Execute(func() error {
+    return r.withBreaker(func() error {
+        // Fault injection for resilience testing; no-op unless configured
+        if err := chaos.Inject(chaos.TargetRepository); err != nil {
+            return err
+        }
+
         // Start transaction
         tx, err := r.db.BeginTx(ctx, &sql.TxOptions{
             Isolation: sql.LevelSerializable,
@@ -184,7 +245,7 @@ func (r *PostgresRepository) CreateWorkflow(ctx context.Context, workflow *model
 
         // Insert nodes
         for _, node := range workflow.GetNodes() {
-            config, err := json.Marshal(node.Config)
+            config, err := r.marshalNodeConfig(ctx, node)
             if err != nil {
                 return fmt.Errorf("failed to marshal node config: %w", err)
             }
@@ -204,17 +265,10 @@ func (r *PostgresRepository) CreateWorkflow(ctx context.Context, workflow *model
                 return fmt.Errorf("failed to insert node: %w", err)
             }
 
-            // Insert node connections
-            for _, targetID := range node.GetOutputConnections() {
-                _, err = tx.StmtContext(ctx, r.preparedStmts["createNodeConnection"]).ExecContext(ctx,
-                    node.ID,
-                    targetID,
-                    "standard",
-                    time.Now().UTC(),
-                )
-                if err != nil {
-                    return fmt.Errorf("failed to insert node connection: %w", err)
-                }
+            // Insert node connections; error edges carry their own type
+            // so loads keep them out of the success graph
+            if err := r.insertNodeConnections(ctx, tx, node); err != nil {
+                return err
             }
         }
 
@@ -227,6 +281,333 @@ func (r *PostgresRepository) CreateWorkflow(ctx context.Context, workflow *model
     })
 }
 
+// Create satisfies the services.WorkflowRepository contract
+func (r *PostgresRepository) Create(ctx context.Context, workflow *models.Workflow) error {
+    return r.CreateWorkflow(ctx, workflow)
+}
+
+// Get retrieves a workflow with its nodes and connections
+func (r *PostgresRepository) Get(ctx context.Context, id uuid.UUID) (*models.Workflow, error) {
+    var workflow *models.Workflow
+    err := r.withBreaker(func() error {
+        if err := chaos.Inject(chaos.TargetRepository); err != nil {
+            return err
+        }
+
+        row := r.db.QueryRowContext(ctx, getWorkflowSQL, id)
+        loaded, err := scanWorkflow(row)
+        if err != nil {
+            if errors.Is(err, sql.ErrNoRows) {
+                return ErrWorkflowNotFound
+            }
+            return fmt.Errorf("failed to load workflow: %w", err)
+        }
+
+        if err := r.loadNodes(ctx, loaded); err != nil {
+            return err
+        }
+
+        workflow = loaded
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+    return workflow, nil
+}
+
+// List retrieves a page of a user's workflows ordered by creation time,
+// returning the page and the user's total workflow count
+func (r *PostgresRepository) List(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.Workflow, int, error) {
+    var workflows []*models.Workflow
+    var total int
+    err := r.withBreaker(func() error {
+        if err := chaos.Inject(chaos.TargetRepository); err != nil {
+            return err
+        }
+
+        if err := r.db.QueryRowContext(ctx, countWorkflowsSQL, userID).Scan(&total); err != nil {
+            return fmt.Errorf("failed to count workflows: %w", err)
+        }
+
+        rows, err := r.db.QueryContext(ctx, listWorkflowsSQL, userID, offset, limit)
+        if err != nil {
+            return fmt.Errorf("failed to list workflows: %w", err)
+        }
+        defer rows.Close()
+
+        for rows.Next() {
+            workflow, err := scanWorkflow(rows)
+            if err != nil {
+                return fmt.Errorf("failed to scan workflow: %w", err)
+            }
+            if err := r.loadNodes(ctx, workflow); err != nil {
+                return err
+            }
+            workflows = append(workflows, workflow)
+        }
+        return rows.Err()
+    })
+    if err != nil {
+        return nil, 0, err
+    }
+    return workflows, total, nil
+}
+
+// Update persists changes to an existing workflow, replacing its node
+// graph atomically
+func (r *PostgresRepository) Update(ctx context.Context, workflow *models.Workflow) error {
+    return r.withBreaker(func() error {
+        if err := chaos.Inject(chaos.TargetRepository); err != nil {
+            return err
+        }
+
+        tx, err := r.db.BeginTx(ctx, &sql.TxOptions{
+            Isolation: sql.LevelSerializable,
+        })
+        if err != nil {
+            return fmt.Errorf("failed to start transaction: %w", err)
+        }
+        defer tx.Rollback()
+
+        metadata, err := json.Marshal(workflow.GetMetadata())
+        if err != nil {
+            return fmt.Errorf("failed to marshal metadata: %w", err)
+        }
+
+        result, err := tx.ExecContext(ctx, updateWorkflowSQL,
+            workflow.ID,
+            workflow.Name,
+            workflow.Description,
+            workflow.Status,
+            metadata,
+            workflow.Version,
+            time.Now().UTC(),
+        )
+        if err != nil {
+            return fmt.Errorf("failed to update workflow: %w", err)
+        }
+        if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+            return ErrWorkflowNotFound
+        }
+
+        // Replace the node graph: simpler and safer than diffing, and
+        // workflows are small enough that rewrite cost is negligible
+        if _, err := tx.ExecContext(ctx, deleteConnectionsSQL, workflow.ID); err != nil {
+            return fmt.Errorf("failed to clear node connections: %w", err)
+        }
+        if _, err := tx.ExecContext(ctx, deleteNodesSQL, workflow.ID); err != nil {
+            return fmt.Errorf("failed to clear nodes: %w", err)
+        }
+
+        for _, node := range workflow.GetNodes() {
+            config, err := r.marshalNodeConfig(ctx, node)
+            if err != nil {
+                return fmt.Errorf("failed to marshal node config: %w", err)
+            }
+
+            _, err = tx.StmtContext(ctx, r.preparedStmts["createNode"]).ExecContext(ctx,
+                node.ID,
+                workflow.ID,
+                node.Type,
+                node.Name,
+                config,
+                node.PositionX,
+                node.PositionY,
+                node.CreatedAt,
+                node.UpdatedAt,
+            )
+            if err != nil {
+                return fmt.Errorf("failed to insert node: %w", err)
+            }
+
+            if err := r.insertNodeConnections(ctx, tx, node); err != nil {
+                return err
+            }
+        }
+
+        if err := tx.Commit(); err != nil {
+            return fmt.Errorf("failed to commit transaction: %w", err)
+        }
+        return nil
+    })
+}
+
+// Delete removes a workflow with its nodes and connections
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+    return r.withBreaker(func() error {
+        if err := chaos.Inject(chaos.TargetRepository); err != nil {
+            return err
+        }
+
+        tx, err := r.db.BeginTx(ctx, &sql.TxOptions{
+            Isolation: sql.LevelSerializable,
+        })
+        if err != nil {
+            return fmt.Errorf("failed to start transaction: %w", err)
+        }
+        defer tx.Rollback()
+
+        if _, err := tx.ExecContext(ctx, deleteConnectionsSQL, id); err != nil {
+            return fmt.Errorf("failed to delete node connections: %w", err)
+        }
+        if _, err := tx.ExecContext(ctx, deleteNodesSQL, id); err != nil {
+            return fmt.Errorf("failed to delete nodes: %w", err)
+        }
+
+        result, err := tx.ExecContext(ctx, deleteWorkflowSQL, id)
+        if err != nil {
+            return fmt.Errorf("failed to delete workflow: %w", err)
+        }
+        if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+            return ErrWorkflowNotFound
+        }
+
+        if err := tx.Commit(); err != nil {
+            return fmt.Errorf("failed to commit transaction: %w", err)
+        }
+        return nil
+    })
+}
+
+// scanWorkflow reconstructs a workflow from a workflows table row
+func scanWorkflow(row rowScanner) (*models.Workflow, error) {
+    workflow := &models.Workflow{}
+    var metadata []byte
+
+    err := row.Scan(
+        &workflow.ID,
+        &workflow.UserID,
+        &workflow.Name,
+        &workflow.Description,
+        &workflow.Status,
+        &metadata,
+        &workflow.Version,
+        &workflow.CreatedAt,
+        &workflow.UpdatedAt,
+    )
+    if err != nil {
+        return nil, err
+    }
+
+    workflow.Metadata = make(map[string]interface{})
+    if len(metadata) > 0 {
+        if err := json.Unmarshal(metadata, &workflow.Metadata); err != nil {
+            return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+        }
+    }
+
+    return workflow, nil
+}
+
+// loadNodes attaches a workflow's nodes and their connections
+func (r *PostgresRepository) loadNodes(ctx context.Context, workflow *models.Workflow) error {
+    rows, err := r.db.QueryContext(ctx, getNodesSQL, workflow.ID)
+    if err != nil {
+        return fmt.Errorf("failed to load nodes: %w", err)
+    }
+    defer rows.Close()
+
+    nodes := make(map[uuid.UUID]*models.Node)
+    workflow.Nodes = make([]*models.Node, 0)
+    for rows.Next() {
+        node := &models.Node{
+            WorkflowID:        workflow.ID,
+            InputConnections:  make([]uuid.UUID, 0),
+            OutputConnections: make([]uuid.UUID, 0),
+        }
+        var config []byte
+
+        err := rows.Scan(
+            &node.ID,
+            &node.Type,
+            &node.Name,
+            &config,
+            &node.PositionX,
+            &node.PositionY,
+            &node.CreatedAt,
+            &node.UpdatedAt,
+        )
+        if err != nil {
+            return fmt.Errorf("failed to scan node: %w", err)
+        }
+
+        node.Config = make(map[string]interface{})
+        if len(config) > 0 {
+            if err := json.Unmarshal(config, &node.Config); err != nil {
+                return fmt.Errorf("failed to unmarshal node config: %w", err)
+            }
+        }
+        if err := r.openSecretFields(node); err != nil {
+            return err
+        }
+
+        nodes[node.ID] = node
+        workflow.Nodes = append(workflow.Nodes, node)
+    }
+    if err := rows.Err(); err != nil {
+        return fmt.Errorf("failed to iterate nodes: %w", err)
+    }
+
+    connRows, err := r.db.QueryContext(ctx, getConnectionsSQL, workflow.ID)
+    if err != nil {
+        return fmt.Errorf("failed to load connections: %w", err)
+    }
+    defer connRows.Close()
+
+    for connRows.Next() {
+        var sourceID, targetID uuid.UUID
+        var connType string
+        if err := connRows.Scan(&sourceID, &targetID, &connType); err != nil {
+            return fmt.Errorf("failed to scan connection: %w", err)
+        }
+
+        source, sourceExists := nodes[sourceID]
+        target, targetExists := nodes[targetID]
+        if !sourceExists || !targetExists {
+            continue // dangling connection; skip rather than fail the load
+        }
+
+        switch connType {
+        case connectionTypeError:
+            // Error edges feed the executor's error routing only; they
+            // must not become success-graph inputs
+            source.ErrorConnections = append(source.ErrorConnections, targetID)
+        default:
+            source.OutputConnections = append(source.OutputConnections, targetID)
+            target.InputConnections = append(target.InputConnections, sourceID)
+        }
+    }
+    return connRows.Err()
+}
+
+// insertNodeConnections persists a node's outgoing success and error edges
+func (r *PostgresRepository) insertNodeConnections(ctx context.Context, tx *sql.Tx, node *models.Node) error {
+    for _, targetID := range node.GetOutputConnections() {
+        _, err := tx.StmtContext(ctx, r.preparedStmts["createNodeConnection"]).ExecContext(ctx,
+            node.ID,
+            targetID,
+            connectionTypeStandard,
+            time.Now().UTC(),
+        )
+        if err != nil {
+            return fmt.Errorf("failed to insert node connection: %w", err)
+        }
+    }
+    for _, targetID := range node.GetErrorConnections() {
+        _, err := tx.StmtContext(ctx, r.preparedStmts["createNodeConnection"]).ExecContext(ctx,
+            node.ID,
+            targetID,
+            connectionTypeError,
+            time.Now().UTC(),
+        )
+        if err != nil {
+            return fmt.Errorf("failed to insert error connection: %w", err)
+        }
+    }
+    return nil
+}
+
 // HealthCheck performs a health check of the repository
 func (r *PostgresRepository) HealthCheck(ctx context.Context) (bool, error) {
     ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
@@ -0,0 +1,201 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "errors"
+    "fmt"
+
+    "github.com/google/uuid" // v1.3.0
+    "github.com/lib/pq"      // v1.10.9
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common prompt store errors
+var (
+    ErrPromptNotFound = errors.New("prompt template not found")
+)
+
+// SQL statements for the prompt_templates table; every version is its
+// own immutable row
+const (
+    insertPromptSQL = `
+        INSERT INTO prompt_templates (id, name, description, version, content, variables, created_by, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `
+    getPromptVersionSQL = `
+        SELECT id, name, description, version, content, variables, created_by, created_at
+        FROM prompt_templates
+        WHERE id = $1 AND version = $2
+    `
+    getPromptLatestSQL = `
+        SELECT id, name, description, version, content, variables, created_by, created_at
+        FROM prompt_templates
+        WHERE id = $1
+        ORDER BY version DESC
+        LIMIT 1
+    `
+    listPromptsSQL = `
+        SELECT DISTINCT ON (id) id, name, description, version, content, variables, created_by, created_at
+        FROM prompt_templates
+        ORDER BY id, version DESC
+    `
+    listPromptVersionsSQL = `
+        SELECT id, name, description, version, content, variables, created_by, created_at
+        FROM prompt_templates
+        WHERE id = $1
+        ORDER BY version
+    `
+    deletePromptSQL = `
+        DELETE FROM prompt_templates WHERE id = $1
+    `
+)
+
+// PostgresPromptStore persists versioned prompt templates
+type PostgresPromptStore struct {
+    db *sql.DB
+}
+
+// NewPromptStore creates a new PostgreSQL-backed prompt template store
+func NewPromptStore(cfg *config.DatabaseConfig) (*PostgresPromptStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PostgresPromptStore{db: db}, nil
+}
+
+// NewPromptStoreWithDB creates a prompt store over an existing connection pool
+func NewPromptStoreWithDB(db *sql.DB) *PostgresPromptStore {
+    return &PostgresPromptStore{db: db}
+}
+
+// Create persists one immutable template version
+func (s *PostgresPromptStore) Create(ctx context.Context, template *models.PromptTemplate) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    _, err := s.db.ExecContext(ctx, insertPromptSQL,
+        template.ID,
+        template.Name,
+        template.Description,
+        template.Version,
+        template.Content,
+        pq.Array(template.Variables),
+        template.CreatedBy,
+        template.CreatedAt,
+    )
+    if err != nil {
+        return fmt.Errorf("failed to create prompt template: %w", err)
+    }
+    return nil
+}
+
+// Get returns one template version; version zero resolves to the latest
+func (s *PostgresPromptStore) Get(ctx context.Context, id uuid.UUID, version int) (*models.PromptTemplate, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    var row *sql.Row
+    if version > 0 {
+        row = s.db.QueryRowContext(ctx, getPromptVersionSQL, id, version)
+    } else {
+        row = s.db.QueryRowContext(ctx, getPromptLatestSQL, id)
+    }
+    return scanPrompt(row)
+}
+
+// List returns the latest version of every template
+func (s *PostgresPromptStore) List(ctx context.Context) ([]*models.PromptTemplate, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listPromptsSQL)
+    if err != nil {
+        return nil, fmt.Errorf("failed to list prompt templates: %w", err)
+    }
+    defer rows.Close()
+    return scanPrompts(rows)
+}
+
+// ListVersions returns every version of one template, oldest first
+func (s *PostgresPromptStore) ListVersions(ctx context.Context, id uuid.UUID) ([]*models.PromptTemplate, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listPromptVersionsSQL, id)
+    if err != nil {
+        return nil, fmt.Errorf("failed to list prompt template versions: %w", err)
+    }
+    defer rows.Close()
+    return scanPrompts(rows)
+}
+
+// Delete removes a template and all of its versions
+func (s *PostgresPromptStore) Delete(ctx context.Context, id uuid.UUID) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    result, err := s.db.ExecContext(ctx, deletePromptSQL, id)
+    if err != nil {
+        return fmt.Errorf("failed to delete prompt template: %w", err)
+    }
+    if affected, _ := result.RowsAffected(); affected == 0 {
+        return ErrPromptNotFound
+    }
+    return nil
+}
+
+// Close releases the underlying connection pool
+func (s *PostgresPromptStore) Close() error {
+    return s.db.Close()
+}
+
+// scanPrompt reads one template row
+func scanPrompt(row *sql.Row) (*models.PromptTemplate, error) {
+    template := &models.PromptTemplate{}
+    err := row.Scan(
+        &template.ID,
+        &template.Name,
+        &template.Description,
+        &template.Version,
+        &template.Content,
+        pq.Array(&template.Variables),
+        &template.CreatedBy,
+        &template.CreatedAt,
+    )
+    if errors.Is(err, sql.ErrNoRows) {
+        return nil, ErrPromptNotFound
+    }
+    if err != nil {
+        return nil, fmt.Errorf("failed to scan prompt template: %w", err)
+    }
+    return template, nil
+}
+
+// scanPrompts reads a template result set
+func scanPrompts(rows *sql.Rows) ([]*models.PromptTemplate, error) {
+    templates := make([]*models.PromptTemplate, 0)
+    for rows.Next() {
+        template := &models.PromptTemplate{}
+        err := rows.Scan(
+            &template.ID,
+            &template.Name,
+            &template.Description,
+            &template.Version,
+            &template.Content,
+            pq.Array(&template.Variables),
+            &template.CreatedBy,
+            &template.CreatedAt,
+        )
+        if err != nil {
+            return nil, fmt.Errorf("failed to scan prompt template: %w", err)
+        }
+        templates = append(templates, template)
+    }
+    return templates, rows.Err()
+}
@@ -0,0 +1,181 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "sync"
+
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/middleware"
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/encryption"
+)
+
+// Common residency errors
+var (
+    ErrRegionNotConfigured = errors.New("no storage backend configured for region")
+    ErrUnknownTenantRegion = errors.New("tenant region not registered")
+)
+
+// RegionPinnedSelector selects a region-pinned storage backend per tenant so
+// that execution payloads are persisted in the tenant's configured region
+type RegionPinnedSelector struct {
+    mu       sync.RWMutex
+    backends map[string]*PostgresRepository
+    regions  map[string]string // tenant ID -> region
+    fallback *PostgresRepository
+}
+
+// NewRegionPinnedSelector creates a selector with the given default backend
+func NewRegionPinnedSelector(fallback *PostgresRepository) *RegionPinnedSelector {
+    return &RegionPinnedSelector{
+        backends: make(map[string]*PostgresRepository),
+        regions:  make(map[string]string),
+        fallback: fallback,
+    }
+}
+
+// RegisterRegion configures a storage backend for a region
+func (s *RegionPinnedSelector) RegisterRegion(region string, cfg *config.DatabaseConfig) error {
+    repo, err := NewPostgresRepository(cfg)
+    if err != nil {
+        return fmt.Errorf("failed to initialize backend for region %s: %w", region, err)
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.backends[region] = repo
+    return nil
+}
+
+// SetSecretEncryptor enables secret config encryption on every regional
+// backend so pinned tenants get the same at-rest protection
+func (s *RegionPinnedSelector) SetSecretEncryptor(encryptor *encryption.EnvelopeEncryptor) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for _, backend := range s.backends {
+        backend.SetSecretEncryptor(encryptor)
+    }
+    if s.fallback != nil {
+        s.fallback.SetSecretEncryptor(encryptor)
+    }
+}
+
+// PinTenant pins a tenant to a region. Subsequent writes for the tenant are
+// routed to the region's storage backend.
+func (s *RegionPinnedSelector) PinTenant(tenantID, region string) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if _, exists := s.backends[region]; !exists {
+        return fmt.Errorf("%w: %s", ErrRegionNotConfigured, region)
+    }
+
+    s.regions[tenantID] = region
+    return nil
+}
+
+// SelectForTenant returns the storage backend for a tenant, falling back to
+// the default backend for tenants without a pinned region
+func (s *RegionPinnedSelector) SelectForTenant(tenantID string) (*PostgresRepository, error) {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
+    region, exists := s.regions[tenantID]
+    if !exists {
+        if s.fallback == nil {
+            return nil, ErrUnknownTenantRegion
+        }
+        return s.fallback, nil
+    }
+
+    backend, exists := s.backends[region]
+    if !exists {
+        return nil, fmt.Errorf("%w: %s", ErrRegionNotConfigured, region)
+    }
+
+    return backend, nil
+}
+
+// ResidencyRepository routes workflow persistence to the requesting
+// tenant's pinned regional backend, satisfying the workflow service's
+// repository contract. Tenants without a pin use the default backend
+type ResidencyRepository struct {
+    selector *RegionPinnedSelector
+}
+
+// NewResidencyRepository creates a repository router over the selector
+func NewResidencyRepository(selector *RegionPinnedSelector) *ResidencyRepository {
+    return &ResidencyRepository{selector: selector}
+}
+
+// backend resolves the storage backend for the tenant the request
+// context carries
+func (r *ResidencyRepository) backend(ctx context.Context) (*PostgresRepository, error) {
+    return r.selector.SelectForTenant(middleware.TenantFromContext(ctx))
+}
+
+// Create persists a workflow in the tenant's region
+func (r *ResidencyRepository) Create(ctx context.Context, workflow *models.Workflow) error {
+    backend, err := r.backend(ctx)
+    if err != nil {
+        return err
+    }
+    return backend.Create(ctx, workflow)
+}
+
+// Get loads a workflow from the tenant's region
+func (r *ResidencyRepository) Get(ctx context.Context, id uuid.UUID) (*models.Workflow, error) {
+    backend, err := r.backend(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return backend.Get(ctx, id)
+}
+
+// List lists workflows from the tenant's region
+func (r *ResidencyRepository) List(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.Workflow, int, error) {
+    backend, err := r.backend(ctx)
+    if err != nil {
+        return nil, 0, err
+    }
+    return backend.List(ctx, userID, offset, limit)
+}
+
+// Update persists workflow changes in the tenant's region
+func (r *ResidencyRepository) Update(ctx context.Context, workflow *models.Workflow) error {
+    backend, err := r.backend(ctx)
+    if err != nil {
+        return err
+    }
+    return backend.Update(ctx, workflow)
+}
+
+// Delete removes a workflow from the tenant's region
+func (r *ResidencyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+    backend, err := r.backend(ctx)
+    if err != nil {
+        return err
+    }
+    return backend.Delete(ctx, id)
+}
+
+// Close closes all region backends
+func (s *RegionPinnedSelector) Close() error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    var firstErr error
+    for region, backend := range s.backends {
+        if err := backend.Close(); err != nil && firstErr == nil {
+            firstErr = fmt.Errorf("failed to close backend for region %s: %w", region, err)
+        }
+    }
+
+    return firstErr
+}
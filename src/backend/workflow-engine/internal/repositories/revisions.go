@@ -0,0 +1,182 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "errors"
+    "fmt"
+
+    "github.com/google/uuid"
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common revision store errors
+var (
+    ErrRevisionNotFound = errors.New("workflow revision not found")
+)
+
+// SQL statements for the workflow revisions table
+const (
+    insertRevisionSQL = `
+        INSERT INTO workflow_revisions (id, workflow_id, version, hash, snapshot, published_by, published_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+    `
+    latestRevisionSQL = `
+        SELECT id, workflow_id, version, hash, snapshot, published_by, published_at
+        FROM workflow_revisions
+        WHERE workflow_id = $1
+        ORDER BY version DESC
+        LIMIT 1
+    `
+    revisionByVersionSQL = `
+        SELECT id, workflow_id, version, hash, snapshot, published_by, published_at
+        FROM workflow_revisions
+        WHERE workflow_id = $1 AND version = $2
+    `
+    listRevisionsSQL = `
+        SELECT id, workflow_id, version, hash, snapshot, published_by, published_at
+        FROM workflow_revisions
+        WHERE workflow_id = $1
+        ORDER BY version DESC
+    `
+)
+
+// PostgresRevisionStore persists immutable published workflow revisions
+type PostgresRevisionStore struct {
+    db *sql.DB
+}
+
+// NewRevisionStore creates a new PostgreSQL-backed revision store
+func NewRevisionStore(cfg *config.DatabaseConfig) (*PostgresRevisionStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PostgresRevisionStore{db: db}, nil
+}
+
+// NewRevisionStoreWithDB creates a revision store over an existing connection pool
+func NewRevisionStoreWithDB(db *sql.DB) *PostgresRevisionStore {
+    return &PostgresRevisionStore{db: db}
+}
+
+// Save persists a published revision snapshot
+func (s *PostgresRevisionStore) Save(ctx context.Context, revision *models.WorkflowRevision) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    _, err := s.db.ExecContext(ctx, insertRevisionSQL,
+        revision.ID,
+        revision.WorkflowID,
+        revision.Version,
+        revision.Hash,
+        []byte(revision.Snapshot),
+        revision.PublishedBy,
+        revision.PublishedAt,
+    )
+    if err != nil {
+        return fmt.Errorf("failed to save revision: %w", err)
+    }
+
+    return nil
+}
+
+// GetLatest returns the most recently published revision of a workflow
+func (s *PostgresRevisionStore) GetLatest(ctx context.Context, workflowID uuid.UUID) (*models.WorkflowRevision, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    revision := &models.WorkflowRevision{}
+    var snapshot []byte
+    err := s.db.QueryRowContext(ctx, latestRevisionSQL, workflowID).Scan(
+        &revision.ID,
+        &revision.WorkflowID,
+        &revision.Version,
+        &revision.Hash,
+        &snapshot,
+        &revision.PublishedBy,
+        &revision.PublishedAt,
+    )
+    if err == sql.ErrNoRows {
+        return nil, ErrRevisionNotFound
+    }
+    if err != nil {
+        return nil, fmt.Errorf("failed to query latest revision: %w", err)
+    }
+    revision.Snapshot = snapshot
+
+    return revision, nil
+}
+
+// GetByVersion returns a specific published revision of a workflow
+func (s *PostgresRevisionStore) GetByVersion(ctx context.Context, workflowID uuid.UUID, version int) (*models.WorkflowRevision, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    revision := &models.WorkflowRevision{}
+    var snapshot []byte
+    err := s.db.QueryRowContext(ctx, revisionByVersionSQL, workflowID, version).Scan(
+        &revision.ID,
+        &revision.WorkflowID,
+        &revision.Version,
+        &revision.Hash,
+        &snapshot,
+        &revision.PublishedBy,
+        &revision.PublishedAt,
+    )
+    if err == sql.ErrNoRows {
+        return nil, ErrRevisionNotFound
+    }
+    if err != nil {
+        return nil, fmt.Errorf("failed to query revision by version: %w", err)
+    }
+    revision.Snapshot = snapshot
+
+    return revision, nil
+}
+
+// List returns all published revisions of a workflow, newest first
+func (s *PostgresRevisionStore) List(ctx context.Context, workflowID uuid.UUID) ([]*models.WorkflowRevision, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listRevisionsSQL, workflowID)
+    if err != nil {
+        return nil, fmt.Errorf("failed to query revisions: %w", err)
+    }
+    defer rows.Close()
+
+    revisions := make([]*models.WorkflowRevision, 0)
+    for rows.Next() {
+        revision := &models.WorkflowRevision{}
+        var snapshot []byte
+        if err := rows.Scan(
+            &revision.ID,
+            &revision.WorkflowID,
+            &revision.Version,
+            &revision.Hash,
+            &snapshot,
+            &revision.PublishedBy,
+            &revision.PublishedAt,
+        ); err != nil {
+            return nil, fmt.Errorf("failed to scan revision row: %w", err)
+        }
+        revision.Snapshot = snapshot
+        revisions = append(revisions, revision)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("failed to iterate revision rows: %w", err)
+    }
+
+    return revisions, nil
+}
+
+// Close closes the underlying database connection
+func (s *PostgresRevisionStore) Close() error {
+    return s.db.Close()
+}
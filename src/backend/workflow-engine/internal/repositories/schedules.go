@@ -0,0 +1,135 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "encoding/json"
+    "fmt"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// SQL statements for the workflow_schedules table
+const (
+    saveScheduleSQL = `
+        INSERT INTO workflow_schedules (workflow_id, config, paused, updated_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (workflow_id) DO UPDATE SET
+            config = EXCLUDED.config,
+            paused = EXCLUDED.paused,
+            updated_at = EXCLUDED.updated_at
+    `
+    deleteScheduleSQL = `
+        DELETE FROM workflow_schedules WHERE workflow_id = $1
+    `
+    saveLastRunSQL = `
+        UPDATE workflow_schedules SET last_run_at = $2, updated_at = $3 WHERE workflow_id = $1
+    `
+    loadSchedulesSQL = `
+        SELECT workflow_id, config, paused, last_run_at FROM workflow_schedules
+    `
+)
+
+// PostgresScheduleStore persists schedule configurations and last-run
+// times so schedules survive engine restarts
+type PostgresScheduleStore struct {
+    db *sql.DB
+}
+
+// The store must satisfy the scheduler's persistence contract
+var _ core.ScheduleStateStore = (*PostgresScheduleStore)(nil)
+
+// NewScheduleStore creates a new PostgreSQL-backed schedule store
+func NewScheduleStore(cfg *config.DatabaseConfig) (*PostgresScheduleStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PostgresScheduleStore{db: db}, nil
+}
+
+// NewScheduleStoreWithDB creates a schedule store over an existing connection pool
+func NewScheduleStoreWithDB(db *sql.DB) *PostgresScheduleStore {
+    return &PostgresScheduleStore{db: db}
+}
+
+// SaveSchedule upserts a schedule's configuration and paused flag
+func (s *PostgresScheduleStore) SaveSchedule(ctx context.Context, workflowID uuid.UUID, scheduleConfig map[string]interface{}, paused bool) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    encoded, err := json.Marshal(scheduleConfig)
+    if err != nil {
+        return fmt.Errorf("failed to marshal schedule config: %w", err)
+    }
+
+    if _, err := s.db.ExecContext(ctx, saveScheduleSQL, workflowID, encoded, paused, time.Now().UTC()); err != nil {
+        return fmt.Errorf("failed to save schedule: %w", err)
+    }
+    return nil
+}
+
+// DeleteSchedule removes a persisted schedule
+func (s *PostgresScheduleStore) DeleteSchedule(ctx context.Context, workflowID uuid.UUID) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    if _, err := s.db.ExecContext(ctx, deleteScheduleSQL, workflowID); err != nil {
+        return fmt.Errorf("failed to delete schedule: %w", err)
+    }
+    return nil
+}
+
+// SaveLastRun records when a schedule last fired
+func (s *PostgresScheduleStore) SaveLastRun(ctx context.Context, workflowID uuid.UUID, lastRun time.Time) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    if _, err := s.db.ExecContext(ctx, saveLastRunSQL, workflowID, lastRun.UTC(), time.Now().UTC()); err != nil {
+        return fmt.Errorf("failed to save last run: %w", err)
+    }
+    return nil
+}
+
+// LoadSchedules returns every persisted schedule with its runtime state
+func (s *PostgresScheduleStore) LoadSchedules(ctx context.Context) ([]core.PersistedSchedule, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, loadSchedulesSQL)
+    if err != nil {
+        return nil, fmt.Errorf("failed to load schedules: %w", err)
+    }
+    defer rows.Close()
+
+    schedules := make([]core.PersistedSchedule, 0)
+    for rows.Next() {
+        var entry core.PersistedSchedule
+        var encoded []byte
+        var lastRun sql.NullTime
+
+        if err := rows.Scan(&entry.WorkflowID, &encoded, &entry.Paused, &lastRun); err != nil {
+            return nil, fmt.Errorf("failed to scan schedule: %w", err)
+        }
+        if err := json.Unmarshal(encoded, &entry.Config); err != nil {
+            return nil, fmt.Errorf("failed to unmarshal schedule config: %w", err)
+        }
+        if lastRun.Valid {
+            entry.LastRun = lastRun.Time
+        }
+
+        schedules = append(schedules, entry)
+    }
+    return schedules, rows.Err()
+}
+
+// Close releases the underlying connection pool
+func (s *PostgresScheduleStore) Close() error {
+    return s.db.Close()
+}
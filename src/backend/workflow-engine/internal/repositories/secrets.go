@@ -0,0 +1,82 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+
+    "github.com/organization/workflow-engine/internal/middleware"
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/encryption"
+)
+
+// secretValueKey is the payload key a secret config value is stored
+// under inside its envelope
+const secretValueKey = "value"
+
+// SetSecretEncryptor enables envelope encryption of secret-marked node
+// config fields with per-tenant keys
+func (r *PostgresRepository) SetSecretEncryptor(encryptor *encryption.EnvelopeEncryptor) {
+    r.secrets = encryptor
+}
+
+// marshalNodeConfig serializes a node's config for persistence, sealing
+// secret-marked fields under the requesting tenant's key when an
+// encryptor is configured
+func (r *PostgresRepository) marshalNodeConfig(ctx context.Context, node *models.Node) ([]byte, error) {
+    config := node.Config
+    if r.secrets != nil {
+        fields := models.SecretConfigFields(node.Type)
+        if len(fields) > 0 {
+            sealed := make(map[string]interface{}, len(config))
+            for key, value := range config {
+                sealed[key] = value
+            }
+            tenant := middleware.TenantFromContext(ctx)
+            for _, field := range fields {
+                secret, ok := sealed[field].(string)
+                if !ok {
+                    continue // absent, already sealed, or non-string; leave as-is
+                }
+                envelope, err := r.secrets.Encrypt(tenant, map[string]interface{}{secretValueKey: secret})
+                if err != nil {
+                    return nil, fmt.Errorf("failed to encrypt secret field %s: %w", field, err)
+                }
+                sealed[field] = map[string]interface{}{envelopeMarkerKey: envelope}
+            }
+            config = sealed
+        }
+    }
+    return json.Marshal(config)
+}
+
+// openSecretFields decrypts a loaded node's secret-marked config fields
+// in place; plaintext values from before encryption was enabled pass
+// through
+func (r *PostgresRepository) openSecretFields(node *models.Node) error {
+    for _, field := range models.SecretConfigFields(node.Type) {
+        wrapper, ok := node.Config[field].(map[string]interface{})
+        if !ok {
+            continue
+        }
+        raw, sealed := wrapper[envelopeMarkerKey]
+        if !sealed {
+            continue
+        }
+        if r.secrets == nil {
+            return fmt.Errorf("node %s field %s is encrypted but no secret encryptor is configured", node.ID, field)
+        }
+
+        envelope, err := decodeEnvelope(raw)
+        if err != nil {
+            return err
+        }
+        payload, err := r.secrets.Decrypt(envelope)
+        if err != nil {
+            return fmt.Errorf("failed to decrypt secret field %s for node %s: %w", field, node.ID, err)
+        }
+        node.Config[field] = payload[secretValueKey]
+    }
+    return nil
+}
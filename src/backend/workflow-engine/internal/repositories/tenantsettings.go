@@ -0,0 +1,98 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "fmt"
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/middleware"
+)
+
+// SQL statements for the tenant settings table
+const (
+    getRateLimitSQL = `
+        SELECT per_minute, burst
+        FROM tenant_settings
+        WHERE tenant_id = $1 AND rate_limit_class = $2
+    `
+    listResidencyPinsSQL = `
+        SELECT DISTINCT tenant_id, residency_region
+        FROM tenant_settings
+        WHERE residency_region IS NOT NULL AND residency_region <> ''
+    `
+)
+
+// PostgresTenantSettingsStore reads per-tenant overrides from the tenant
+// settings table
+type PostgresTenantSettingsStore struct {
+    db *sql.DB
+}
+
+// NewTenantSettingsStore creates a new PostgreSQL-backed tenant settings store
+func NewTenantSettingsStore(cfg *config.DatabaseConfig) (*PostgresTenantSettingsStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PostgresTenantSettingsStore{db: db}, nil
+}
+
+// NewTenantSettingsStoreWithDB creates a tenant settings store over an
+// existing connection pool
+func NewTenantSettingsStoreWithDB(db *sql.DB) *PostgresTenantSettingsStore {
+    return &PostgresTenantSettingsStore{db: db}
+}
+
+// GetRateLimit returns the tenant's rate limit override for an endpoint
+// class, reporting whether one is stored
+func (s *PostgresTenantSettingsStore) GetRateLimit(ctx context.Context, tenant, class string) (middleware.EndpointLimit, bool, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    var limit middleware.EndpointLimit
+    err := s.db.QueryRowContext(ctx, getRateLimitSQL, tenant, class).Scan(&limit.PerMinute, &limit.Burst)
+    if err == sql.ErrNoRows {
+        return middleware.EndpointLimit{}, false, nil
+    }
+    if err != nil {
+        return middleware.EndpointLimit{}, false, fmt.Errorf("failed to read tenant rate limit: %w", err)
+    }
+
+    return limit, true, nil
+}
+
+// ListResidencyPins returns the storage region each pinned tenant's
+// data must stay in, keyed by tenant ID
+func (s *PostgresTenantSettingsStore) ListResidencyPins(ctx context.Context) (map[string]string, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, listResidencyPinsSQL)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read tenant residency pins: %w", err)
+    }
+    defer rows.Close()
+
+    pins := make(map[string]string)
+    for rows.Next() {
+        var tenant, region string
+        if err := rows.Scan(&tenant, &region); err != nil {
+            return nil, fmt.Errorf("failed to scan tenant residency pin: %w", err)
+        }
+        pins[tenant] = region
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("failed to iterate tenant residency pins: %w", err)
+    }
+
+    return pins, nil
+}
+
+// Close releases the store's database resources
+func (s *PostgresTenantSettingsStore) Close() error {
+    return s.db.Close()
+}
@@ -0,0 +1,115 @@
+// Package repositories provides data persistence implementations for the workflow engine
+package repositories
+
+import (
+    "context"
+    "database/sql"
+    "encoding/json"
+    "fmt"
+    "strconv"
+    "strings"
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// SQL statements for the embeddings table, which requires the pgvector
+// extension
+const (
+    upsertVectorSQL = `
+        INSERT INTO embeddings (id, namespace, embedding, metadata)
+        VALUES ($1, $2, $3::vector, $4)
+        ON CONFLICT (id, namespace) DO UPDATE SET
+            embedding = EXCLUDED.embedding,
+            metadata = EXCLUDED.metadata
+    `
+    queryVectorSQL = `
+        SELECT id, 1 - (embedding <=> $2::vector) AS score, metadata
+        FROM embeddings
+        WHERE namespace = $1
+        ORDER BY embedding <=> $2::vector
+        LIMIT $3
+    `
+)
+
+// PgVectorStore persists embeddings in PostgreSQL through the pgvector
+// extension, scoring queries by cosine similarity
+type PgVectorStore struct {
+    db *sql.DB
+}
+
+// NewVectorStore creates a new pgvector-backed vector store
+func NewVectorStore(cfg *config.DatabaseConfig) (*PgVectorStore, error) {
+    db, err := newPostgresDB(cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to initialize database: %w", err)
+    }
+
+    return &PgVectorStore{db: db}, nil
+}
+
+// NewVectorStoreWithDB creates a vector store over an existing connection pool
+func NewVectorStoreWithDB(db *sql.DB) *PgVectorStore {
+    return &PgVectorStore{db: db}
+}
+
+// Upsert writes the items into the namespace, replacing entries with
+// the same ID
+func (s *PgVectorStore) Upsert(ctx context.Context, namespace string, items []core.VectorItem) error {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    for _, item := range items {
+        metadata, err := json.Marshal(item.Metadata)
+        if err != nil {
+            return fmt.Errorf("failed to encode vector metadata: %w", err)
+        }
+        if _, err := s.db.ExecContext(ctx, upsertVectorSQL, item.ID, namespace, encodeVector(item.Vector), metadata); err != nil {
+            return fmt.Errorf("failed to upsert vector: %w", err)
+        }
+    }
+    return nil
+}
+
+// Query returns the topK nearest vectors in the namespace by cosine
+// similarity
+func (s *PgVectorStore) Query(ctx context.Context, namespace string, vector []float32, topK int) ([]core.VectorMatch, error) {
+    ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+    defer cancel()
+
+    rows, err := s.db.QueryContext(ctx, queryVectorSQL, namespace, encodeVector(vector), topK)
+    if err != nil {
+        return nil, fmt.Errorf("failed to query vectors: %w", err)
+    }
+    defer rows.Close()
+
+    matches := make([]core.VectorMatch, 0, topK)
+    for rows.Next() {
+        var match core.VectorMatch
+        var metadata []byte
+        if err := rows.Scan(&match.ID, &match.Score, &metadata); err != nil {
+            return nil, fmt.Errorf("failed to scan vector match: %w", err)
+        }
+        if len(metadata) > 0 {
+            if err := json.Unmarshal(metadata, &match.Metadata); err != nil {
+                return nil, fmt.Errorf("failed to decode vector metadata: %w", err)
+            }
+        }
+        matches = append(matches, match)
+    }
+    return matches, rows.Err()
+}
+
+// Close releases the underlying connection pool
+func (s *PgVectorStore) Close() error {
+    return s.db.Close()
+}
+
+// encodeVector renders a vector in pgvector's text input format
+func encodeVector(vector []float32) string {
+    parts := make([]string, len(vector))
+    for i, value := range vector {
+        parts[i] = strconv.FormatFloat(float64(value), 'f', -1, 32)
+    }
+    return "[" + strings.Join(parts, ",") + "]"
+}
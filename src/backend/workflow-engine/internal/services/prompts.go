@@ -0,0 +1,112 @@
+// Package services provides enterprise-grade service implementations for the workflow engine
+package services
+
+import (
+    "context"
+    "errors"
+    "fmt"
+
+    "github.com/google/uuid"
+
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Error definitions
+var (
+    ErrPromptNotFound = errors.New("prompt template not found")
+)
+
+// PromptStore defines the persistence interface the prompt service
+// depends on
+type PromptStore interface {
+    Create(ctx context.Context, template *models.PromptTemplate) error
+    Get(ctx context.Context, id uuid.UUID, version int) (*models.PromptTemplate, error)
+    List(ctx context.Context) ([]*models.PromptTemplate, error)
+    ListVersions(ctx context.Context, id uuid.UUID) ([]*models.PromptTemplate, error)
+    Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// PromptService manages the versioned prompt template library AI task
+// nodes reference by ID and version. Versions are immutable: updates
+// append a new version so running workflows keep the prompt they were
+// authored against and changes stay auditable
+type PromptService struct {
+    store PromptStore
+}
+
+// NewPromptService creates a prompt service over the store
+func NewPromptService(store PromptStore) *PromptService {
+    return &PromptService{store: store}
+}
+
+// Create validates and persists the first version of a template
+func (s *PromptService) Create(ctx context.Context, name, description, content string, createdBy uuid.UUID) (*models.PromptTemplate, error) {
+    template, err := models.NewPromptTemplate(name, description, content, createdBy)
+    if err != nil {
+        return nil, err
+    }
+    if err := s.store.Create(ctx, template); err != nil {
+        return nil, err
+    }
+    return template, nil
+}
+
+// NewVersion appends a new immutable version with updated content,
+// keeping the template's identity and name
+func (s *PromptService) NewVersion(ctx context.Context, id uuid.UUID, content string, createdBy uuid.UUID) (*models.PromptTemplate, error) {
+    latest, err := s.store.Get(ctx, id, 0)
+    if err != nil {
+        return nil, fmt.Errorf("%w: %v", ErrPromptNotFound, err)
+    }
+
+    next, err := models.NewPromptTemplate(latest.Name, latest.Description, content, createdBy)
+    if err != nil {
+        return nil, err
+    }
+    next.ID = latest.ID
+    next.Version = latest.Version + 1
+
+    if err := s.store.Create(ctx, next); err != nil {
+        return nil, err
+    }
+    return next, nil
+}
+
+// Get returns one template version; version zero resolves to the latest
+func (s *PromptService) Get(ctx context.Context, id uuid.UUID, version int) (*models.PromptTemplate, error) {
+    template, err := s.store.Get(ctx, id, version)
+    if err != nil {
+        return nil, fmt.Errorf("%w: %v", ErrPromptNotFound, err)
+    }
+    return template, nil
+}
+
+// List returns the latest version of every template
+func (s *PromptService) List(ctx context.Context) ([]*models.PromptTemplate, error) {
+    return s.store.List(ctx)
+}
+
+// ListVersions returns every version of one template, oldest first
+func (s *PromptService) ListVersions(ctx context.Context, id uuid.UUID) ([]*models.PromptTemplate, error) {
+    return s.store.ListVersions(ctx, id)
+}
+
+// Delete removes a template and all of its versions
+func (s *PromptService) Delete(ctx context.Context, id uuid.UUID) error {
+    return s.store.Delete(ctx, id)
+}
+
+// Render resolves a template version and substitutes the values,
+// which is how AI task nodes turn their template reference into the
+// prompt sent to the model
+func (s *PromptService) Render(ctx context.Context, id uuid.UUID, version int, values map[string]string) (string, error) {
+    template, err := s.store.Get(ctx, id, version)
+    if err != nil {
+        return "", err
+    }
+    rendered, err := template.Render(values)
+    if err != nil {
+        return "", fmt.Errorf("failed to render prompt %s v%d: %w", id, template.Version, err)
+    }
+    return rendered, nil
+}
@@ -0,0 +1,190 @@
+// Package services provides the business logic layer for the workflow engine
+package services
+
+import (
+    "errors"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/google/uuid" // v1.3.0
+)
+
+// Support access constants
+const (
+    // defaultSupportAccessTTL is how long a grant lasts when no duration
+    // is requested
+    defaultSupportAccessTTL = time.Hour
+
+    // maxSupportAccessTTL caps how long any grant can last
+    maxSupportAccessTTL = 24 * time.Hour
+)
+
+// Common support access errors
+var (
+    ErrConsentRequired     = errors.New("customer consent reference is required")
+    ErrGrantNotFound       = errors.New("support access grant not found")
+    ErrGrantExpired        = errors.New("support access grant has expired")
+    ErrGrantRevoked        = errors.New("support access grant was revoked")
+    ErrGrantWrongAdmin     = errors.New("support access grant belongs to another admin")
+)
+
+// SupportGrant is one consented, time-bounded authorization for a
+// platform admin to act within a customer's account
+type SupportGrant struct {
+    ID         uuid.UUID `json:"id"`
+    AdminID    uuid.UUID `json:"admin_id"`
+    UserID     uuid.UUID `json:"user_id"`
+    Reason     string    `json:"reason"`
+    ConsentRef string    `json:"consent_ref"`
+    CreatedAt  time.Time `json:"created_at"`
+    ExpiresAt  time.Time `json:"expires_at"`
+    RevokedAt  time.Time `json:"revoked_at,omitempty"`
+    UseCount   int       `json:"use_count"`
+    LastUsedAt time.Time `json:"last_used_at,omitempty"`
+}
+
+// SupportAuditEvent records one support access action for the audit trail
+type SupportAuditEvent struct {
+    Action  string
+    GrantID uuid.UUID
+    AdminID uuid.UUID
+    UserID  uuid.UUID
+    Path    string
+}
+
+// SupportAccessManager issues and resolves support access grants. Every
+// grant requires an explicit customer consent reference, expires
+// automatically, and every issuance, use, and revocation is reported to
+// the audit callback
+type SupportAccessManager struct {
+    mu      sync.Mutex
+    grants  map[uuid.UUID]*SupportGrant
+    onAudit func(SupportAuditEvent)
+}
+
+// NewSupportAccessManager creates a manager reporting to the audit
+// callback; nil disables reporting
+func NewSupportAccessManager(onAudit func(SupportAuditEvent)) *SupportAccessManager {
+    return &SupportAccessManager{
+        grants:  make(map[uuid.UUID]*SupportGrant),
+        onAudit: onAudit,
+    }
+}
+
+// Grant issues a support access grant for the admin to act as the user.
+// The consent reference (ticket, recorded approval) is mandatory; the
+// duration defaults to an hour and is capped at a day
+func (m *SupportAccessManager) Grant(adminID, userID uuid.UUID, reason, consentRef string, duration time.Duration) (*SupportGrant, error) {
+    if consentRef == "" {
+        return nil, ErrConsentRequired
+    }
+    if adminID == uuid.Nil || userID == uuid.Nil {
+        return nil, fmt.Errorf("admin and user IDs are required")
+    }
+    if duration <= 0 {
+        duration = defaultSupportAccessTTL
+    }
+    if duration > maxSupportAccessTTL {
+        duration = maxSupportAccessTTL
+    }
+
+    now := time.Now().UTC()
+    grant := &SupportGrant{
+        ID:         uuid.New(),
+        AdminID:    adminID,
+        UserID:     userID,
+        Reason:     reason,
+        ConsentRef: consentRef,
+        CreatedAt:  now,
+        ExpiresAt:  now.Add(duration),
+    }
+
+    m.mu.Lock()
+    m.sweepLocked(now)
+    m.grants[grant.ID] = grant
+    m.mu.Unlock()
+
+    m.audit(SupportAuditEvent{Action: "granted", GrantID: grant.ID, AdminID: adminID, UserID: userID})
+    return grant, nil
+}
+
+// Use resolves the grant for an impersonated request, returning the
+// customer user the admin may act as. Expired and revoked grants fail
+func (m *SupportAccessManager) Use(grantID, adminID uuid.UUID, path string) (uuid.UUID, error) {
+    m.mu.Lock()
+    grant, exists := m.grants[grantID]
+    if !exists {
+        m.mu.Unlock()
+        return uuid.Nil, ErrGrantNotFound
+    }
+    if grant.AdminID != adminID {
+        m.mu.Unlock()
+        return uuid.Nil, ErrGrantWrongAdmin
+    }
+    if !grant.RevokedAt.IsZero() {
+        m.mu.Unlock()
+        return uuid.Nil, ErrGrantRevoked
+    }
+    now := time.Now().UTC()
+    if now.After(grant.ExpiresAt) {
+        m.mu.Unlock()
+        return uuid.Nil, ErrGrantExpired
+    }
+    grant.UseCount++
+    grant.LastUsedAt = now
+    userID := grant.UserID
+    m.mu.Unlock()
+
+    m.audit(SupportAuditEvent{Action: "used", GrantID: grantID, AdminID: adminID, UserID: userID, Path: path})
+    return userID, nil
+}
+
+// Revoke ends a grant before its expiry
+func (m *SupportAccessManager) Revoke(grantID uuid.UUID) error {
+    m.mu.Lock()
+    grant, exists := m.grants[grantID]
+    if !exists {
+        m.mu.Unlock()
+        return ErrGrantNotFound
+    }
+    if grant.RevokedAt.IsZero() {
+        grant.RevokedAt = time.Now().UTC()
+    }
+    adminID, userID := grant.AdminID, grant.UserID
+    m.mu.Unlock()
+
+    m.audit(SupportAuditEvent{Action: "revoked", GrantID: grantID, AdminID: adminID, UserID: userID})
+    return nil
+}
+
+// ListGrants returns all grants, active and past, newest first omitted;
+// callers sort as needed
+func (m *SupportAccessManager) ListGrants() []*SupportGrant {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    grants := make([]*SupportGrant, 0, len(m.grants))
+    for _, grant := range m.grants {
+        copied := *grant
+        grants = append(grants, &copied)
+    }
+    return grants
+}
+
+// sweepLocked drops grants expired for longer than the maximum TTL so
+// the map stays bounded while recent history remains listable
+func (m *SupportAccessManager) sweepLocked(now time.Time) {
+    for id, grant := range m.grants {
+        if now.Sub(grant.ExpiresAt) > maxSupportAccessTTL {
+            delete(m.grants, id)
+        }
+    }
+}
+
+// audit reports one event to the configured callback
+func (m *SupportAccessManager) audit(event SupportAuditEvent) {
+    if m.onAudit != nil {
+        m.onAudit(event)
+    }
+}
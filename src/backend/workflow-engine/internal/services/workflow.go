@@ -3,7 +3,6 @@ package services
 
 import (
     "context"
-    "encoding/json"
     "errors"
     "fmt"
     "time"
@@ -15,7 +14,7 @@ import (
     "github.com/avast/retry-go" // v3.0.0
     "github.com/sony/gobreaker" // v0.5.0
 
-    "workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/internal/models"
 )
 
 // Metrics collectors
@@ -44,6 +43,7 @@ var (
     ErrUnauthorized      = errors.New("unauthorized workflow access")
     ErrWorkflowNotFound  = errors.New("workflow not found")
     ErrCircuitOpen       = errors.New("circuit breaker is open")
+    ErrApprovalRequired  = errors.New("publishing requires an approved review for the current version")
 )
 
 // Constants
@@ -55,21 +55,48 @@ const (
 
 // WorkflowService provides enterprise-grade workflow management capabilities
 type WorkflowService struct {
-    repo        WorkflowRepository
-    engine      WorkflowEngine
-    breaker     *gobreaker.CircuitBreaker
-    tracer      opentracing.Tracer
-    metrics     *prometheus.Registry
+    repo            WorkflowRepository
+    revisions       RevisionStore
+    canary          CanaryRouter
+    approvals       ApprovalStore
+    requireApproval bool
+    engine          WorkflowEngine
+    breaker         *gobreaker.CircuitBreaker
+    tracer          opentracing.Tracer
+    metrics         *prometheus.Registry
 }
 
 // WorkflowRepository defines the interface for workflow persistence
 type WorkflowRepository interface {
     Create(ctx context.Context, workflow *models.Workflow) error
     Get(ctx context.Context, id uuid.UUID) (*models.Workflow, error)
+    List(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.Workflow, int, error)
     Update(ctx context.Context, workflow *models.Workflow) error
     Delete(ctx context.Context, id uuid.UUID) error
 }
 
+// RevisionStore defines the interface for published revision persistence
+type RevisionStore interface {
+    Save(ctx context.Context, revision *models.WorkflowRevision) error
+    GetLatest(ctx context.Context, workflowID uuid.UUID) (*models.WorkflowRevision, error)
+    GetByVersion(ctx context.Context, workflowID uuid.UUID, version int) (*models.WorkflowRevision, error)
+    List(ctx context.Context, workflowID uuid.UUID) ([]*models.WorkflowRevision, error)
+}
+
+// CanaryRouter defines the interface for canary version selection on the
+// execution path
+type CanaryRouter interface {
+    PickVersion(workflowID uuid.UUID, stableVersion int) (version int, canary bool)
+}
+
+// ApprovalStore defines the interface for approval request persistence
+type ApprovalStore interface {
+    Save(ctx context.Context, request *models.ApprovalRequest) error
+    Update(ctx context.Context, request *models.ApprovalRequest) error
+    Get(ctx context.Context, id uuid.UUID) (*models.ApprovalRequest, error)
+    ListByWorkflow(ctx context.Context, workflowID uuid.UUID) ([]*models.ApprovalRequest, error)
+}
+
 // WorkflowEngine defines the interface for workflow execution
 type WorkflowEngine interface {
     Execute(ctx context.Context, workflow *models.Workflow) error
@@ -163,6 +190,391 @@ func (s *WorkflowService) createWorkflowWithRetry(ctx context.Context, userID uu
     return workflow, nil
 }
 
+// GetWorkflow retrieves a single workflow with authorization checks
+func (s *WorkflowService) GetWorkflow(ctx context.Context, userID, workflowID uuid.UUID) (*models.Workflow, error) {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.GetWorkflow")
+    defer span.Finish()
+
+    timer := prometheus.NewTimer(workflowLatency.WithLabelValues("get"))
+    defer timer.ObserveDuration()
+
+    workflow, err := s.repo.Get(ctx, workflowID)
+    if err != nil {
+        workflowOperations.WithLabelValues("get", "failure").Inc()
+        ext.Error.Set(span, true)
+        return nil, fmt.Errorf("%w: %v", ErrWorkflowNotFound, err)
+    }
+
+    if workflow.UserID != userID {
+        workflowOperations.WithLabelValues("get", "unauthorized").Inc()
+        return nil, ErrUnauthorized
+    }
+
+    workflowOperations.WithLabelValues("get", "success").Inc()
+    return workflow, nil
+}
+
+// ListWorkflows retrieves a page of the user's workflows, returning the page
+// and the total number of workflows owned by the user
+func (s *WorkflowService) ListWorkflows(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.Workflow, int, error) {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.ListWorkflows")
+    defer span.Finish()
+
+    timer := prometheus.NewTimer(workflowLatency.WithLabelValues("list"))
+    defer timer.ObserveDuration()
+
+    workflows, total, err := s.repo.List(ctx, userID, offset, limit)
+    if err != nil {
+        workflowOperations.WithLabelValues("list", "failure").Inc()
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return nil, 0, fmt.Errorf("failed to list workflows: %w", err)
+    }
+
+    workflowOperations.WithLabelValues("list", "success").Inc()
+    return workflows, total, nil
+}
+
+// UpdateWorkflow persists changes to an existing workflow with authorization
+// and validation
+func (s *WorkflowService) UpdateWorkflow(ctx context.Context, userID uuid.UUID, workflow *models.Workflow) error {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.UpdateWorkflow")
+    defer span.Finish()
+
+    timer := prometheus.NewTimer(workflowLatency.WithLabelValues("update"))
+    defer timer.ObserveDuration()
+
+    if workflow == nil {
+        return ErrInvalidRequest
+    }
+    if workflow.UserID != userID {
+        workflowOperations.WithLabelValues("update", "unauthorized").Inc()
+        return ErrUnauthorized
+    }
+
+    if err := workflow.Validate(); err != nil {
+        workflowOperations.WithLabelValues("update", "failure").Inc()
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    // Skip persistence when the content hash is unchanged so no-op saves
+    // do not bump Version or churn the repository
+    if current, err := s.repo.Get(ctx, workflow.ID); err == nil {
+        currentHash, err1 := current.Hash()
+        newHash, err2 := workflow.Hash()
+        if err1 == nil && err2 == nil && currentHash == newHash {
+            workflowOperations.WithLabelValues("update", "noop").Inc()
+            return nil
+        }
+    }
+
+    if err := s.repo.Update(ctx, workflow); err != nil {
+        workflowOperations.WithLabelValues("update", "failure").Inc()
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("repository error: %w", err)
+    }
+
+    workflowOperations.WithLabelValues("update", "success").Inc()
+    return nil
+}
+
+// DeleteWorkflow removes a workflow after verifying ownership
+func (s *WorkflowService) DeleteWorkflow(ctx context.Context, userID, workflowID uuid.UUID) error {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.DeleteWorkflow")
+    defer span.Finish()
+
+    timer := prometheus.NewTimer(workflowLatency.WithLabelValues("delete"))
+    defer timer.ObserveDuration()
+
+    // Ownership check before destructive operations
+    if _, err := s.GetWorkflow(ctx, userID, workflowID); err != nil {
+        workflowOperations.WithLabelValues("delete", "failure").Inc()
+        return err
+    }
+
+    if err := s.repo.Delete(ctx, workflowID); err != nil {
+        workflowOperations.WithLabelValues("delete", "failure").Inc()
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("repository error: %w", err)
+    }
+
+    workflowOperations.WithLabelValues("delete", "success").Inc()
+    return nil
+}
+
+// UpdateLayout applies node positions and canvas metadata to a workflow
+// and persists it without the full-update validation or version bump,
+// keeping UI autosave cheap
+func (s *WorkflowService) UpdateLayout(ctx context.Context, userID, workflowID uuid.UUID, positions map[uuid.UUID][2]int, canvas map[string]interface{}) error {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.UpdateLayout")
+    defer span.Finish()
+
+    timer := prometheus.NewTimer(workflowLatency.WithLabelValues("layout"))
+    defer timer.ObserveDuration()
+
+    workflow, err := s.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        workflowOperations.WithLabelValues("layout", "failure").Inc()
+        return err
+    }
+
+    if err := workflow.UpdateLayout(positions, canvas); err != nil {
+        workflowOperations.WithLabelValues("layout", "failure").Inc()
+        return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    if err := s.repo.Update(ctx, workflow); err != nil {
+        workflowOperations.WithLabelValues("layout", "failure").Inc()
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return fmt.Errorf("repository error: %w", err)
+    }
+
+    workflowOperations.WithLabelValues("layout", "success").Inc()
+    return nil
+}
+
+// SetRevisionStore wires the revision store used by the draft/publish model
+func (s *WorkflowService) SetRevisionStore(store RevisionStore) {
+    s.revisions = store
+}
+
+// SetCanaryRouter wires the canary controller that splits execution
+// traffic between the stable and canary revisions of a workflow
+func (s *WorkflowService) SetCanaryRouter(router CanaryRouter) {
+    s.canary = router
+}
+
+// ListRevisions returns the published revision history of a workflow,
+// newest first
+func (s *WorkflowService) ListRevisions(ctx context.Context, userID, workflowID uuid.UUID) ([]*models.WorkflowRevision, error) {
+    if s.revisions == nil {
+        return nil, fmt.Errorf("%w: revisions are not configured", ErrInvalidRequest)
+    }
+
+    // Ownership check before exposing the revision history
+    if _, err := s.GetWorkflow(ctx, userID, workflowID); err != nil {
+        return nil, err
+    }
+
+    return s.revisions.List(ctx, workflowID)
+}
+
+// SetApprovalStore wires the approval store and toggles whether publishing
+// is gated on an approved review
+func (s *WorkflowService) SetApprovalStore(store ApprovalStore, required bool) {
+    s.approvals = store
+    s.requireApproval = required
+}
+
+// SubmitForReview creates a pending approval request for the current
+// version of a workflow
+func (s *WorkflowService) SubmitForReview(ctx context.Context, userID, workflowID uuid.UUID, approvers []uuid.UUID) (*models.ApprovalRequest, error) {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.SubmitForReview")
+    defer span.Finish()
+
+    if s.approvals == nil {
+        return nil, fmt.Errorf("%w: approvals are not configured", ErrInvalidRequest)
+    }
+
+    workflow, err := s.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        return nil, err
+    }
+
+    request, err := models.NewApprovalRequest(workflow, userID, approvers)
+    if err != nil {
+        workflowOperations.WithLabelValues("review", "failure").Inc()
+        return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    if err := s.approvals.Save(ctx, request); err != nil {
+        workflowOperations.WithLabelValues("review", "failure").Inc()
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return nil, fmt.Errorf("failed to save approval request: %w", err)
+    }
+
+    workflowOperations.WithLabelValues("review", "success").Inc()
+    span.SetTag("approval_id", request.ID.String())
+    return request, nil
+}
+
+// DecideApproval records an approve or reject decision on a pending
+// approval request, forming the audit trail of who approved what version
+func (s *WorkflowService) DecideApproval(ctx context.Context, approverID, approvalID uuid.UUID, approve bool, reason string) (*models.ApprovalRequest, error) {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.DecideApproval")
+    defer span.Finish()
+
+    if s.approvals == nil {
+        return nil, fmt.Errorf("%w: approvals are not configured", ErrInvalidRequest)
+    }
+
+    request, err := s.approvals.Get(ctx, approvalID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return nil, fmt.Errorf("approval request not found: %w", err)
+    }
+
+    if approve {
+        err = request.Approve(approverID, reason)
+    } else {
+        err = request.Reject(approverID, reason)
+    }
+    if err != nil {
+        workflowOperations.WithLabelValues("approval", "failure").Inc()
+        return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    if err := s.approvals.Update(ctx, request); err != nil {
+        workflowOperations.WithLabelValues("approval", "failure").Inc()
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return nil, fmt.Errorf("failed to persist approval decision: %w", err)
+    }
+
+    workflowOperations.WithLabelValues("approval", request.Status).Inc()
+    return request, nil
+}
+
+// ListApprovals returns the approval audit history of a workflow
+func (s *WorkflowService) ListApprovals(ctx context.Context, userID, workflowID uuid.UUID) ([]*models.ApprovalRequest, error) {
+    if s.approvals == nil {
+        return nil, fmt.Errorf("%w: approvals are not configured", ErrInvalidRequest)
+    }
+
+    // Ownership check before exposing the audit trail
+    if _, err := s.GetWorkflow(ctx, userID, workflowID); err != nil {
+        return nil, err
+    }
+
+    return s.approvals.ListByWorkflow(ctx, workflowID)
+}
+
+// checkPublishApproval enforces the optional approval gate before a
+// workflow version can be published
+func (s *WorkflowService) checkPublishApproval(ctx context.Context, workflow *models.Workflow) error {
+    if !s.requireApproval || s.approvals == nil {
+        return nil
+    }
+
+    hash, err := workflow.Hash()
+    if err != nil {
+        return fmt.Errorf("failed to hash workflow: %w", err)
+    }
+
+    requests, err := s.approvals.ListByWorkflow(ctx, workflow.ID)
+    if err != nil {
+        return fmt.Errorf("failed to load approval history: %w", err)
+    }
+
+    for _, request := range requests {
+        if request.IsApprovedFor(hash) {
+            return nil
+        }
+    }
+
+    return ErrApprovalRequired
+}
+
+// PublishWorkflow snapshots the current draft into an immutable revision
+// and activates it. Scheduled and in-flight executions keep running the
+// previously published revision; subsequent draft edits have no effect
+// until the next publish.
+func (s *WorkflowService) PublishWorkflow(ctx context.Context, userID, workflowID uuid.UUID) (*models.WorkflowRevision, error) {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.PublishWorkflow")
+    defer span.Finish()
+
+    timer := prometheus.NewTimer(workflowLatency.WithLabelValues("publish"))
+    defer timer.ObserveDuration()
+
+    workflow, err := s.GetWorkflow(ctx, userID, workflowID)
+    if err != nil {
+        return nil, err
+    }
+
+    if err := s.validateWorkflow(ctx, workflow); err != nil {
+        workflowOperations.WithLabelValues("publish", "failure").Inc()
+        return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+    }
+
+    if err := s.checkPublishApproval(ctx, workflow); err != nil {
+        workflowOperations.WithLabelValues("publish", "unapproved").Inc()
+        return nil, err
+    }
+
+    revision, err := models.NewWorkflowRevision(workflow, userID)
+    if err != nil {
+        workflowOperations.WithLabelValues("publish", "failure").Inc()
+        return nil, fmt.Errorf("failed to create revision: %w", err)
+    }
+
+    if s.revisions != nil {
+        if err := s.revisions.Save(ctx, revision); err != nil {
+            workflowOperations.WithLabelValues("publish", "failure").Inc()
+            ext.Error.Set(span, true)
+            span.SetTag("error", err.Error())
+            return nil, fmt.Errorf("failed to save revision: %w", err)
+        }
+    }
+
+    if workflow.Status != "active" {
+        if err := workflow.UpdateStatus("active"); err != nil {
+            workflowOperations.WithLabelValues("publish", "failure").Inc()
+            return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+        }
+    }
+    workflow.MarkPublished()
+
+    if err := s.repo.Update(ctx, workflow); err != nil {
+        workflowOperations.WithLabelValues("publish", "failure").Inc()
+        ext.Error.Set(span, true)
+        span.SetTag("error", err.Error())
+        return nil, fmt.Errorf("repository error: %w", err)
+    }
+
+    workflowOperations.WithLabelValues("publish", "success").Inc()
+    span.SetTag("revision_id", revision.ID.String())
+    return revision, nil
+}
+
+// GetPublishedWorkflow returns the workflow as of its latest published
+// revision; executions use this so draft edits never affect live runs.
+// When a canary activation is in flight the canary router decides per
+// call whether the stable or the canary revision is served.
+func (s *WorkflowService) GetPublishedWorkflow(ctx context.Context, workflowID uuid.UUID) (*models.Workflow, error) {
+    span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.GetPublishedWorkflow")
+    defer span.Finish()
+
+    if s.revisions != nil {
+        revision, err := s.revisions.GetLatest(ctx, workflowID)
+        if err == nil {
+            if s.canary != nil {
+                version, canary := s.canary.PickVersion(workflowID, revision.Version)
+                span.SetTag("canary", canary)
+                if version != revision.Version {
+                    routed, err := s.revisions.GetByVersion(ctx, workflowID, version)
+                    if err == nil {
+                        return routed.Restore()
+                    }
+                    // Routed version is missing: serve the latest revision
+                }
+            }
+            return revision.Restore()
+        }
+    }
+
+    // No published revision yet: fall back to the stored workflow
+    workflow, err := s.repo.Get(ctx, workflowID)
+    if err != nil {
+        ext.Error.Set(span, true)
+        return nil, fmt.Errorf("%w: %v", ErrWorkflowNotFound, err)
+    }
+    return workflow, nil
+}
+
 // validateWorkflow performs comprehensive workflow validation
 func (s *WorkflowService) validateWorkflow(ctx context.Context, workflow *models.Workflow) error {
     span, ctx := opentracing.StartSpanFromContext(ctx, "WorkflowService.validateWorkflow")
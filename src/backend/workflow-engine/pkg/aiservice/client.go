@@ -0,0 +1,190 @@
+// Package aiservice provides the typed client the engine uses to talk
+// to the AI service, wrapping the generated gRPC stubs with deadline
+// and retry defaults so callers never handle raw connections
+package aiservice
+
+//go:generate protoc --go_out=../.. --go_opt=module=github.com/organization/workflow-engine --go-grpc_out=../.. --go-grpc_opt=module=github.com/organization/workflow-engine ../../api/proto/ai/v1/ai_service.proto
+
+import (
+    "context"
+    "errors"
+    "io"
+    "time"
+
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    aipb "github.com/organization/workflow-engine/api/proto/ai/v1"
+)
+
+// Common client errors
+var (
+    ErrNoConnection = errors.New("ai service connection not configured")
+)
+
+// Client defaults
+const (
+    defaultDeadline   = 30 * time.Second
+    defaultMaxRetries = 3
+    defaultRetryWait  = 500 * time.Millisecond
+)
+
+// Config holds client behavior overrides; zero values use the defaults
+type Config struct {
+    Deadline   time.Duration
+    MaxRetries int
+    RetryWait  time.Duration
+}
+
+// Client is a typed AI service client with deadline and retry defaults
+type Client struct {
+    service aipb.AIServiceClient
+    config  Config
+}
+
+// NewClient wraps a managed connection in a typed client
+func NewClient(conn *grpc.ClientConn, config Config) *Client {
+    if config.Deadline <= 0 {
+        config.Deadline = defaultDeadline
+    }
+    if config.MaxRetries <= 0 {
+        config.MaxRetries = defaultMaxRetries
+    }
+    if config.RetryWait <= 0 {
+        config.RetryWait = defaultRetryWait
+    }
+
+    return &Client{
+        service: aipb.NewAIServiceClient(conn),
+        config:  config,
+    }
+}
+
+// ExecuteTask runs one task to completion, retrying transient transport
+// failures with a linear backoff. Deadlines cover the whole call
+// including retries so a slow service cannot stall a node indefinitely
+func (c *Client) ExecuteTask(ctx context.Context, request *aipb.TaskRequest) (*aipb.TaskResponse, error) {
+    ctx, cancel := context.WithTimeout(ctx, c.config.Deadline)
+    defer cancel()
+
+    var lastErr error
+    for attempt := 0; attempt < c.config.MaxRetries; attempt++ {
+        if attempt > 0 {
+            select {
+            case <-ctx.Done():
+                return nil, lastErr
+            case <-time.After(time.Duration(attempt) * c.config.RetryWait):
+            }
+        }
+
+        response, err := c.service.ExecuteTask(ctx, request)
+        if err == nil {
+            return response, nil
+        }
+        lastErr = err
+        if !retryable(err) {
+            return nil, err
+        }
+    }
+    return nil, lastErr
+}
+
+// ExecuteBatch runs many coalesced tasks as a single call, returning one
+// response per request in order. Retries follow the same transient
+// transport failure policy as ExecuteTask
+func (c *Client) ExecuteBatch(ctx context.Context, requests []*aipb.TaskRequest) ([]*aipb.TaskResponse, error) {
+    ctx, cancel := context.WithTimeout(ctx, c.config.Deadline)
+    defer cancel()
+
+    batch := &aipb.BatchTaskRequest{Requests: requests}
+
+    var lastErr error
+    for attempt := 0; attempt < c.config.MaxRetries; attempt++ {
+        if attempt > 0 {
+            select {
+            case <-ctx.Done():
+                return nil, lastErr
+            case <-time.After(time.Duration(attempt) * c.config.RetryWait):
+            }
+        }
+
+        response, err := c.service.ExecuteBatch(ctx, batch)
+        if err == nil {
+            return response.Responses, nil
+        }
+        lastErr = err
+        if !retryable(err) {
+            return nil, err
+        }
+    }
+    return nil, lastErr
+}
+
+// StreamTask starts a streaming task. The deadline covers the whole
+// stream; established streams are not retried because partial output
+// may already have been consumed
+func (c *Client) StreamTask(ctx context.Context, request *aipb.TaskRequest) (aipb.AIService_StreamTaskClient, context.CancelFunc, error) {
+    ctx, cancel := context.WithTimeout(ctx, c.config.Deadline)
+
+    stream, err := c.service.StreamTask(ctx, request)
+    if err != nil {
+        cancel()
+        return nil, nil, err
+    }
+    return stream, cancel, nil
+}
+
+// StreamSink receives streamed deltas from a task; the engine's chunk
+// streams implement it
+type StreamSink interface {
+    Publish(ctx context.Context, delta string) error
+    Close(err error)
+}
+
+// StreamInto runs a streaming task and pumps every delta into the sink,
+// closing it when the stream ends. A sink that blocks in Publish holds
+// up Recv, so consumer backpressure propagates to the service through
+// gRPC flow control. The final chunk is returned for token accounting
+func (c *Client) StreamInto(ctx context.Context, request *aipb.TaskRequest, sink StreamSink) (*aipb.TaskChunk, error) {
+    stream, cancel, err := c.StreamTask(ctx, request)
+    if err != nil {
+        sink.Close(err)
+        return nil, err
+    }
+    defer cancel()
+
+    var final *aipb.TaskChunk
+    for {
+        chunk, err := stream.Recv()
+        if errors.Is(err, io.EOF) {
+            sink.Close(nil)
+            return final, nil
+        }
+        if err != nil {
+            sink.Close(err)
+            return final, err
+        }
+
+        if chunk.Delta != "" {
+            if err := sink.Publish(ctx, chunk.Delta); err != nil {
+                sink.Close(err)
+                return final, err
+            }
+        }
+        if chunk.Done {
+            final = chunk
+        }
+    }
+}
+
+// retryable reports whether the error is a transient transport failure
+// worth retrying; application errors surface to the caller immediately
+func retryable(err error) bool {
+    switch status.Code(err) {
+    case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+        return true
+    default:
+        return false
+    }
+}
@@ -0,0 +1,145 @@
+// Package chaos provides an environment-gated fault-injection facility
+// for resilience testing. Rules inject latency, errors, or panics into
+// node executors, repository calls, and gRPC clients at configured rates
+// so breaker and retry paths can be exercised in staging.
+package chaos
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "math/rand"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+
+    "google.golang.org/grpc"
+)
+
+// Injection targets understood by the engine's hook points
+const (
+    TargetNode       = "node"
+    TargetRepository = "repository"
+    TargetGRPC       = "grpc"
+)
+
+// Fault modes
+const (
+    ModeLatency = "latency"
+    ModeError   = "error"
+    ModePanic   = "panic"
+)
+
+// ErrInjected is returned by error-mode injections
+var ErrInjected = errors.New("chaos: injected fault")
+
+// rule is one configured fault: mode applied at rate, with latency for
+// latency-mode rules
+type rule struct {
+    mode    string
+    rate    float64
+    latency time.Duration
+}
+
+var (
+    mu    sync.RWMutex
+    rules map[string][]rule
+)
+
+// Configure parses a rule specification of the form
+// "target:mode:rate[:latency]" with rules separated by semicolons, e.g.
+// "node:error:0.05;grpc:latency:0.1:200ms". An empty specification
+// disables injection entirely
+func Configure(spec string) error {
+    parsed := make(map[string][]rule)
+
+    for _, entry := range strings.Split(spec, ";") {
+        entry = strings.TrimSpace(entry)
+        if entry == "" {
+            continue
+        }
+
+        parts := strings.Split(entry, ":")
+        if len(parts) < 3 {
+            return fmt.Errorf("chaos: invalid rule %q", entry)
+        }
+
+        target, mode := parts[0], parts[1]
+        switch target {
+        case TargetNode, TargetRepository, TargetGRPC:
+        default:
+            return fmt.Errorf("chaos: unknown target %q", target)
+        }
+
+        rate, err := strconv.ParseFloat(parts[2], 64)
+        if err != nil || rate <= 0 || rate > 1 {
+            return fmt.Errorf("chaos: invalid rate in rule %q", entry)
+        }
+
+        injected := rule{mode: mode, rate: rate}
+        switch mode {
+        case ModeLatency:
+            if len(parts) < 4 {
+                return fmt.Errorf("chaos: latency rule %q needs a duration", entry)
+            }
+            latency, err := time.ParseDuration(parts[3])
+            if err != nil {
+                return fmt.Errorf("chaos: invalid duration in rule %q", entry)
+            }
+            injected.latency = latency
+        case ModeError, ModePanic:
+        default:
+            return fmt.Errorf("chaos: unknown mode %q", mode)
+        }
+
+        parsed[target] = append(parsed[target], injected)
+    }
+
+    mu.Lock()
+    rules = parsed
+    mu.Unlock()
+    return nil
+}
+
+// Enabled reports whether any injection rules are configured
+func Enabled() bool {
+    mu.RLock()
+    defer mu.RUnlock()
+    return len(rules) > 0
+}
+
+// Inject applies the configured faults for a target: latency rules sleep,
+// error rules return ErrInjected, and panic rules panic
+func Inject(target string) error {
+    mu.RLock()
+    targetRules := rules[target]
+    mu.RUnlock()
+
+    for _, r := range targetRules {
+        if rand.Float64() >= r.rate {
+            continue
+        }
+
+        switch r.mode {
+        case ModeLatency:
+            time.Sleep(r.latency)
+        case ModeError:
+            return fmt.Errorf("%w: %s", ErrInjected, target)
+        case ModePanic:
+            panic(fmt.Sprintf("chaos: injected panic for target %s", target))
+        }
+    }
+    return nil
+}
+
+// UnaryClientInterceptor injects configured gRPC faults ahead of every
+// outgoing unary call
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+    return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+        if err := Inject(TargetGRPC); err != nil {
+            return err
+        }
+        return invoker(ctx, method, req, reply, cc, opts...)
+    }
+}
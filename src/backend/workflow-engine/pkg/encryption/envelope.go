@@ -0,0 +1,162 @@
+// Package encryption provides envelope encryption of persisted node outputs
+// and metadata with per-tenant keys for encryption-at-rest requirements.
+package encryption
+
+import (
+    "crypto/aes"
+    "crypto/cipher"
+    "crypto/rand"
+    "encoding/base64"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "sync"
+)
+
+// Common encryption errors
+var (
+    ErrKeyNotFound      = errors.New("tenant key not found")
+    ErrInvalidCiphertext = errors.New("invalid ciphertext")
+)
+
+// Envelope format constants
+const (
+    envelopeVersion = 1
+    dekSize         = 32 // AES-256 data encryption key size in bytes
+)
+
+// KeyProvider supplies and wraps per-tenant key material. Implementations
+// typically delegate wrapping to an external KMS.
+type KeyProvider interface {
+    // WrapKey encrypts a data encryption key under the tenant's key
+    WrapKey(tenantID string, dek []byte) ([]byte, error)
+    // UnwrapKey decrypts a wrapped data encryption key for the tenant
+    UnwrapKey(tenantID string, wrapped []byte) ([]byte, error)
+}
+
+// Envelope is the serialized representation of an encrypted payload together
+// with its wrapped data encryption key
+type Envelope struct {
+    Version    int    `json:"version"`
+    TenantID   string `json:"tenant_id"`
+    WrappedDEK string `json:"wrapped_dek"`
+    Nonce      string `json:"nonce"`
+    Ciphertext string `json:"ciphertext"`
+}
+
+// EnvelopeEncryptor performs envelope encryption of payloads with per-tenant
+// keys and thread-safe operations
+type EnvelopeEncryptor struct {
+    mu       sync.RWMutex
+    provider KeyProvider
+}
+
+// NewEnvelopeEncryptor creates a new envelope encryptor backed by the given
+// key provider
+func NewEnvelopeEncryptor(provider KeyProvider) (*EnvelopeEncryptor, error) {
+    if provider == nil {
+        return nil, errors.New("key provider is required")
+    }
+
+    return &EnvelopeEncryptor{provider: provider}, nil
+}
+
+// Encrypt encrypts a payload for a tenant, generating a fresh data encryption
+// key and wrapping it under the tenant's key
+func (e *EnvelopeEncryptor) Encrypt(tenantID string, payload map[string]interface{}) (*Envelope, error) {
+    plaintext, err := json.Marshal(payload)
+    if err != nil {
+        return nil, fmt.Errorf("failed to marshal payload: %w", err)
+    }
+
+    // Generate a fresh data encryption key for this payload
+    dek := make([]byte, dekSize)
+    if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+        return nil, fmt.Errorf("failed to generate data key: %w", err)
+    }
+
+    gcm, err := newGCM(dek)
+    if err != nil {
+        return nil, err
+    }
+
+    nonce := make([]byte, gcm.NonceSize())
+    if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+        return nil, fmt.Errorf("failed to generate nonce: %w", err)
+    }
+
+    ciphertext := gcm.Seal(nil, nonce, plaintext, []byte(tenantID))
+
+    wrapped, err := e.provider.WrapKey(tenantID, dek)
+    if err != nil {
+        return nil, fmt.Errorf("failed to wrap data key: %w", err)
+    }
+
+    return &Envelope{
+        Version:    envelopeVersion,
+        TenantID:   tenantID,
+        WrappedDEK: base64.StdEncoding.EncodeToString(wrapped),
+        Nonce:      base64.StdEncoding.EncodeToString(nonce),
+        Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+    }, nil
+}
+
+// Decrypt unwraps the envelope's data encryption key and decrypts the payload
+func (e *EnvelopeEncryptor) Decrypt(envelope *Envelope) (map[string]interface{}, error) {
+    if envelope == nil {
+        return nil, ErrInvalidCiphertext
+    }
+
+    wrapped, err := base64.StdEncoding.DecodeString(envelope.WrappedDEK)
+    if err != nil {
+        return nil, fmt.Errorf("%w: invalid wrapped key encoding", ErrInvalidCiphertext)
+    }
+
+    dek, err := e.provider.UnwrapKey(envelope.TenantID, wrapped)
+    if err != nil {
+        return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+    }
+
+    gcm, err := newGCM(dek)
+    if err != nil {
+        return nil, err
+    }
+
+    nonce, err := base64.StdEncoding.DecodeString(envelope.Nonce)
+    if err != nil || len(nonce) != gcm.NonceSize() {
+        return nil, fmt.Errorf("%w: invalid nonce", ErrInvalidCiphertext)
+    }
+
+    ciphertext, err := base64.StdEncoding.DecodeString(envelope.Ciphertext)
+    if err != nil {
+        return nil, fmt.Errorf("%w: invalid ciphertext encoding", ErrInvalidCiphertext)
+    }
+
+    plaintext, err := gcm.Open(nil, nonce, ciphertext, []byte(envelope.TenantID))
+    if err != nil {
+        return nil, fmt.Errorf("%w: decryption failed", ErrInvalidCiphertext)
+    }
+
+    var payload map[string]interface{}
+    if err := json.Unmarshal(plaintext, &payload); err != nil {
+        return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+    }
+
+    return payload, nil
+}
+
+// newGCM constructs an AES-GCM cipher from a data encryption key
+func newGCM(dek []byte) (cipher.AEAD, error) {
+    block, err := aes.NewCipher(dek)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create cipher: %w", err)
+    }
+
+    gcm, err := cipher.NewGCM(block)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create GCM: %w", err)
+    }
+
+    return gcm, nil
+}
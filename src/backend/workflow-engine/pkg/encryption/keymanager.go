@@ -0,0 +1,289 @@
+// Package encryption provides envelope encryption of persisted node outputs
+// and metadata with per-tenant keys for encryption-at-rest requirements.
+package encryption
+
+import (
+    "context"
+    "crypto/rand"
+    "errors"
+    "fmt"
+    "io"
+    "sync"
+)
+
+// Key management constants
+const (
+    // kekSize is the master key encryption key size in bytes (AES-256)
+    kekSize = 32
+)
+
+// Common key management errors
+var (
+    ErrInvalidMasterKey = errors.New("master key must be 32 bytes")
+    ErrNoCurrentKEK     = errors.New("no current key encryption key")
+)
+
+// tenantKey is one tenant's data encryption key, held wrapped under a
+// specific KEK version
+type tenantKey struct {
+    wrapped    []byte
+    kekVersion int
+}
+
+// KeyManager implements KeyProvider with a two-level hierarchy: each
+// tenant gets a data encryption key (DEK) wrapped under a versioned
+// master key encryption key (KEK). Rotating the KEK re-wraps every
+// tenant DEK without changing the DEKs themselves, so existing
+// ciphertext stays readable and rotation needs no downtime
+type KeyManager struct {
+    mu         sync.RWMutex
+    master     []byte
+    keks       map[int][]byte
+    currentKEK int
+    tenants    map[string]*tenantKey
+    store      KeyStore
+}
+
+// NewKeyManager creates a key manager with the given master KEK
+func NewKeyManager(masterKey []byte) (*KeyManager, error) {
+    if len(masterKey) != kekSize {
+        return nil, ErrInvalidMasterKey
+    }
+
+    kek := make([]byte, kekSize)
+    copy(kek, masterKey)
+
+    return &KeyManager{
+        master:     kek,
+        keks:       map[int][]byte{1: kek},
+        currentKEK: 1,
+        tenants:    make(map[string]*tenantKey),
+    }, nil
+}
+
+// SetStore attaches persistent storage for wrapped keys and loads any
+// previously persisted KEKs and tenant DEKs, so envelopes written
+// before a restart or by another replica stay decryptable. Rotated
+// KEKs are unsealed with the master key they were persisted under
+func (m *KeyManager) SetStore(ctx context.Context, store KeyStore) error {
+    keks, err := store.LoadKEKs(ctx)
+    if err != nil {
+        return fmt.Errorf("failed to load persisted KEKs: %w", err)
+    }
+    tenantKeys, err := store.LoadTenantKeys(ctx)
+    if err != nil {
+        return fmt.Errorf("failed to load persisted tenant keys: %w", err)
+    }
+
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    for _, stored := range keks {
+        kek, err := openWithKey(m.master, stored.SealedKEK, kekSealContext(stored.Version))
+        if err != nil {
+            return fmt.Errorf("failed to unseal KEK version %d: %w", stored.Version, err)
+        }
+        m.keks[stored.Version] = kek
+        if stored.Version > m.currentKEK {
+            m.currentKEK = stored.Version
+        }
+    }
+    for _, stored := range tenantKeys {
+        if _, exists := m.keks[stored.KEKVersion]; !exists {
+            return fmt.Errorf("tenant %s DEK references missing KEK version %d", stored.TenantID, stored.KEKVersion)
+        }
+        m.tenants[stored.TenantID] = &tenantKey{
+            wrapped:    stored.WrappedDEK,
+            kekVersion: stored.KEKVersion,
+        }
+    }
+
+    m.store = store
+    return nil
+}
+
+// WrapKey encrypts a payload data key under the tenant's DEK, creating
+// the tenant DEK on first use
+func (m *KeyManager) WrapKey(tenantID string, dek []byte) ([]byte, error) {
+    tenantDEK, err := m.tenantDEK(tenantID, true)
+    if err != nil {
+        return nil, err
+    }
+    return sealWithKey(tenantDEK, dek, []byte(tenantID))
+}
+
+// UnwrapKey decrypts a payload data key with the tenant's DEK
+func (m *KeyManager) UnwrapKey(tenantID string, wrapped []byte) ([]byte, error) {
+    tenantDEK, err := m.tenantDEK(tenantID, false)
+    if err != nil {
+        return nil, err
+    }
+    return openWithKey(tenantDEK, wrapped, []byte(tenantID))
+}
+
+// RotateKEK installs a new master KEK and re-wraps every tenant DEK
+// under it. A nil newKEK generates a random one. The previous KEK
+// versions are retained so nothing wrapped before the call is lost if
+// re-wrapping is interrupted
+func (m *KeyManager) RotateKEK(newKEK []byte) (int, int, error) {
+    if newKEK == nil {
+        newKEK = make([]byte, kekSize)
+        if _, err := io.ReadFull(rand.Reader, newKEK); err != nil {
+            return 0, 0, fmt.Errorf("failed to generate key: %w", err)
+        }
+    }
+    if len(newKEK) != kekSize {
+        return 0, 0, ErrInvalidMasterKey
+    }
+
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    version := m.currentKEK + 1
+
+    // Persist the new KEK, sealed under the process master key, before
+    // anything is wrapped under it; an unpersisted KEK would evaporate
+    // on restart along with every DEK re-wrapped below
+    if m.store != nil {
+        sealed, err := sealWithKey(m.master, newKEK, kekSealContext(version))
+        if err != nil {
+            return 0, 0, fmt.Errorf("failed to seal new KEK: %w", err)
+        }
+        err = m.store.SaveKEK(context.Background(), StoredKEK{Version: version, SealedKEK: sealed})
+        if err != nil {
+            return 0, 0, fmt.Errorf("failed to persist new KEK: %w", err)
+        }
+    }
+
+    m.keks[version] = newKEK
+
+    rewrapped := 0
+    for tenantID, key := range m.tenants {
+        oldKEK, exists := m.keks[key.kekVersion]
+        if !exists {
+            return 0, 0, fmt.Errorf("missing KEK version %d for tenant %s", key.kekVersion, tenantID)
+        }
+        plainDEK, err := openWithKey(oldKEK, key.wrapped, []byte(tenantID))
+        if err != nil {
+            return 0, 0, fmt.Errorf("failed to unwrap DEK for tenant %s: %w", tenantID, err)
+        }
+        wrapped, err := sealWithKey(newKEK, plainDEK, []byte(tenantID))
+        if err != nil {
+            return 0, 0, fmt.Errorf("failed to re-wrap DEK for tenant %s: %w", tenantID, err)
+        }
+        if m.store != nil {
+            err := m.store.SaveTenantKey(context.Background(), StoredTenantKey{
+                TenantID:   tenantID,
+                KEKVersion: version,
+                WrappedDEK: wrapped,
+            })
+            if err != nil {
+                return 0, 0, fmt.Errorf("failed to persist re-wrapped key for tenant %s: %w", tenantID, err)
+            }
+        }
+        key.wrapped = wrapped
+        key.kekVersion = version
+        rewrapped++
+    }
+
+    m.currentKEK = version
+    return version, rewrapped, nil
+}
+
+// CurrentKEKVersion returns the active master key version
+func (m *KeyManager) CurrentKEKVersion() int {
+    m.mu.RLock()
+    defer m.mu.RUnlock()
+    return m.currentKEK
+}
+
+// TenantCount returns how many tenants have provisioned DEKs
+func (m *KeyManager) TenantCount() int {
+    m.mu.RLock()
+    defer m.mu.RUnlock()
+    return len(m.tenants)
+}
+
+// tenantDEK returns the tenant's plaintext DEK, provisioning one when
+// create is set and none exists
+func (m *KeyManager) tenantDEK(tenantID string, create bool) ([]byte, error) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    kek, exists := m.keks[m.currentKEK]
+    if !exists {
+        return nil, ErrNoCurrentKEK
+    }
+
+    key, exists := m.tenants[tenantID]
+    if !exists {
+        if !create {
+            return nil, ErrKeyNotFound
+        }
+
+        plainDEK := make([]byte, dekSize)
+        if _, err := io.ReadFull(rand.Reader, plainDEK); err != nil {
+            return nil, fmt.Errorf("failed to generate tenant key: %w", err)
+        }
+        wrapped, err := sealWithKey(kek, plainDEK, []byte(tenantID))
+        if err != nil {
+            return nil, err
+        }
+
+        // The wrapped DEK must be durable before anything is encrypted
+        // under it; an unpersisted DEK would make every envelope written
+        // with it unreadable after a restart
+        if m.store != nil {
+            err := m.store.SaveTenantKey(context.Background(), StoredTenantKey{
+                TenantID:   tenantID,
+                KEKVersion: m.currentKEK,
+                WrappedDEK: wrapped,
+            })
+            if err != nil {
+                return nil, fmt.Errorf("failed to persist tenant key: %w", err)
+            }
+        }
+
+        m.tenants[tenantID] = &tenantKey{wrapped: wrapped, kekVersion: m.currentKEK}
+        return plainDEK, nil
+    }
+
+    kek, exists = m.keks[key.kekVersion]
+    if !exists {
+        return nil, fmt.Errorf("missing KEK version %d", key.kekVersion)
+    }
+    return openWithKey(kek, key.wrapped, []byte(tenantID))
+}
+
+// sealWithKey encrypts plaintext under a key with AES-GCM and a fresh
+// nonce prepended to the result
+func sealWithKey(key, plaintext, additionalData []byte) ([]byte, error) {
+    gcm, err := newGCM(key)
+    if err != nil {
+        return nil, err
+    }
+
+    nonce := make([]byte, gcm.NonceSize())
+    if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+        return nil, fmt.Errorf("failed to generate nonce: %w", err)
+    }
+
+    return append(nonce, gcm.Seal(nil, nonce, plaintext, additionalData)...), nil
+}
+
+// openWithKey decrypts a sealWithKey result
+func openWithKey(key, sealed, additionalData []byte) ([]byte, error) {
+    gcm, err := newGCM(key)
+    if err != nil {
+        return nil, err
+    }
+    if len(sealed) < gcm.NonceSize() {
+        return nil, ErrInvalidCiphertext
+    }
+
+    plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], additionalData)
+    if err != nil {
+        return nil, fmt.Errorf("%w: decryption failed", ErrInvalidCiphertext)
+    }
+    return plaintext, nil
+}
@@ -0,0 +1,39 @@
+// Package encryption provides envelope encryption of persisted node outputs
+// and metadata with per-tenant keys for encryption-at-rest requirements.
+package encryption
+
+import (
+    "context"
+    "fmt"
+)
+
+// StoredTenantKey is one persisted tenant DEK, wrapped under a specific
+// KEK version
+type StoredTenantKey struct {
+    TenantID   string
+    KEKVersion int
+    WrappedDEK []byte
+}
+
+// StoredKEK is one persisted key encryption key, sealed under the
+// process master key so it can be reloaded after a restart
+type StoredKEK struct {
+    Version   int
+    SealedKEK []byte
+}
+
+// KeyStore persists wrapped tenant DEKs and rotated KEKs. Without
+// persistence a restarted process would provision fresh DEKs and every
+// previously written envelope would become undecryptable
+type KeyStore interface {
+    SaveTenantKey(ctx context.Context, key StoredTenantKey) error
+    SaveKEK(ctx context.Context, kek StoredKEK) error
+    LoadKEKs(ctx context.Context) ([]StoredKEK, error)
+    LoadTenantKeys(ctx context.Context) ([]StoredTenantKey, error)
+}
+
+// kekSealContext builds the additional data binding a sealed KEK to its
+// version
+func kekSealContext(version int) []byte {
+    return []byte(fmt.Sprintf("kek-v%d", version))
+}
@@ -0,0 +1,290 @@
+// Package httpmiddleware provides the Fiber middleware shared by the
+// engine's HTTP servers: tracing, metrics, request IDs, authentication,
+// and rate limiting, each driven by its own configuration struct.
+package httpmiddleware
+
+import (
+    "net/http"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/gofiber/fiber/v2" // v2.50.0
+    "github.com/google/uuid" // v1.3.0
+    "github.com/opentracing/opentracing-go" // v1.2.0
+    "github.com/opentracing/opentracing-go/ext"
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// RequestIDHeader is the header carrying the request ID in and out
+const RequestIDHeader = "X-Request-ID"
+
+// Metrics collectors
+var (
+    httpRequestDuration = prometheus.NewHistogramVec(
+        prometheus.HistogramOpts{
+            Name: "http_request_duration_seconds",
+            Help: "Duration of HTTP requests",
+            Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+        },
+        []string{"service", "method", "route", "status"},
+    )
+
+    httpRequestsInFlight = prometheus.NewGaugeVec(
+        prometheus.GaugeOpts{
+            Name: "http_requests_in_flight",
+            Help: "Number of HTTP requests currently being served",
+        },
+        []string{"service"},
+    )
+
+    httpRequestPayloadBytes = prometheus.NewHistogramVec(
+        prometheus.HistogramOpts{
+            Name: "http_request_payload_bytes",
+            Help: "Size of HTTP request bodies",
+            Buckets: []float64{1024, 10 * 1024, 100 * 1024, 1 << 20, 10 << 20, 100 << 20},
+        },
+        []string{"service", "method", "route"},
+    )
+
+    httpResponsePayloadBytes = prometheus.NewHistogramVec(
+        prometheus.HistogramOpts{
+            Name: "http_response_payload_bytes",
+            Help: "Size of HTTP response bodies",
+            Buckets: []float64{1024, 10 * 1024, 100 * 1024, 1 << 20, 10 << 20, 100 << 20},
+        },
+        []string{"service", "method", "route"},
+    )
+
+    rateLimitedTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "http_rate_limited_requests_total",
+            Help: "Total number of requests rejected by rate limiting",
+        },
+        []string{"service"},
+    )
+
+    registerOnce sync.Once
+)
+
+// registerCollectors registers the package collectors exactly once against
+// the default registry
+func registerCollectors() {
+    registerOnce.Do(func() {
+        prometheus.MustRegister(httpRequestDuration, httpRequestsInFlight,
+            httpRequestPayloadBytes, httpResponsePayloadBytes, rateLimitedTotal)
+    })
+}
+
+// TracingConfig holds configuration for the tracing middleware
+type TracingConfig struct {
+    // Tracer defaults to the global tracer when nil
+    Tracer opentracing.Tracer
+    // OperationPrefix is prepended to the route in span operation names
+    OperationPrefix string
+}
+
+// Tracing returns middleware that opens a server span per request,
+// continuing a trace propagated in the request headers when present
+func Tracing(config TracingConfig) fiber.Handler {
+    return func(c *fiber.Ctx) error {
+        tracer := config.Tracer
+        if tracer == nil {
+            tracer = opentracing.GlobalTracer()
+        }
+
+        carrier := opentracing.HTTPHeadersCarrier{}
+        c.Request().Header.VisitAll(func(key, value []byte) {
+            carrier.Set(string(key), string(value))
+        })
+
+        operation := config.OperationPrefix + c.Method() + " " + c.Path()
+        var span opentracing.Span
+        if parent, err := tracer.Extract(opentracing.HTTPHeaders, carrier); err == nil {
+            span = tracer.StartSpan(operation, ext.RPCServerOption(parent))
+        } else {
+            span = tracer.StartSpan(operation)
+        }
+        defer span.Finish()
+
+        ext.HTTPMethod.Set(span, c.Method())
+        ext.HTTPUrl.Set(span, c.OriginalURL())
+        c.Locals("span", span)
+        c.SetUserContext(opentracing.ContextWithSpan(c.UserContext(), span))
+
+        err := c.Next()
+
+        ext.HTTPStatusCode.Set(span, uint16(c.Response().StatusCode()))
+        if err != nil || c.Response().StatusCode() >= http.StatusInternalServerError {
+            ext.Error.Set(span, true)
+        }
+        return err
+    }
+}
+
+// MetricsConfig holds configuration for the metrics middleware
+type MetricsConfig struct {
+    // Service labels the series so multiple servers can share the collectors
+    Service string
+}
+
+// Metrics returns middleware that records request duration, in-flight
+// gauges, and request/response payload sizes labeled by route
+func Metrics(config MetricsConfig) fiber.Handler {
+    registerCollectors()
+
+    return func(c *fiber.Ctx) error {
+        httpRequestsInFlight.WithLabelValues(config.Service).Inc()
+        start := time.Now()
+
+        // Read the declared length up front; streamed bodies are not
+        // buffered here just to measure them
+        requestBytes := c.Request().Header.ContentLength()
+
+        err := c.Next()
+
+        httpRequestsInFlight.WithLabelValues(config.Service).Dec()
+
+        // Label by the registered route pattern, not the raw path, to
+        // keep series cardinality bounded
+        route := c.Route().Path
+        if route == "" {
+            route = "unmatched"
+        }
+        httpRequestDuration.WithLabelValues(
+            config.Service,
+            c.Method(),
+            route,
+            http.StatusText(c.Response().StatusCode()),
+        ).Observe(time.Since(start).Seconds())
+
+        if requestBytes > 0 {
+            httpRequestPayloadBytes.WithLabelValues(config.Service, c.Method(), route).
+                Observe(float64(requestBytes))
+        }
+        httpResponsePayloadBytes.WithLabelValues(config.Service, c.Method(), route).
+            Observe(float64(len(c.Response().Body())))
+
+        return err
+    }
+}
+
+// RequestIDConfig holds configuration for the request ID middleware
+type RequestIDConfig struct {
+    // Header overrides the default X-Request-ID header name
+    Header string
+}
+
+// RequestID returns middleware that honors an incoming request ID or
+// generates one, storing it in locals and echoing it in the response
+func RequestID(config RequestIDConfig) fiber.Handler {
+    header := config.Header
+    if header == "" {
+        header = RequestIDHeader
+    }
+
+    return func(c *fiber.Ctx) error {
+        requestID := c.Get(header)
+        if requestID == "" {
+            requestID = uuid.New().String()
+        }
+
+        c.Locals("requestID", requestID)
+        c.Set(header, requestID)
+        return c.Next()
+    }
+}
+
+// AuthConfig holds configuration for the authentication middleware
+type AuthConfig struct {
+    // Validate checks a bearer token and returns the authenticated user ID
+    Validate func(token string) (uuid.UUID, error)
+    // ExemptPaths lists path prefixes served without authentication
+    ExemptPaths []string
+}
+
+// Auth returns middleware that validates the bearer token on every
+// request and stores the authenticated user ID in locals
+func Auth(config AuthConfig) fiber.Handler {
+    return func(c *fiber.Ctx) error {
+        for _, prefix := range config.ExemptPaths {
+            if strings.HasPrefix(c.Path(), prefix) {
+                return c.Next()
+            }
+        }
+
+        authorization := c.Get(fiber.HeaderAuthorization)
+        token := strings.TrimPrefix(authorization, "Bearer ")
+        if token == "" || token == authorization {
+            return fiber.NewError(http.StatusUnauthorized, "missing bearer token")
+        }
+
+        userID, err := config.Validate(token)
+        if err != nil {
+            return fiber.NewError(http.StatusUnauthorized, "invalid token")
+        }
+
+        c.Locals("userID", userID)
+        return c.Next()
+    }
+}
+
+// RateLimitConfig holds configuration for the rate limiting middleware
+type RateLimitConfig struct {
+    // Max requests allowed per key within Window
+    Max    int
+    Window time.Duration
+    // Service labels the rejection metric
+    Service string
+    // KeyFunc derives the limiting key; defaults to client IP
+    KeyFunc func(c *fiber.Ctx) string
+}
+
+// rateWindow tracks request counts for one key in the current window
+type rateWindow struct {
+    count   int
+    resetAt time.Time
+}
+
+// RateLimit returns middleware enforcing a fixed-window request limit per
+// key, rejecting excess requests with 429 and a Retry-After header
+func RateLimit(config RateLimitConfig) fiber.Handler {
+    registerCollectors()
+
+    if config.Max <= 0 {
+        config.Max = 100
+    }
+    if config.Window <= 0 {
+        config.Window = time.Minute
+    }
+    if config.KeyFunc == nil {
+        config.KeyFunc = func(c *fiber.Ctx) string { return c.IP() }
+    }
+
+    var mu sync.Mutex
+    windows := make(map[string]*rateWindow)
+
+    return func(c *fiber.Ctx) error {
+        key := config.KeyFunc(c)
+        now := time.Now()
+
+        mu.Lock()
+        window, exists := windows[key]
+        if !exists || now.After(window.resetAt) {
+            window = &rateWindow{resetAt: now.Add(config.Window)}
+            windows[key] = window
+        }
+        window.count++
+        exceeded := window.count > config.Max
+        retryAfter := time.Until(window.resetAt)
+        mu.Unlock()
+
+        if exceeded {
+            rateLimitedTotal.WithLabelValues(config.Service).Inc()
+            c.Set(fiber.HeaderRetryAfter, retryAfter.Round(time.Second).String())
+            return fiber.NewError(http.StatusTooManyRequests, "rate limit exceeded")
+        }
+
+        return c.Next()
+    }
+}
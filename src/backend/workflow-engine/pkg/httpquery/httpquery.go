@@ -0,0 +1,246 @@
+// Package httpquery provides consistent parsing of pagination, sorting,
+// and filtering query parameters for list endpoints, with cursor and
+// Link-header generation and helpers translating filters to SQL.
+package httpquery
+
+import (
+    "encoding/base64"
+    "errors"
+    "fmt"
+    "net/url"
+    "strconv"
+    "strings"
+)
+
+// Pagination defaults
+const (
+    defaultLimit = 20
+    maxLimit     = 100
+)
+
+// Common query errors
+var (
+    ErrInvalidCursor = errors.New("invalid pagination cursor")
+    ErrInvalidSort   = errors.New("invalid sort parameter")
+    ErrInvalidFilter = errors.New("invalid filter parameter")
+)
+
+// filterOperators maps the accepted filter operators to their SQL form
+var filterOperators = map[string]string{
+    "eq":   "=",
+    "ne":   "<>",
+    "lt":   "<",
+    "lte":  "<=",
+    "gt":   ">",
+    "gte":  ">=",
+    "like": "LIKE",
+}
+
+// SortField is one field of a sort expression
+type SortField struct {
+    Field      string
+    Descending bool
+}
+
+// Filter is one parsed filter expression in the field:op:value form
+type Filter struct {
+    Field    string
+    Operator string
+    Value    string
+}
+
+// Params are the parsed list parameters of a request
+type Params struct {
+    Limit  int
+    Offset int
+    Cursor string
+    Sort   []SortField
+    Filter []Filter
+}
+
+// Options bounds what a list endpoint accepts. AllowedSort and
+// AllowedFilter map query field names to their backing columns; empty
+// maps reject sorting or filtering entirely
+type Options struct {
+    DefaultLimit  int
+    MaxLimit      int
+    AllowedSort   map[string]string
+    AllowedFilter map[string]string
+}
+
+// Parse extracts the typed list parameters from query values.
+// Sort takes comma-separated fields with a leading dash for descending
+// (sort=-created_at,name); filters take the field:op:value form
+// (filter=status:eq:active) and may repeat
+func Parse(query url.Values, opts Options) (Params, error) {
+    if opts.DefaultLimit <= 0 {
+        opts.DefaultLimit = defaultLimit
+    }
+    if opts.MaxLimit <= 0 {
+        opts.MaxLimit = maxLimit
+    }
+
+    params := Params{Limit: opts.DefaultLimit}
+
+    if raw := query.Get("limit"); raw != "" {
+        limit, err := strconv.Atoi(raw)
+        if err != nil || limit < 1 {
+            return Params{}, fmt.Errorf("%w: limit %q", ErrInvalidFilter, raw)
+        }
+        if limit > opts.MaxLimit {
+            limit = opts.MaxLimit
+        }
+        params.Limit = limit
+    }
+
+    if cursor := query.Get("cursor"); cursor != "" {
+        offset, err := DecodeCursor(cursor)
+        if err != nil {
+            return Params{}, err
+        }
+        params.Cursor = cursor
+        params.Offset = offset
+    }
+
+    if raw := query.Get("sort"); raw != "" {
+        for _, field := range strings.Split(raw, ",") {
+            field = strings.TrimSpace(field)
+            if field == "" {
+                continue
+            }
+            entry := SortField{Field: field}
+            if strings.HasPrefix(field, "-") {
+                entry.Field = field[1:]
+                entry.Descending = true
+            }
+            if _, allowed := opts.AllowedSort[entry.Field]; !allowed {
+                return Params{}, fmt.Errorf("%w: %s", ErrInvalidSort, entry.Field)
+            }
+            params.Sort = append(params.Sort, entry)
+        }
+    }
+
+    for _, raw := range query["filter"] {
+        parts := strings.SplitN(raw, ":", 3)
+        if len(parts) != 3 {
+            return Params{}, fmt.Errorf("%w: %q is not field:op:value", ErrInvalidFilter, raw)
+        }
+        if _, allowed := opts.AllowedFilter[parts[0]]; !allowed {
+            return Params{}, fmt.Errorf("%w: field %s", ErrInvalidFilter, parts[0])
+        }
+        if _, known := filterOperators[parts[1]]; !known {
+            return Params{}, fmt.Errorf("%w: operator %s", ErrInvalidFilter, parts[1])
+        }
+        params.Filter = append(params.Filter, Filter{
+            Field:    parts[0],
+            Operator: parts[1],
+            Value:    parts[2],
+        })
+    }
+
+    return params, nil
+}
+
+// EncodeCursor produces the opaque cursor for an offset
+func EncodeCursor(offset int) string {
+    return base64.URLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// DecodeCursor recovers the offset from an opaque cursor
+func DecodeCursor(cursor string) (int, error) {
+    decoded, err := base64.URLEncoding.DecodeString(cursor)
+    if err != nil {
+        return 0, ErrInvalidCursor
+    }
+    offset, err := strconv.Atoi(string(decoded))
+    if err != nil || offset < 0 {
+        return 0, ErrInvalidCursor
+    }
+    return offset, nil
+}
+
+// NextCursor returns the cursor for the page after this one, or empty
+// when the listing is exhausted
+func (p Params) NextCursor(total int) string {
+    next := p.Offset + p.Limit
+    if next >= total {
+        return ""
+    }
+    return EncodeCursor(next)
+}
+
+// PrevCursor returns the cursor for the page before this one, or empty
+// on the first page
+func (p Params) PrevCursor() string {
+    if p.Offset <= 0 {
+        return ""
+    }
+    prev := p.Offset - p.Limit
+    if prev < 0 {
+        prev = 0
+    }
+    return EncodeCursor(prev)
+}
+
+// LinkHeader builds the RFC 5988 Link header value for the page, with
+// next and prev relations as applicable
+func (p Params) LinkHeader(baseURL string, total int) string {
+    links := make([]string, 0, 2)
+    if next := p.NextCursor(total); next != "" {
+        links = append(links, fmt.Sprintf(`<%s?cursor=%s&limit=%d>; rel="next"`, baseURL, next, p.Limit))
+    }
+    if prev := p.PrevCursor(); prev != "" {
+        links = append(links, fmt.Sprintf(`<%s?cursor=%s&limit=%d>; rel="prev"`, baseURL, prev, p.Limit))
+    }
+    return strings.Join(links, ", ")
+}
+
+// WhereClause translates the parsed filters into a parameterized SQL
+// fragment using the columns declared in the allowlist, starting
+// placeholders at $start. An empty filter list yields an empty clause
+func WhereClause(filters []Filter, columns map[string]string, start int) (string, []interface{}, error) {
+    if len(filters) == 0 {
+        return "", nil, nil
+    }
+
+    clauses := make([]string, 0, len(filters))
+    args := make([]interface{}, 0, len(filters))
+    for i, filter := range filters {
+        column, allowed := columns[filter.Field]
+        if !allowed {
+            return "", nil, fmt.Errorf("%w: field %s", ErrInvalidFilter, filter.Field)
+        }
+        operator, known := filterOperators[filter.Operator]
+        if !known {
+            return "", nil, fmt.Errorf("%w: operator %s", ErrInvalidFilter, filter.Operator)
+        }
+        clauses = append(clauses, fmt.Sprintf("%s %s $%d", column, operator, start+i))
+        args = append(args, filter.Value)
+    }
+
+    return strings.Join(clauses, " AND "), args, nil
+}
+
+// OrderClause translates the parsed sort fields into a SQL ORDER BY
+// fragment using the columns declared in the allowlist. An empty sort
+// yields an empty clause
+func OrderClause(sort []SortField, columns map[string]string) (string, error) {
+    if len(sort) == 0 {
+        return "", nil
+    }
+
+    clauses := make([]string, 0, len(sort))
+    for _, field := range sort {
+        column, allowed := columns[field.Field]
+        if !allowed {
+            return "", fmt.Errorf("%w: %s", ErrInvalidSort, field.Field)
+        }
+        direction := "ASC"
+        if field.Descending {
+            direction = "DESC"
+        }
+        clauses = append(clauses, column+" "+direction)
+    }
+
+    return strings.Join(clauses, ", "), nil
+}
@@ -0,0 +1,256 @@
+// Package logging provides structured JSON logging shared across services,
+// with dynamic level control via API or signal, per-module levels, sampling
+// of repetitive entries, and consistent service identity fields.
+package logging
+
+import (
+    "errors"
+    "fmt"
+    "os"
+    "os/signal"
+    "sync"
+    "syscall"
+
+    "go.uber.org/zap"     // v1.26.0
+    "go.uber.org/zap/zapcore" // v1.26.0
+    "gopkg.in/natefinch/lumberjack.v2" // v2.2.1
+)
+
+// Common logging errors
+var (
+    ErrInvalidLevel   = errors.New("invalid log level")
+    ErrUnknownModule  = errors.New("unknown logging module")
+)
+
+// Sampling defaults applied when the config leaves them zero
+const (
+    defaultSampleInitial    = 100
+    defaultSampleThereafter = 100
+)
+
+// Rotation defaults applied when the config leaves them zero
+const (
+    defaultMaxSizeMB  = 100
+    defaultMaxAgeDays = 14
+    defaultMaxBackups = 5
+)
+
+// Config holds configuration for the shared logger
+type Config struct {
+    // Service and Version are attached to every entry
+    Service string
+    Version string
+    // Level is the initial global level (debug, info, warn, error)
+    Level string
+    // ModuleLevels sets initial per-module levels overriding the global
+    ModuleLevels map[string]string
+    // SampleInitial/SampleThereafter control sampling of repetitive
+    // entries: the first SampleInitial identical entries per second pass,
+    // then one in every SampleThereafter
+    SampleInitial    int
+    SampleThereafter int
+    // FilePath enables logging to a rotated file in addition to stdout.
+    // The file sink is skipped silently when the path is not writable
+    // (e.g. read-only container filesystems)
+    FilePath   string
+    MaxSizeMB  int
+    MaxAgeDays int
+    MaxBackups int
+    Compress   bool
+}
+
+// Logger wraps a zap logger with dynamic global and per-module levels
+type Logger struct {
+    mu      sync.RWMutex
+    base    *zap.Logger
+    level   zap.AtomicLevel
+    modules map[string]zap.AtomicLevel
+}
+
+// New creates a structured JSON logger with the consistent field set and
+// sampling configured
+func New(config Config) (*Logger, error) {
+    level := zap.NewAtomicLevel()
+    if config.Level != "" {
+        parsed, err := zapcore.ParseLevel(config.Level)
+        if err != nil {
+            return nil, fmt.Errorf("%w: %s", ErrInvalidLevel, config.Level)
+        }
+        level.SetLevel(parsed)
+    }
+
+    if config.SampleInitial <= 0 {
+        config.SampleInitial = defaultSampleInitial
+    }
+    if config.SampleThereafter <= 0 {
+        config.SampleThereafter = defaultSampleThereafter
+    }
+
+    encoderConfig := zap.NewProductionEncoderConfig()
+    encoderConfig.TimeKey = "timestamp"
+    encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+    encoder := zapcore.NewJSONEncoder(encoderConfig)
+    core := zapcore.NewCore(encoder, zapcore.Lock(os.Stdout), level)
+
+    // Add a size/age rotated file sink when configured and writable
+    if sink := fileSink(config); sink != nil {
+        core = zapcore.NewTee(core, zapcore.NewCore(encoder, sink, level))
+    }
+
+    // Sample repetitive entries so error storms don't flood the sink
+    core = zapcore.NewSamplerWithOptions(core,
+        1e9, config.SampleInitial, config.SampleThereafter)
+
+    base := zap.New(core, zap.AddCaller()).With(
+        zap.String("service", config.Service),
+        zap.String("version", config.Version),
+    )
+
+    logger := &Logger{
+        base:    base,
+        level:   level,
+        modules: make(map[string]zap.AtomicLevel),
+    }
+
+    for module, moduleLevel := range config.ModuleLevels {
+        if err := logger.SetModuleLevel(module, moduleLevel); err != nil {
+            return nil, err
+        }
+    }
+
+    return logger, nil
+}
+
+// fileSink builds the rotated file sink, returning nil when no path is
+// configured or the path is not writable so containerized deployments
+// fall back to stdout-only logging
+func fileSink(config Config) zapcore.WriteSyncer {
+    if config.FilePath == "" {
+        return nil
+    }
+
+    // Probe writability before handing the path to the rotator; a
+    // read-only filesystem must not take the service down
+    probe, err := os.OpenFile(config.FilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+    if err != nil {
+        return nil
+    }
+    probe.Close()
+
+    if config.MaxSizeMB <= 0 {
+        config.MaxSizeMB = defaultMaxSizeMB
+    }
+    if config.MaxAgeDays <= 0 {
+        config.MaxAgeDays = defaultMaxAgeDays
+    }
+    if config.MaxBackups <= 0 {
+        config.MaxBackups = defaultMaxBackups
+    }
+
+    return zapcore.AddSync(&lumberjack.Logger{
+        Filename:   config.FilePath,
+        MaxSize:    config.MaxSizeMB,
+        MaxAge:     config.MaxAgeDays,
+        MaxBackups: config.MaxBackups,
+        Compress:   config.Compress,
+    })
+}
+
+// Zap returns the underlying zap logger for direct use
+func (l *Logger) Zap() *zap.Logger {
+    return l.base
+}
+
+// Module returns a named logger whose level can be adjusted independently
+// of the global level
+func (l *Logger) Module(name string) *zap.Logger {
+    l.mu.Lock()
+    moduleLevel, exists := l.modules[name]
+    if !exists {
+        moduleLevel = zap.NewAtomicLevelAt(l.level.Level())
+        l.modules[name] = moduleLevel
+    }
+    l.mu.Unlock()
+
+    return l.base.Named(name).WithOptions(zap.IncreaseLevel(moduleLevel))
+}
+
+// WithRequest returns a logger carrying the per-request identity fields
+func (l *Logger) WithRequest(tenant, requestID string) *zap.Logger {
+    return l.base.With(
+        zap.String("tenant", tenant),
+        zap.String("request_id", requestID),
+    )
+}
+
+// Level returns the current global level
+func (l *Logger) Level() string {
+    return l.level.Level().String()
+}
+
+// SetLevel changes the global level at runtime
+func (l *Logger) SetLevel(levelName string) error {
+    parsed, err := zapcore.ParseLevel(levelName)
+    if err != nil {
+        return fmt.Errorf("%w: %s", ErrInvalidLevel, levelName)
+    }
+    l.level.SetLevel(parsed)
+    return nil
+}
+
+// SetModuleLevel changes a module's level at runtime, creating the module
+// entry when it does not yet exist
+func (l *Logger) SetModuleLevel(module, levelName string) error {
+    parsed, err := zapcore.ParseLevel(levelName)
+    if err != nil {
+        return fmt.Errorf("%w: %s", ErrInvalidLevel, levelName)
+    }
+
+    l.mu.Lock()
+    defer l.mu.Unlock()
+
+    moduleLevel, exists := l.modules[module]
+    if !exists {
+        moduleLevel = zap.NewAtomicLevel()
+        l.modules[module] = moduleLevel
+    }
+    moduleLevel.SetLevel(parsed)
+    return nil
+}
+
+// ModuleLevels returns the current per-module levels
+func (l *Logger) ModuleLevels() map[string]string {
+    l.mu.RLock()
+    defer l.mu.RUnlock()
+
+    levels := make(map[string]string, len(l.modules))
+    for module, moduleLevel := range l.modules {
+        levels[module] = moduleLevel.Level().String()
+    }
+    return levels
+}
+
+// HandleSignals toggles the global level on signals: SIGUSR1 switches to
+// debug, SIGUSR2 restores the level the logger started with
+func (l *Logger) HandleSignals() {
+    initial := l.level.Level()
+    signals := make(chan os.Signal, 1)
+    signal.Notify(signals, syscall.SIGUSR1, syscall.SIGUSR2)
+
+    go func() {
+        for sig := range signals {
+            switch sig {
+            case syscall.SIGUSR1:
+                l.level.SetLevel(zapcore.DebugLevel)
+            case syscall.SIGUSR2:
+                l.level.SetLevel(initial)
+            }
+        }
+    }()
+}
+
+// Sync flushes buffered entries
+func (l *Logger) Sync() error {
+    return l.base.Sync()
+}
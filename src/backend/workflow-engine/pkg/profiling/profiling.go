@@ -0,0 +1,132 @@
+// Package profiling provides optional continuous CPU and heap profile
+// capture, shipped to a Pyroscope-compatible ingest endpoint for
+// performance investigations. Capture is toggleable at runtime.
+package profiling
+
+import (
+    "bytes"
+    "context"
+    "fmt"
+    "net/http"
+    "runtime/pprof"
+    "sync/atomic"
+    "time"
+)
+
+// Default profiling configuration values
+const (
+    defaultCaptureInterval = time.Minute
+    defaultCPUDuration     = 10 * time.Second
+    defaultShipTimeout     = 10 * time.Second
+)
+
+// Config configures the continuous profiler
+type Config struct {
+    // Service is the application name profiles are filed under
+    Service string
+
+    // Endpoint is the base URL of the Pyroscope-compatible ingest API
+    Endpoint string
+
+    Interval    time.Duration
+    CPUDuration time.Duration
+}
+
+// Profiler periodically captures CPU and heap profiles and ships them to
+// the configured endpoint while enabled
+type Profiler struct {
+    service     string
+    endpoint    string
+    interval    time.Duration
+    cpuDuration time.Duration
+    client      *http.Client
+    enabled     atomic.Bool
+}
+
+// New creates a profiler; capture stays off until Enable is called
+func New(cfg Config) *Profiler {
+    if cfg.Interval <= 0 {
+        cfg.Interval = defaultCaptureInterval
+    }
+    if cfg.CPUDuration <= 0 {
+        cfg.CPUDuration = defaultCPUDuration
+    }
+
+    return &Profiler{
+        service:     cfg.Service,
+        endpoint:    cfg.Endpoint,
+        interval:    cfg.Interval,
+        cpuDuration: cfg.CPUDuration,
+        client:      &http.Client{Timeout: defaultShipTimeout},
+    }
+}
+
+// Enable turns on continuous capture
+func (p *Profiler) Enable() { p.enabled.Store(true) }
+
+// Disable turns off continuous capture
+func (p *Profiler) Disable() { p.enabled.Store(false) }
+
+// Enabled reports whether capture is currently on
+func (p *Profiler) Enabled() bool { return p.enabled.Load() }
+
+// Start begins the capture loop until the context is canceled
+func (p *Profiler) Start(ctx context.Context) {
+    go func() {
+        ticker := time.NewTicker(p.interval)
+        defer ticker.Stop()
+
+        for {
+            select {
+            case <-ctx.Done():
+                return
+            case <-ticker.C:
+                if p.enabled.Load() {
+                    p.capture(ctx)
+                }
+            }
+        }
+    }()
+}
+
+// capture records one CPU and one heap profile and ships them. Profile
+// samples carry goroutine labels (e.g. execution_id) set by the engine,
+// so profiles correlate with executions
+func (p *Profiler) capture(ctx context.Context) {
+    from := time.Now()
+
+    var cpu bytes.Buffer
+    if err := pprof.StartCPUProfile(&cpu); err == nil {
+        select {
+        case <-time.After(p.cpuDuration):
+        case <-ctx.Done():
+        }
+        pprof.StopCPUProfile()
+        p.ship(ctx, "cpu", &cpu, from)
+    }
+
+    var heap bytes.Buffer
+    if profile := pprof.Lookup("heap"); profile != nil {
+        if err := profile.WriteTo(&heap, 0); err == nil {
+            p.ship(ctx, "heap", &heap, from)
+        }
+    }
+}
+
+// ship uploads one pprof-format profile to the ingest endpoint
+func (p *Profiler) ship(ctx context.Context, profileType string, body *bytes.Buffer, from time.Time) {
+    url := fmt.Sprintf("%s/ingest?name=%s.%s&format=pprof&from=%d&until=%d",
+        p.endpoint, p.service, profileType, from.Unix(), time.Now().Unix())
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+    if err != nil {
+        return
+    }
+    req.Header.Set("Content-Type", "application/octet-stream")
+
+    resp, err := p.client.Do(req)
+    if err != nil {
+        return
+    }
+    resp.Body.Close()
+}
@@ -0,0 +1,192 @@
+// Package redaction provides configurable redaction of sensitive data applied
+// before persisting node inputs/outputs and before structured logging,
+// required for workflows processing PII under the GDPR compliance mode.
+package redaction
+
+import (
+    "errors"
+    "fmt"
+    "regexp"
+    "strings"
+    "sync"
+
+    "go.uber.org/zap/zapcore" // v1.26.0
+)
+
+// RedactedValue is the placeholder written in place of redacted data
+const RedactedValue = "[REDACTED]"
+
+// Default field names that are always treated as sensitive
+var defaultSensitiveFields = []string{
+    "password",
+    "secret",
+    "token",
+    "api_key",
+    "authorization",
+    "credit_card",
+    "ssn",
+}
+
+// Common redaction errors
+var (
+    ErrInvalidPattern = errors.New("invalid redaction pattern")
+)
+
+// Config holds configurable redaction rules
+type Config struct {
+    // FieldNames lists field names (case-insensitive) whose values are redacted
+    FieldNames []string
+    // Patterns lists regular expressions whose matches are redacted from
+    // string values
+    Patterns []string
+}
+
+// Redactor applies field name and regex based redaction rules with
+// thread-safe operations
+type Redactor struct {
+    mu       sync.RWMutex
+    fields   map[string]bool
+    patterns []*regexp.Regexp
+}
+
+// NewRedactor creates a new redactor from the provided configuration,
+// combining the configured rules with the built-in sensitive field list
+func NewRedactor(config Config) (*Redactor, error) {
+    fields := make(map[string]bool, len(defaultSensitiveFields)+len(config.FieldNames))
+    for _, name := range defaultSensitiveFields {
+        fields[strings.ToLower(name)] = true
+    }
+    for _, name := range config.FieldNames {
+        fields[strings.ToLower(name)] = true
+    }
+
+    patterns := make([]*regexp.Regexp, 0, len(config.Patterns))
+    for _, pattern := range config.Patterns {
+        compiled, err := regexp.Compile(pattern)
+        if err != nil {
+            return nil, fmt.Errorf("%w: %s: %v", ErrInvalidPattern, pattern, err)
+        }
+        patterns = append(patterns, compiled)
+    }
+
+    return &Redactor{
+        fields:   fields,
+        patterns: patterns,
+    }, nil
+}
+
+// RedactMap returns a deep copy of the payload with sensitive fields and
+// pattern matches replaced by the redaction placeholder
+func (r *Redactor) RedactMap(payload map[string]interface{}) map[string]interface{} {
+    if payload == nil {
+        return nil
+    }
+
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+
+    redacted := make(map[string]interface{}, len(payload))
+    for key, value := range payload {
+        if r.fields[strings.ToLower(key)] {
+            redacted[key] = RedactedValue
+            continue
+        }
+        redacted[key] = r.redactValue(value)
+    }
+
+    return redacted
+}
+
+// RedactString replaces all configured pattern matches in a string
+func (r *Redactor) RedactString(value string) string {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+
+    return r.redactString(value)
+}
+
+// redactValue applies redaction recursively to nested values
+func (r *Redactor) redactValue(value interface{}) interface{} {
+    switch v := value.(type) {
+    case string:
+        return r.redactString(v)
+    case map[string]interface{}:
+        redacted := make(map[string]interface{}, len(v))
+        for key, nested := range v {
+            if r.fields[strings.ToLower(key)] {
+                redacted[key] = RedactedValue
+                continue
+            }
+            redacted[key] = r.redactValue(nested)
+        }
+        return redacted
+    case []interface{}:
+        redacted := make([]interface{}, len(v))
+        for i, item := range v {
+            redacted[i] = r.redactValue(item)
+        }
+        return redacted
+    default:
+        return value
+    }
+}
+
+// redactString applies pattern redaction without acquiring the lock
+func (r *Redactor) redactString(value string) string {
+    for _, pattern := range r.patterns {
+        value = pattern.ReplaceAllString(value, RedactedValue)
+    }
+    return value
+}
+
+// redactingCore wraps a zap core so that sensitive fields are redacted before
+// log entries are written
+type redactingCore struct {
+    zapcore.Core
+    redactor *Redactor
+}
+
+// WrapZapCore returns a zap core that applies the redaction rules to all
+// string fields before they are logged
+func (r *Redactor) WrapZapCore(core zapcore.Core) zapcore.Core {
+    return &redactingCore{Core: core, redactor: r}
+}
+
+// With implements zapcore.Core with redaction applied to attached fields
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+    return &redactingCore{Core: c.Core.With(c.redactFields(fields)), redactor: c.redactor}
+}
+
+// Check implements zapcore.Core
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+    if c.Enabled(entry.Level) {
+        return checked.AddCore(entry, c)
+    }
+    return checked
+}
+
+// Write implements zapcore.Core with redaction applied to entry fields
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+    entry.Message = c.redactor.RedactString(entry.Message)
+    return c.Core.Write(entry, c.redactFields(fields))
+}
+
+// redactFields applies redaction rules to zap fields
+func (c *redactingCore) redactFields(fields []zapcore.Field) []zapcore.Field {
+    redacted := make([]zapcore.Field, len(fields))
+    for i, field := range fields {
+        c.redactor.mu.RLock()
+        sensitive := c.redactor.fields[strings.ToLower(field.Key)]
+        c.redactor.mu.RUnlock()
+
+        if sensitive {
+            field.Type = zapcore.StringType
+            field.String = RedactedValue
+            field.Interface = nil
+        } else if field.Type == zapcore.StringType {
+            field.String = c.redactor.RedactString(field.String)
+        }
+        redacted[i] = field
+    }
+    return redacted
+}
@@ -0,0 +1,93 @@
+package safehttp
+
+import (
+    "io"
+    "net"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// mustParseCIDRs parses a CIDR list or fails the test
+func mustParseCIDRs(t *testing.T, cidrs []string) []*net.IPNet {
+    t.Helper()
+    networks, err := parseCIDRs(cidrs)
+    assert.NoError(t, err)
+    return networks
+}
+
+// TestCheckAddressBlocked tests that dialed addresses in blocked
+// networks are rejected, with and without a port
+func TestCheckAddressBlocked(t *testing.T) {
+    blocked := mustParseCIDRs(t, blockedCIDRs)
+
+    for _, address := range []string{
+        "127.0.0.1:443",
+        "10.1.2.3:80",
+        "169.254.169.254:80",
+        "127.0.0.1", // host-only, no port
+        "[::1]:443",
+        "fe80::1", // IPv6, host-only
+    } {
+        err := checkAddress(address, blocked, nil)
+        assert.ErrorIs(t, err, ErrPrivateAddress, "address %s should be blocked", address)
+    }
+}
+
+// TestCheckAddressPublic tests that public addresses pass
+func TestCheckAddressPublic(t *testing.T) {
+    blocked := mustParseCIDRs(t, blockedCIDRs)
+
+    assert.NoError(t, checkAddress("93.184.216.34:443", blocked, nil))
+    assert.NoError(t, checkAddress("2606:2800:220:1::1", blocked, nil))
+}
+
+// TestCheckAddressAllowlistPrecedence tests that an explicit allowlist
+// entry wins over the blocked networks, and only for its own range
+func TestCheckAddressAllowlistPrecedence(t *testing.T) {
+    blocked := mustParseCIDRs(t, blockedCIDRs)
+    allowed := mustParseCIDRs(t, []string{"10.1.0.0/16", "::1/128"})
+
+    assert.NoError(t, checkAddress("10.1.2.3:80", blocked, allowed))
+    assert.NoError(t, checkAddress("[::1]:8080", blocked, allowed))
+    assert.ErrorIs(t, checkAddress("10.2.0.1:80", blocked, allowed), ErrPrivateAddress,
+        "allowlisting one range should not open the rest of the network")
+}
+
+// TestCheckAddressUnparsableHost tests that hosts that are not IP
+// addresses fail closed; the control hook only ever sees resolved IPs
+func TestCheckAddressUnparsableHost(t *testing.T) {
+    blocked := mustParseCIDRs(t, blockedCIDRs)
+
+    assert.ErrorIs(t, checkAddress("not-an-ip:80", blocked, nil), ErrPrivateAddress)
+}
+
+// readAllCloser adapts a string to the io.ReadCloser limitedBody wraps
+type readAllCloser struct{ io.Reader }
+
+func (readAllCloser) Close() error { return nil }
+
+// TestLimitedBodyUnderCap tests that bodies within the cap read fully
+func TestLimitedBodyUnderCap(t *testing.T) {
+    body := &limitedBody{reader: readAllCloser{strings.NewReader("payload")}, remaining: 1024}
+
+    data, err := io.ReadAll(body)
+    assert.NoError(t, err)
+    assert.Equal(t, "payload", string(data))
+    assert.NoError(t, body.Close())
+}
+
+// TestLimitedBodyOverCap tests that reads past the cap fail with
+// ErrResponseTooLarge rather than buffering unbounded data
+func TestLimitedBodyOverCap(t *testing.T) {
+    payload := strings.Repeat("x", 100)
+    body := &limitedBody{reader: readAllCloser{strings.NewReader(payload)}, remaining: 10}
+
+    _, err := io.ReadAll(body)
+    assert.ErrorIs(t, err, ErrResponseTooLarge)
+
+    // Subsequent reads keep failing instead of resetting the budget
+    _, err = body.Read(make([]byte, 1))
+    assert.ErrorIs(t, err, ErrResponseTooLarge)
+}
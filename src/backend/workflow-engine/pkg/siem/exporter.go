@@ -0,0 +1,319 @@
+// Package siem ships audit and security-relevant events (auth failures,
+// permission denials, admin actions) to a SIEM over syslog/CEF or HTTPS,
+// with buffering and at-least-once delivery through bounded retries.
+package siem
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "net"
+    "net/http"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus" // v1.16.0
+)
+
+// Exporter defaults
+const (
+    defaultBufferSize    = 1024
+    defaultBatchSize     = 50
+    defaultFlushInterval = 5 * time.Second
+    defaultMaxRetries    = 3
+    defaultRetryBackoff  = time.Second
+
+    // cefVendor and cefProduct identify this service in CEF headers
+    cefVendor  = "workflow-engine"
+    cefProduct = "workflow-engine"
+    cefVersion = "1.0"
+)
+
+// Metrics collectors
+var (
+    siemEventsTotal = prometheus.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "workflow_siem_events_total",
+            Help: "Total number of events handled by the SIEM exporter",
+        },
+        []string{"outcome"},
+    )
+
+    siemRegisterOnce sync.Once
+)
+
+// Event is one security-relevant occurrence bound for the SIEM
+type Event struct {
+    Timestamp time.Time         `json:"timestamp"`
+    Category  string            `json:"category"`
+    Name      string            `json:"name"`
+    Severity  int               `json:"severity"`
+    Actor     string            `json:"actor,omitempty"`
+    Target    string            `json:"target,omitempty"`
+    Fields    map[string]string `json:"fields,omitempty"`
+}
+
+// Sink delivers event batches to a SIEM transport
+type Sink interface {
+    Deliver(ctx context.Context, events []Event) error
+    Close() error
+}
+
+// Config tunes the exporter; zero values apply the defaults
+type Config struct {
+    BufferSize    int
+    BatchSize     int
+    FlushInterval time.Duration
+    MaxRetries    int
+    RetryBackoff  time.Duration
+}
+
+// Exporter buffers submitted events and ships them in batches on a
+// background worker. Submission never blocks request handling; when the
+// buffer is full the event is dropped and counted
+type Exporter struct {
+    events chan Event
+    sink   Sink
+    config Config
+    done   chan struct{}
+    wg     sync.WaitGroup
+}
+
+// NewExporter creates and starts an exporter over the sink
+func NewExporter(sink Sink, config Config) *Exporter {
+    if config.BufferSize <= 0 {
+        config.BufferSize = defaultBufferSize
+    }
+    if config.BatchSize <= 0 {
+        config.BatchSize = defaultBatchSize
+    }
+    if config.FlushInterval <= 0 {
+        config.FlushInterval = defaultFlushInterval
+    }
+    if config.MaxRetries <= 0 {
+        config.MaxRetries = defaultMaxRetries
+    }
+    if config.RetryBackoff <= 0 {
+        config.RetryBackoff = defaultRetryBackoff
+    }
+
+    siemRegisterOnce.Do(func() {
+        prometheus.MustRegister(siemEventsTotal)
+    })
+
+    e := &Exporter{
+        events: make(chan Event, config.BufferSize),
+        sink:   sink,
+        config: config,
+        done:   make(chan struct{}),
+    }
+
+    e.wg.Add(1)
+    go e.worker()
+
+    return e
+}
+
+// Submit enqueues one event without blocking; a full buffer drops it
+func (e *Exporter) Submit(event Event) {
+    if event.Timestamp.IsZero() {
+        event.Timestamp = time.Now().UTC()
+    }
+
+    select {
+    case e.events <- event:
+        siemEventsTotal.WithLabelValues("buffered").Inc()
+    default:
+        siemEventsTotal.WithLabelValues("dropped").Inc()
+    }
+}
+
+// Close flushes buffered events and stops the worker
+func (e *Exporter) Close() error {
+    close(e.done)
+    e.wg.Wait()
+    return e.sink.Close()
+}
+
+// worker batches buffered events by size or interval and delivers them
+// with bounded retries
+func (e *Exporter) worker() {
+    defer e.wg.Done()
+
+    ticker := time.NewTicker(e.config.FlushInterval)
+    defer ticker.Stop()
+
+    batch := make([]Event, 0, e.config.BatchSize)
+    for {
+        select {
+        case event := <-e.events:
+            batch = append(batch, event)
+            if len(batch) >= e.config.BatchSize {
+                e.deliver(batch)
+                batch = batch[:0]
+            }
+        case <-ticker.C:
+            if len(batch) > 0 {
+                e.deliver(batch)
+                batch = batch[:0]
+            }
+        case <-e.done:
+            // Drain whatever is still buffered before shutting down
+            for {
+                select {
+                case event := <-e.events:
+                    batch = append(batch, event)
+                default:
+                    if len(batch) > 0 {
+                        e.deliver(batch)
+                    }
+                    return
+                }
+            }
+        }
+    }
+}
+
+// deliver ships one batch, retrying with backoff before giving up
+func (e *Exporter) deliver(batch []Event) {
+    ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+    defer cancel()
+
+    var err error
+    for attempt := 0; attempt < e.config.MaxRetries; attempt++ {
+        if err = e.sink.Deliver(ctx, batch); err == nil {
+            siemEventsTotal.WithLabelValues("delivered").Add(float64(len(batch)))
+            return
+        }
+        time.Sleep(e.config.RetryBackoff * time.Duration(attempt+1))
+    }
+    siemEventsTotal.WithLabelValues("failed").Add(float64(len(batch)))
+}
+
+// SyslogSink writes CEF-formatted events to a syslog collector
+type SyslogSink struct {
+    mu      sync.Mutex
+    network string
+    address string
+    conn    net.Conn
+}
+
+// NewSyslogSink creates a syslog/CEF sink; network is tcp or udp
+func NewSyslogSink(network, address string) *SyslogSink {
+    return &SyslogSink{network: network, address: address}
+}
+
+// Deliver implements Sink, reconnecting as needed
+func (s *SyslogSink) Deliver(ctx context.Context, events []Event) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.conn == nil {
+        conn, err := net.Dial(s.network, s.address)
+        if err != nil {
+            return fmt.Errorf("failed to connect to syslog: %w", err)
+        }
+        s.conn = conn
+    }
+
+    for _, event := range events {
+        if _, err := fmt.Fprintln(s.conn, formatCEF(event)); err != nil {
+            s.conn.Close()
+            s.conn = nil
+            return fmt.Errorf("failed to write syslog event: %w", err)
+        }
+    }
+    return nil
+}
+
+// Close implements Sink
+func (s *SyslogSink) Close() error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.conn != nil {
+        return s.conn.Close()
+    }
+    return nil
+}
+
+// HTTPSSink posts event batches as JSON to a SIEM collection endpoint
+type HTTPSSink struct {
+    endpoint string
+    client   *http.Client
+}
+
+// NewHTTPSSink creates an HTTPS sink; a nil client uses a default with a
+// bounded timeout
+func NewHTTPSSink(endpoint string, client *http.Client) *HTTPSSink {
+    if client == nil {
+        client = &http.Client{Timeout: 10 * time.Second}
+    }
+    return &HTTPSSink{endpoint: endpoint, client: client}
+}
+
+// Deliver implements Sink
+func (s *HTTPSSink) Deliver(ctx context.Context, events []Event) error {
+    payload, err := json.Marshal(events)
+    if err != nil {
+        return fmt.Errorf("failed to encode events: %w", err)
+    }
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(payload))
+    if err != nil {
+        return err
+    }
+    req.Header.Set("Content-Type", "application/json")
+
+    resp, err := s.client.Do(req)
+    if err != nil {
+        return fmt.Errorf("failed to post events: %w", err)
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode >= 300 {
+        return fmt.Errorf("SIEM endpoint returned status %d", resp.StatusCode)
+    }
+    return nil
+}
+
+// Close implements Sink
+func (s *HTTPSSink) Close() error {
+    return nil
+}
+
+// formatCEF renders one event as a CEF line with escaped extensions
+func formatCEF(event Event) string {
+    var extension strings.Builder
+    fmt.Fprintf(&extension, "rt=%d", event.Timestamp.UnixMilli())
+    if event.Actor != "" {
+        fmt.Fprintf(&extension, " suser=%s", escapeCEF(event.Actor))
+    }
+    if event.Target != "" {
+        fmt.Fprintf(&extension, " duser=%s", escapeCEF(event.Target))
+    }
+    for key, value := range event.Fields {
+        fmt.Fprintf(&extension, " %s=%s", key, escapeCEF(value))
+    }
+
+    return fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|%s",
+        cefVendor, cefProduct, cefVersion,
+        escapeCEFHeader(event.Category), escapeCEFHeader(event.Name), event.Severity,
+        extension.String(),
+    )
+}
+
+// escapeCEF escapes extension values per the CEF specification
+func escapeCEF(value string) string {
+    value = strings.ReplaceAll(value, `\`, `\\`)
+    value = strings.ReplaceAll(value, "=", `\=`)
+    return strings.ReplaceAll(value, "\n", `\n`)
+}
+
+// escapeCEFHeader escapes header fields per the CEF specification
+func escapeCEFHeader(value string) string {
+    value = strings.ReplaceAll(value, `\`, `\\`)
+    return strings.ReplaceAll(value, "|", `\|`)
+}
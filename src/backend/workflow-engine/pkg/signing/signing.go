@@ -0,0 +1,72 @@
+// Package signing provides ed25519 signing and verification of exported
+// workflow definitions so operators can prove a definition's provenance.
+package signing
+
+import (
+    "crypto/ed25519"
+    "encoding/base64"
+    "errors"
+)
+
+// Common signing errors
+var (
+    ErrInvalidKey       = errors.New("invalid signing key")
+    ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// Signer produces detached ed25519 signatures over definition payloads
+type Signer struct {
+    key ed25519.PrivateKey
+}
+
+// NewSigner creates a signer from an ed25519 seed or private key
+func NewSigner(key []byte) (*Signer, error) {
+    switch len(key) {
+    case ed25519.SeedSize:
+        return &Signer{key: ed25519.NewKeyFromSeed(key)}, nil
+    case ed25519.PrivateKeySize:
+        private := make(ed25519.PrivateKey, ed25519.PrivateKeySize)
+        copy(private, key)
+        return &Signer{key: private}, nil
+    default:
+        return nil, ErrInvalidKey
+    }
+}
+
+// Sign returns the base64-encoded signature over the payload
+func (s *Signer) Sign(payload []byte) string {
+    return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload))
+}
+
+// PublicKey returns the verification key matching this signer
+func (s *Signer) PublicKey() []byte {
+    return []byte(s.key.Public().(ed25519.PublicKey))
+}
+
+// Verifier checks detached ed25519 signatures produced by a Signer
+type Verifier struct {
+    key ed25519.PublicKey
+}
+
+// NewVerifier creates a verifier from an ed25519 public key
+func NewVerifier(key []byte) (*Verifier, error) {
+    if len(key) != ed25519.PublicKeySize {
+        return nil, ErrInvalidKey
+    }
+
+    public := make(ed25519.PublicKey, ed25519.PublicKeySize)
+    copy(public, key)
+    return &Verifier{key: public}, nil
+}
+
+// Verify checks the base64-encoded signature over the payload
+func (v *Verifier) Verify(payload []byte, signature string) error {
+    decoded, err := base64.StdEncoding.DecodeString(signature)
+    if err != nil {
+        return ErrInvalidSignature
+    }
+    if !ed25519.Verify(v.key, payload, decoded) {
+        return ErrInvalidSignature
+    }
+    return nil
+}
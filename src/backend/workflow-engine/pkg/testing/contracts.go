@@ -0,0 +1,162 @@
+// Package enginetest provides official in-memory and fake implementations
+// of the engine's persistence and execution interfaces, with state
+// inspection helpers, so downstream tests don't need to hand-roll mocks.
+package enginetest
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/google/uuid"
+
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// noopInterceptor satisfies core.ExecutionInterceptor for contract checks
+type noopInterceptor struct{}
+
+func (noopInterceptor) BeforeExecute(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID) error {
+    return nil
+}
+
+func (noopInterceptor) AfterNode(ctx context.Context, node *models.Node, output map[string]interface{}, duration time.Duration) {
+}
+
+func (noopInterceptor) OnError(ctx context.Context, workflow *models.Workflow, node *models.Node, err error) {
+}
+
+func (noopInterceptor) OnComplete(ctx context.Context, workflow *models.Workflow, executionID uuid.UUID, duration time.Duration) {
+}
+
+// ExecutorContract verifies that a core.WorkflowExecutor implementation
+// honors the behavior the engine depends on. Alternate implementations
+// (Temporal adapters, queue-based workers) should call this from their
+// own test suites with a factory producing a fresh instance per run
+func ExecutorContract(t *testing.T, factory func() core.WorkflowExecutor) {
+    t.Run("rejects invalid workflow", func(t *testing.T) {
+        executor := factory()
+
+        if err := executor.ExecuteWorkflow(context.Background(), &models.Workflow{}); err == nil {
+            t.Fatal("expected error executing an invalid workflow")
+        }
+    })
+
+    t.Run("cancel of unknown execution fails", func(t *testing.T) {
+        executor := factory()
+
+        if err := executor.CancelExecution(uuid.New()); err == nil {
+            t.Fatal("expected error canceling an unknown execution")
+        }
+    })
+
+    t.Run("reports positive capacity", func(t *testing.T) {
+        executor := factory()
+
+        if capacity := executor.MaxConcurrentExecutions(); capacity <= 0 {
+            t.Fatalf("expected positive capacity, got %d", capacity)
+        }
+    })
+
+    t.Run("starts with no active executions", func(t *testing.T) {
+        executor := factory()
+
+        if active := executor.ActiveExecutions(); active != 0 {
+            t.Fatalf("expected no active executions, got %d", active)
+        }
+    })
+
+    t.Run("accepts interceptors", func(t *testing.T) {
+        executor := factory()
+
+        // Must not panic, and nil interceptors must be tolerated
+        executor.RegisterInterceptor(noopInterceptor{})
+        executor.RegisterInterceptor(nil)
+    })
+}
+
+// SchedulerContract verifies that a core.WorkflowScheduler implementation
+// honors the behavior the engine depends on
+func SchedulerContract(t *testing.T, factory func() core.WorkflowScheduler) {
+    newWorkflow := func(t *testing.T) *models.Workflow {
+        workflow, err := models.NewWorkflow(uuid.New(), "contract", "scheduler contract workflow")
+        if err != nil {
+            t.Fatalf("failed to create workflow: %v", err)
+        }
+        return workflow
+    }
+
+    scheduleConfig := map[string]interface{}{
+        "type":     "interval",
+        "interval": float64(3600), // seconds
+    }
+
+    t.Run("schedule and unschedule round-trip", func(t *testing.T) {
+        scheduler := factory()
+        workflow := newWorkflow(t)
+
+        if err := scheduler.ScheduleWorkflow(context.Background(), workflow, scheduleConfig); err != nil {
+            t.Fatalf("failed to schedule workflow: %v", err)
+        }
+        if err := scheduler.UnscheduleWorkflow(context.Background(), workflow.ID); err != nil {
+            t.Fatalf("failed to unschedule workflow: %v", err)
+        }
+    })
+
+    t.Run("pause and resume round-trip", func(t *testing.T) {
+        scheduler := factory()
+        workflow := newWorkflow(t)
+
+        if err := scheduler.ScheduleWorkflow(context.Background(), workflow, scheduleConfig); err != nil {
+            t.Fatalf("failed to schedule workflow: %v", err)
+        }
+
+        if err := scheduler.PauseSchedule(context.Background(), workflow.ID); err != nil {
+            t.Fatalf("failed to pause schedule: %v", err)
+        }
+        if paused, err := scheduler.IsSchedulePaused(workflow.ID); err != nil || !paused {
+            t.Fatalf("expected schedule paused, got paused=%v err=%v", paused, err)
+        }
+
+        if err := scheduler.ResumeSchedule(context.Background(), workflow.ID); err != nil {
+            t.Fatalf("failed to resume schedule: %v", err)
+        }
+        if paused, err := scheduler.IsSchedulePaused(workflow.ID); err != nil || paused {
+            t.Fatalf("expected schedule resumed, got paused=%v err=%v", paused, err)
+        }
+    })
+
+    t.Run("replace updates an existing schedule", func(t *testing.T) {
+        scheduler := factory()
+        workflow := newWorkflow(t)
+
+        if err := scheduler.ScheduleWorkflow(context.Background(), workflow, scheduleConfig); err != nil {
+            t.Fatalf("failed to schedule workflow: %v", err)
+        }
+        if err := scheduler.ScheduleWorkflow(context.Background(), workflow, scheduleConfig); err == nil {
+            t.Fatal("expected conflict scheduling an already scheduled workflow")
+        }
+        if err := scheduler.ReplaceSchedule(context.Background(), workflow, scheduleConfig); err != nil {
+            t.Fatalf("failed to replace schedule: %v", err)
+        }
+        if err := scheduler.UnscheduleWorkflow(context.Background(), workflow.ID); err != nil {
+            t.Fatalf("failed to unschedule workflow: %v", err)
+        }
+    })
+
+    t.Run("unschedule of unknown workflow fails", func(t *testing.T) {
+        scheduler := factory()
+
+        if err := scheduler.UnscheduleWorkflow(context.Background(), uuid.New()); err == nil {
+            t.Fatal("expected error unscheduling an unknown workflow")
+        }
+    })
+
+    t.Run("start and stop are idempotent enough to pair", func(t *testing.T) {
+        scheduler := factory()
+
+        scheduler.Start()
+        scheduler.Stop()
+    })
+}
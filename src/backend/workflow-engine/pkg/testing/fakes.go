@@ -0,0 +1,376 @@
+// Package enginetest provides official in-memory and fake implementations
+// of the engine's persistence and execution interfaces, with state
+// inspection helpers, so downstream tests don't need to hand-roll mocks.
+package enginetest
+
+import (
+    "context"
+    "errors"
+    "sync"
+    "time"
+
+    "github.com/google/uuid"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// Common fake errors
+var (
+    ErrNotFound      = errors.New("workflow not found")
+    ErrAlreadyExists = errors.New("workflow already exists")
+)
+
+// MemoryWorkflowRepository is an in-memory WorkflowRepository suitable
+// for unit tests. All methods are safe for concurrent use
+type MemoryWorkflowRepository struct {
+    mu        sync.RWMutex
+    workflows map[uuid.UUID]*models.Workflow
+    forcedErr error
+}
+
+// NewMemoryWorkflowRepository creates an empty in-memory repository
+func NewMemoryWorkflowRepository() *MemoryWorkflowRepository {
+    return &MemoryWorkflowRepository{
+        workflows: make(map[uuid.UUID]*models.Workflow),
+    }
+}
+
+// ForceError makes every subsequent call fail with the given error until
+// cleared with ForceError(nil), for exercising failure paths
+func (r *MemoryWorkflowRepository) ForceError(err error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.forcedErr = err
+}
+
+// Create stores a new workflow
+func (r *MemoryWorkflowRepository) Create(ctx context.Context, workflow *models.Workflow) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    if r.forcedErr != nil {
+        return r.forcedErr
+    }
+    if _, exists := r.workflows[workflow.ID]; exists {
+        return ErrAlreadyExists
+    }
+
+    r.workflows[workflow.ID] = workflow
+    return nil
+}
+
+// Get returns a stored workflow
+func (r *MemoryWorkflowRepository) Get(ctx context.Context, id uuid.UUID) (*models.Workflow, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+
+    if r.forcedErr != nil {
+        return nil, r.forcedErr
+    }
+
+    workflow, exists := r.workflows[id]
+    if !exists {
+        return nil, ErrNotFound
+    }
+    return workflow, nil
+}
+
+// List returns the user's workflows with offset/limit pagination and the
+// total count before pagination
+func (r *MemoryWorkflowRepository) List(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.Workflow, int, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+
+    if r.forcedErr != nil {
+        return nil, 0, r.forcedErr
+    }
+
+    matched := make([]*models.Workflow, 0)
+    for _, workflow := range r.workflows {
+        if workflow.UserID == userID {
+            matched = append(matched, workflow)
+        }
+    }
+    total := len(matched)
+
+    if offset >= len(matched) {
+        return []*models.Workflow{}, total, nil
+    }
+    matched = matched[offset:]
+    if limit > 0 && limit < len(matched) {
+        matched = matched[:limit]
+    }
+    return matched, total, nil
+}
+
+// Update replaces a stored workflow
+func (r *MemoryWorkflowRepository) Update(ctx context.Context, workflow *models.Workflow) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    if r.forcedErr != nil {
+        return r.forcedErr
+    }
+    if _, exists := r.workflows[workflow.ID]; !exists {
+        return ErrNotFound
+    }
+
+    r.workflows[workflow.ID] = workflow
+    return nil
+}
+
+// Delete removes a stored workflow
+func (r *MemoryWorkflowRepository) Delete(ctx context.Context, id uuid.UUID) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    if r.forcedErr != nil {
+        return r.forcedErr
+    }
+    if _, exists := r.workflows[id]; !exists {
+        return ErrNotFound
+    }
+
+    delete(r.workflows, id)
+    return nil
+}
+
+// Len reports how many workflows are stored
+func (r *MemoryWorkflowRepository) Len() int {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    return len(r.workflows)
+}
+
+// Reset removes all stored workflows and clears any forced error
+func (r *MemoryWorkflowRepository) Reset() {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.workflows = make(map[uuid.UUID]*models.Workflow)
+    r.forcedErr = nil
+}
+
+// FakeScheduler records scheduling operations without running anything
+type FakeScheduler struct {
+    mu        sync.Mutex
+    schedules map[uuid.UUID]map[string]interface{}
+    paused    map[uuid.UUID]bool
+    leader    bool
+    forcedErr error
+}
+
+// The fake must satisfy the scheduler contract it stands in for
+var _ core.WorkflowScheduler = (*FakeScheduler)(nil)
+
+// NewFakeScheduler creates an empty fake scheduler
+func NewFakeScheduler() *FakeScheduler {
+    return &FakeScheduler{
+        schedules: make(map[uuid.UUID]map[string]interface{}),
+        paused:    make(map[uuid.UUID]bool),
+    }
+}
+
+// ForceError makes every subsequent call fail with the given error
+func (s *FakeScheduler) ForceError(err error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.forcedErr = err
+}
+
+// ScheduleWorkflow records a schedule
+func (s *FakeScheduler) ScheduleWorkflow(ctx context.Context, workflow *models.Workflow, scheduleConfig map[string]interface{}) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.forcedErr != nil {
+        return s.forcedErr
+    }
+    if _, exists := s.schedules[workflow.ID]; exists {
+        return ErrAlreadyExists
+    }
+    s.schedules[workflow.ID] = scheduleConfig
+    return nil
+}
+
+// ReplaceSchedule records a schedule, overwriting any existing one and
+// clearing a recorded pause
+func (s *FakeScheduler) ReplaceSchedule(ctx context.Context, workflow *models.Workflow, scheduleConfig map[string]interface{}) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.forcedErr != nil {
+        return s.forcedErr
+    }
+    s.schedules[workflow.ID] = scheduleConfig
+    delete(s.paused, workflow.ID)
+    return nil
+}
+
+// UnscheduleWorkflow removes a recorded schedule
+func (s *FakeScheduler) UnscheduleWorkflow(ctx context.Context, workflowID uuid.UUID) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.forcedErr != nil {
+        return s.forcedErr
+    }
+    if _, exists := s.schedules[workflowID]; !exists {
+        return ErrNotFound
+    }
+    delete(s.schedules, workflowID)
+    delete(s.paused, workflowID)
+    return nil
+}
+
+// PauseSchedule marks a recorded schedule paused
+func (s *FakeScheduler) PauseSchedule(ctx context.Context, workflowID uuid.UUID) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.forcedErr != nil {
+        return s.forcedErr
+    }
+    s.paused[workflowID] = true
+    return nil
+}
+
+// ResumeSchedule clears a recorded pause
+func (s *FakeScheduler) ResumeSchedule(ctx context.Context, workflowID uuid.UUID) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.forcedErr != nil {
+        return s.forcedErr
+    }
+    delete(s.paused, workflowID)
+    return nil
+}
+
+// IsSchedulePaused reports whether a schedule is paused
+func (s *FakeScheduler) IsSchedulePaused(workflowID uuid.UUID) (bool, error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.paused[workflowID], nil
+}
+
+// SetCapacityMonitor satisfies the scheduler contract; the fake records
+// nothing since it never fires schedules
+func (s *FakeScheduler) SetCapacityMonitor(monitor *core.CapacityMonitor) {}
+
+// SetLeader records the leadership flag
+func (s *FakeScheduler) SetLeader(leader bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.leader = leader
+}
+
+// IsLeader reports the last recorded leadership flag
+func (s *FakeScheduler) IsLeader() bool {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.leader
+}
+
+// RecomputeNextRuns is a no-op; the fake has no run times to refresh
+func (s *FakeScheduler) RecomputeNextRuns() {}
+
+// Start is a no-op; the fake never fires schedules
+func (s *FakeScheduler) Start() {}
+
+// Stop is a no-op counterpart to Start
+func (s *FakeScheduler) Stop() {}
+
+// Scheduled returns the recorded schedule configuration for a workflow
+func (s *FakeScheduler) Scheduled(workflowID uuid.UUID) (map[string]interface{}, bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    config, exists := s.schedules[workflowID]
+    return config, exists
+}
+
+// ScheduleCount reports how many workflows are scheduled
+func (s *FakeScheduler) ScheduleCount() int {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return len(s.schedules)
+}
+
+// NodeCall records one Execute invocation on a FakeNodeExecutor
+type NodeCall struct {
+    NodeID uuid.UUID
+    Type   models.NodeType
+    Input  map[string]interface{}
+}
+
+// FakeNodeExecutor is a configurable NodeExecutor that records its calls
+type FakeNodeExecutor struct {
+    mu    sync.Mutex
+    calls []NodeCall
+
+    // Result is returned from Execute when Err is nil
+    Result map[string]interface{}
+
+    // Err fails Execute when set
+    Err error
+
+    // ValidateErr fails Validate when set
+    ValidateErr error
+
+    // Delay is slept before Execute returns, for timeout tests
+    Delay time.Duration
+}
+
+// Execute records the call and returns the configured result or error
+func (f *FakeNodeExecutor) Execute(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    f.mu.Lock()
+    f.calls = append(f.calls, NodeCall{NodeID: node.ID, Type: node.Type, Input: input})
+    f.mu.Unlock()
+
+    if f.Delay > 0 {
+        select {
+        case <-time.After(f.Delay):
+        case <-ctx.Done():
+            return nil, ctx.Err()
+        }
+    }
+
+    if f.Err != nil {
+        return nil, f.Err
+    }
+    if f.Result != nil {
+        return f.Result, nil
+    }
+    return map[string]interface{}{}, nil
+}
+
+// Validate returns the configured validation error
+func (f *FakeNodeExecutor) Validate(node *models.Node) error {
+    return f.ValidateErr
+}
+
+// Calls returns a copy of the recorded Execute calls
+func (f *FakeNodeExecutor) Calls() []NodeCall {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    calls := make([]NodeCall, len(f.calls))
+    copy(calls, f.calls)
+    return calls
+}
+
+// CallCount reports how many times Execute ran
+func (f *FakeNodeExecutor) CallCount() int {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    return len(f.calls)
+}
+
+// LastCall returns the most recent Execute call
+func (f *FakeNodeExecutor) LastCall() (NodeCall, bool) {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    if len(f.calls) == 0 {
+        return NodeCall{}, false
+    }
+    return f.calls[len(f.calls)-1], true
+}
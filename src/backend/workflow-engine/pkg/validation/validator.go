@@ -7,7 +7,7 @@ import (
     "fmt"
     "sync"
 
-    "github.com/your-org/workflow-engine/internal/models" // v1.0.0
+    "github.com/organization/workflow-engine/internal/models" // v1.0.0
 )
 
 // ComplianceLevel defines the level of compliance validation required
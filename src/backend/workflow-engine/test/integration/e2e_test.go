@@ -0,0 +1,318 @@
+// Package integration provides integration tests for the workflow engine.
+// The end-to-end suite starts real Postgres, Redis, and Jaeger instances
+// via dockertest and drives full create→schedule→execute→history flows
+// against them. Tests skip automatically when Docker is unavailable
+package integration
+
+import (
+    "context"
+    "database/sql"
+    "fmt"
+    "net/http"
+    "strconv"
+    "testing"
+    "time"
+
+    "github.com/go-redis/redis/v8"           // v8.11.5
+    "github.com/google/uuid"                 // v1.3.0
+    _ "github.com/lib/pq"                    // v1.10.9
+    "github.com/ory/dockertest/v3"           // v3.10.0
+
+    "github.com/organization/workflow-engine/internal/config"
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/internal/repositories"
+)
+
+// containerExpiry hard-kills leaked containers if a run is interrupted
+const containerExpiry = 300 // seconds
+
+// schemaSQL creates the tables the repository layer writes to
+const schemaSQL = `
+    CREATE TABLE IF NOT EXISTS workflows (
+        id UUID PRIMARY KEY,
+        user_id UUID NOT NULL,
+        name TEXT NOT NULL,
+        description TEXT,
+        status TEXT NOT NULL,
+        metadata JSONB,
+        version INT NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL,
+        updated_at TIMESTAMPTZ NOT NULL
+    );
+    CREATE TABLE IF NOT EXISTS workflow_nodes (
+        id UUID PRIMARY KEY,
+        workflow_id UUID NOT NULL REFERENCES workflows(id),
+        type TEXT NOT NULL,
+        name TEXT NOT NULL,
+        config JSONB,
+        position_x INT NOT NULL,
+        position_y INT NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL,
+        updated_at TIMESTAMPTZ NOT NULL
+    );
+    CREATE TABLE IF NOT EXISTS node_connections (
+        source_node_id UUID NOT NULL,
+        target_node_id UUID NOT NULL,
+        type TEXT NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL
+    );
+`
+
+// e2eEnv holds the live dependencies for one end-to-end test
+type e2eEnv struct {
+    db        *sql.DB
+    redis     *redis.Client
+    jaegerURL string
+    repo      *repositories.PostgresRepository
+}
+
+// passthroughExecutor is a NodeExecutor that copies its input to its
+// output, standing in for node-type services the harness doesn't run
+type passthroughExecutor struct{}
+
+func (passthroughExecutor) Execute(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    output := make(map[string]interface{}, len(input)+1)
+    for key, value := range input {
+        output[key] = value
+    }
+    output["node"] = node.Name
+    return output, nil
+}
+
+func (passthroughExecutor) Validate(node *models.Node) error {
+    return nil
+}
+
+// setupE2E starts Postgres, Redis, and Jaeger containers, applies the
+// schema, and wires a repository. It skips the test when Docker is not
+// available and cleans everything up when the test finishes
+func setupE2E(t *testing.T) *e2eEnv {
+    t.Helper()
+
+    pool, err := dockertest.NewPool("")
+    if err != nil {
+        t.Skipf("docker not available: %v", err)
+    }
+    if err := pool.Client.Ping(); err != nil {
+        t.Skipf("docker not reachable: %v", err)
+    }
+    pool.MaxWait = 2 * time.Minute
+
+    env := &e2eEnv{}
+
+    // Postgres
+    postgres, err := pool.RunWithOptions(&dockertest.RunOptions{
+        Repository: "postgres",
+        Tag:        "15-alpine",
+        Env: []string{
+            "POSTGRES_USER=workflow",
+            "POSTGRES_PASSWORD=workflow",
+            "POSTGRES_DB=workflows",
+        },
+    })
+    if err != nil {
+        t.Fatalf("failed to start postgres: %v", err)
+    }
+    postgres.Expire(containerExpiry)
+    t.Cleanup(func() { pool.Purge(postgres) })
+
+    postgresPort, _ := strconv.Atoi(postgres.GetPort("5432/tcp"))
+    dsn := fmt.Sprintf("host=localhost port=%d dbname=workflows user=workflow password=workflow sslmode=disable", postgresPort)
+    if err := pool.Retry(func() error {
+        db, err := sql.Open("postgres", dsn)
+        if err != nil {
+            return err
+        }
+        if err := db.Ping(); err != nil {
+            db.Close()
+            return err
+        }
+        env.db = db
+        return nil
+    }); err != nil {
+        t.Fatalf("postgres did not become ready: %v", err)
+    }
+    t.Cleanup(func() { env.db.Close() })
+
+    if _, err := env.db.Exec(schemaSQL); err != nil {
+        t.Fatalf("failed to apply schema: %v", err)
+    }
+
+    // Redis
+    redisContainer, err := pool.RunWithOptions(&dockertest.RunOptions{
+        Repository: "redis",
+        Tag:        "7-alpine",
+    })
+    if err != nil {
+        t.Fatalf("failed to start redis: %v", err)
+    }
+    redisContainer.Expire(containerExpiry)
+    t.Cleanup(func() { pool.Purge(redisContainer) })
+
+    env.redis = redis.NewClient(&redis.Options{
+        Addr: "localhost:" + redisContainer.GetPort("6379/tcp"),
+    })
+    if err := pool.Retry(func() error {
+        return env.redis.Ping(context.Background()).Err()
+    }); err != nil {
+        t.Fatalf("redis did not become ready: %v", err)
+    }
+    t.Cleanup(func() { env.redis.Close() })
+
+    // Jaeger
+    jaeger, err := pool.RunWithOptions(&dockertest.RunOptions{
+        Repository: "jaegertracing/all-in-one",
+        Tag:        "1.50",
+    })
+    if err != nil {
+        t.Fatalf("failed to start jaeger: %v", err)
+    }
+    jaeger.Expire(containerExpiry)
+    t.Cleanup(func() { pool.Purge(jaeger) })
+
+    env.jaegerURL = "http://localhost:" + jaeger.GetPort("16686/tcp")
+    if err := pool.Retry(func() error {
+        resp, err := http.Get(env.jaegerURL + "/")
+        if err != nil {
+            return err
+        }
+        resp.Body.Close()
+        return nil
+    }); err != nil {
+        t.Fatalf("jaeger did not become ready: %v", err)
+    }
+
+    env.repo, err = repositories.NewPostgresRepository(&config.DatabaseConfig{
+        Host:            "localhost",
+        Port:            postgresPort,
+        Name:            "workflows",
+        User:            "workflow",
+        Password:        "workflow",
+        SSLMode:         "disable",
+        MaxConnections:  5,
+        IdleConnections: 2,
+    })
+    if err != nil {
+        t.Fatalf("failed to create repository: %v", err)
+    }
+    t.Cleanup(func() { env.repo.Close() })
+
+    return env
+}
+
+// buildTestWorkflow assembles an active trigger→action workflow
+func buildTestWorkflow(t *testing.T) *models.Workflow {
+    t.Helper()
+
+    workflow, err := models.NewWorkflow(uuid.New(), "e2e", "end-to-end lifecycle workflow")
+    if err != nil {
+        t.Fatalf("failed to create workflow: %v", err)
+    }
+
+    trigger, err := models.NewNode(workflow.ID, models.TriggerNode, "start", map[string]interface{}{})
+    if err != nil {
+        t.Fatalf("failed to create trigger node: %v", err)
+    }
+    action, err := models.NewNode(workflow.ID, models.ActionNode, "work", map[string]interface{}{})
+    if err != nil {
+        t.Fatalf("failed to create action node: %v", err)
+    }
+
+    if err := workflow.AddNode(trigger); err != nil {
+        t.Fatalf("failed to add trigger node: %v", err)
+    }
+    if err := workflow.AddNode(action); err != nil {
+        t.Fatalf("failed to add action node: %v", err)
+    }
+    if err := workflow.Connect(trigger.ID, action.ID); err != nil {
+        t.Fatalf("failed to connect nodes: %v", err)
+    }
+    if err := workflow.UpdateStatus("active"); err != nil {
+        t.Fatalf("failed to activate workflow: %v", err)
+    }
+    return workflow
+}
+
+// TestEndToEndWorkflowLifecycle runs the full create→schedule→execute→
+// history flow against real Postgres, Redis, and Jaeger
+func TestEndToEndWorkflowLifecycle(t *testing.T) {
+    if testing.Short() {
+        t.Skip("skipping end-to-end test in short mode")
+    }
+
+    env := setupE2E(t)
+    ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+    defer cancel()
+
+    // Create: persist the workflow through the repository layer
+    workflow := buildTestWorkflow(t)
+    if err := env.repo.CreateWorkflow(ctx, workflow); err != nil {
+        t.Fatalf("failed to persist workflow: %v", err)
+    }
+
+    var storedNodes int
+    if err := env.db.QueryRow("SELECT COUNT(*) FROM workflow_nodes WHERE workflow_id = $1", workflow.ID).Scan(&storedNodes); err != nil {
+        t.Fatalf("failed to count persisted nodes: %v", err)
+    }
+    if storedNodes != 2 {
+        t.Fatalf("expected 2 persisted nodes, got %d", storedNodes)
+    }
+
+    // Execute: run the workflow with passthrough node executors and a
+    // Redis-backed node result cache
+    executor := core.NewExecutor(nil, nil)
+    executor.RegisterNodeExecutor(models.TriggerNode, passthroughExecutor{})
+    executor.RegisterNodeExecutor(models.ActionNode, passthroughExecutor{})
+    executor.SetNodeResultCache(core.NewNodeResultCache(env.redis, time.Minute))
+
+    if err := executor.ExecuteWorkflow(ctx, workflow); err != nil {
+        t.Fatalf("failed to execute workflow: %v", err)
+    }
+
+    // Schedule: register, pause, resume, and remove a schedule
+    scheduler := core.NewScheduler(executor, core.SchedulerConfig{})
+    scheduler.Start()
+    t.Cleanup(scheduler.Stop)
+
+    scheduleConfig := map[string]interface{}{
+        "type":     "interval",
+        "interval": "1h",
+    }
+    if err := scheduler.ScheduleWorkflow(ctx, workflow, scheduleConfig); err != nil {
+        t.Fatalf("failed to schedule workflow: %v", err)
+    }
+    if err := scheduler.PauseSchedule(ctx, workflow.ID); err != nil {
+        t.Fatalf("failed to pause schedule: %v", err)
+    }
+    if paused, err := scheduler.IsSchedulePaused(workflow.ID); err != nil || !paused {
+        t.Fatalf("expected schedule paused, got paused=%v err=%v", paused, err)
+    }
+    if err := scheduler.UnscheduleWorkflow(ctx, workflow.ID); err != nil {
+        t.Fatalf("failed to unschedule workflow: %v", err)
+    }
+
+    // History: the persisted row reflects the created workflow
+    var storedStatus string
+    var storedVersion int
+    if err := env.db.QueryRow("SELECT status, version FROM workflows WHERE id = $1", workflow.ID).Scan(&storedStatus, &storedVersion); err != nil {
+        t.Fatalf("failed to read persisted workflow: %v", err)
+    }
+    if storedStatus != "active" {
+        t.Fatalf("expected persisted status active, got %q", storedStatus)
+    }
+    if storedVersion != workflow.Version {
+        t.Fatalf("expected persisted version %d, got %d", workflow.Version, storedVersion)
+    }
+
+    // Jaeger stays up for the duration of the flow so spans emitted by
+    // the executor have somewhere to land when a tracer is configured
+    resp, err := http.Get(env.jaegerURL + "/api/services")
+    if err != nil {
+        t.Fatalf("failed to query jaeger: %v", err)
+    }
+    resp.Body.Close()
+    if resp.StatusCode != http.StatusOK {
+        t.Fatalf("expected jaeger query API to respond, got status %d", resp.StatusCode)
+    }
+}
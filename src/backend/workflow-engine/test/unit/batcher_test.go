@@ -0,0 +1,95 @@
+package unit
+
+import (
+    "context"
+    "fmt"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+
+    aipb "github.com/organization/workflow-engine/api/proto/ai/v1"
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// countingInvoker records batched calls and answers each request with its
+// own task ID
+type countingInvoker struct {
+    mu    sync.Mutex
+    calls [][]*aipb.TaskRequest
+}
+
+func (i *countingInvoker) invoke(ctx context.Context, requests []*aipb.TaskRequest) ([]*aipb.TaskResponse, error) {
+    i.mu.Lock()
+    defer i.mu.Unlock()
+    i.calls = append(i.calls, requests)
+
+    responses := make([]*aipb.TaskResponse, len(requests))
+    for n, request := range requests {
+        responses[n] = &aipb.TaskResponse{TaskId: request.TaskId, Content: "ok"}
+    }
+    return responses, nil
+}
+
+func (i *countingInvoker) callCount() int {
+    i.mu.Lock()
+    defer i.mu.Unlock()
+    return len(i.calls)
+}
+
+// TestAIBatcherCoalescesRequests tests that concurrent submissions are
+// sent as a single batched call with per-request results fanned back out
+func TestAIBatcherCoalescesRequests(t *testing.T) {
+    invoker := &countingInvoker{}
+    batcher := core.NewAIBatcher(invoker.invoke, core.AIBatcherConfig{
+        MaxBatchSize:  4,
+        LatencyBudget: time.Second,
+    })
+    defer batcher.Shutdown()
+
+    var wg sync.WaitGroup
+    results := make([]*aipb.TaskResponse, 4)
+    for n := 0; n < 4; n++ {
+        wg.Add(1)
+        go func(n int) {
+            defer wg.Done()
+            response, err := batcher.Submit(context.Background(), &aipb.TaskRequest{TaskId: fmt.Sprintf("task-%d", n)})
+            assert.NoError(t, err)
+            results[n] = response
+        }(n)
+    }
+    wg.Wait()
+
+    assert.Equal(t, 1, invoker.callCount(), "a full batch goes out as one call")
+    for n, response := range results {
+        assert.Equal(t, fmt.Sprintf("task-%d", n), response.TaskId)
+    }
+}
+
+// TestAIBatcherFlushesOnLatencyBudget tests that a partial batch is sent
+// once the oldest request has waited out the latency budget
+func TestAIBatcherFlushesOnLatencyBudget(t *testing.T) {
+    invoker := &countingInvoker{}
+    batcher := core.NewAIBatcher(invoker.invoke, core.AIBatcherConfig{
+        MaxBatchSize:  16,
+        LatencyBudget: 10 * time.Millisecond,
+    })
+    defer batcher.Shutdown()
+
+    response, err := batcher.Submit(context.Background(), &aipb.TaskRequest{TaskId: "lone-task"})
+    assert.NoError(t, err)
+    assert.Equal(t, "lone-task", response.TaskId)
+    assert.Equal(t, 1, invoker.callCount())
+}
+
+// TestAIBatcherRejectsAfterShutdown tests that queued submissions fail
+// cleanly once the batcher is stopped
+func TestAIBatcherRejectsAfterShutdown(t *testing.T) {
+    invoker := &countingInvoker{}
+    batcher := core.NewAIBatcher(invoker.invoke, core.AIBatcherConfig{})
+    batcher.Shutdown()
+
+    _, err := batcher.Submit(context.Background(), &aipb.TaskRequest{TaskId: "late"})
+    assert.ErrorIs(t, err, core.ErrBatcherClosed)
+}
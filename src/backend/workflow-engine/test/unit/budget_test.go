@@ -0,0 +1,72 @@
+package unit
+
+import (
+    "context"
+    "testing"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// chargingExecutor reports one external call per node it runs
+type chargingExecutor struct {
+    executor *core.Executor
+}
+
+func (c *chargingExecutor) Execute(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    if err := c.executor.ChargeExternalCalls(node.WorkflowID, 1); err != nil {
+        return nil, err
+    }
+    return input, nil
+}
+
+func (c *chargingExecutor) Validate(node *models.Node) error { return nil }
+
+// TestBudgetNodeLimitFailsRun tests that the executor fails a run once
+// the node budget is exhausted
+func TestBudgetNodeLimitFailsRun(t *testing.T) {
+    executor := newTestExecutor(&recordingExecutor{})
+    executor.SetBudgetPolicy(&core.BudgetPolicy{
+        Default: core.ExecutionBudget{MaxNodes: 1},
+    })
+
+    workflow := createTestWorkflow(uuid.New(), 3)
+    err := executor.ExecuteWorkflow(context.Background(), workflow)
+    assert.ErrorIs(t, err, core.ErrBudgetExceeded)
+}
+
+// TestBudgetExternalCallLimit tests that charges reported by node
+// executors fail the run once the external call budget is exhausted
+func TestBudgetExternalCallLimit(t *testing.T) {
+    executor := core.NewExecutor(nil, nil)
+    stub := &chargingExecutor{executor: executor}
+    executor.RegisterNodeExecutor(models.TriggerNode, stub)
+    executor.RegisterNodeExecutor(models.ActionNode, stub)
+    executor.RegisterNodeExecutor(models.ConditionNode, stub)
+    executor.SetBudgetPolicy(&core.BudgetPolicy{
+        Default: core.ExecutionBudget{MaxExternalCalls: 2},
+    })
+
+    workflow := createTestWorkflow(uuid.New(), 4)
+    err := executor.ExecuteWorkflow(context.Background(), workflow)
+    assert.ErrorIs(t, err, core.ErrBudgetExceeded)
+}
+
+// TestBudgetPerWorkflowOverride tests that a per-workflow budget takes
+// precedence over the default
+func TestBudgetPerWorkflowOverride(t *testing.T) {
+    workflow := createTestWorkflow(uuid.New(), 3)
+
+    executor := newTestExecutor(&recordingExecutor{})
+    executor.SetBudgetPolicy(&core.BudgetPolicy{
+        Default: core.ExecutionBudget{MaxNodes: 1},
+        PerWorkflow: map[uuid.UUID]core.ExecutionBudget{
+            workflow.ID: {MaxNodes: 10},
+        },
+    })
+
+    assert.NoError(t, executor.ExecuteWorkflow(context.Background(), workflow))
+}
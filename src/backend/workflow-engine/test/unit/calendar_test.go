@@ -0,0 +1,107 @@
+package unit
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// TestCalendarRegistryCRUD tests the lifecycle of a named calendar
+func TestCalendarRegistryCRUD(t *testing.T) {
+    registry := core.NewCalendarRegistry()
+
+    calendar, err := registry.Create("us-holidays", "UTC", []string{"2026-12-25"})
+    assert.NoError(t, err)
+    assert.NotEqual(t, uuid.Nil, calendar.ID)
+
+    fetched, err := registry.Get(calendar.ID)
+    assert.NoError(t, err)
+    assert.Equal(t, "us-holidays", fetched.Name)
+    assert.Equal(t, []string{"2026-12-25"}, fetched.Holidays)
+
+    updated, err := registry.Update(calendar.ID, "us-holidays", "UTC", []string{"2026-12-25", "2027-01-01"})
+    assert.NoError(t, err)
+    assert.Len(t, updated.Holidays, 2)
+
+    assert.Len(t, registry.List(), 1)
+
+    assert.NoError(t, registry.Delete(calendar.ID))
+    _, err = registry.Get(calendar.ID)
+    assert.ErrorIs(t, err, core.ErrCalendarNotFound)
+}
+
+// TestCalendarRegistryValidation tests that bad definitions and
+// duplicate names are rejected
+func TestCalendarRegistryValidation(t *testing.T) {
+    registry := core.NewCalendarRegistry()
+
+    _, err := registry.Create("", "UTC", nil)
+    assert.ErrorIs(t, err, core.ErrInvalidCalendar)
+
+    _, err = registry.Create("bad-tz", "Not/AZone", nil)
+    assert.ErrorIs(t, err, core.ErrInvalidCalendar)
+
+    _, err = registry.Create("bad-date", "UTC", []string{"25-12-2026"})
+    assert.ErrorIs(t, err, core.ErrInvalidCalendar)
+
+    _, err = registry.Create("shared", "UTC", nil)
+    assert.NoError(t, err)
+    _, err = registry.Create("shared", "UTC", nil)
+    assert.ErrorIs(t, err, core.ErrInvalidCalendar)
+}
+
+// TestCalendarRegistryBusiness tests that a named calendar materializes
+// into a business calendar honoring its holidays
+func TestCalendarRegistryBusiness(t *testing.T) {
+    registry := core.NewCalendarRegistry()
+
+    // 2026-12-25 is a Friday
+    calendar, err := registry.Create("eu-holidays", "UTC", []string{"2026-12-25"})
+    assert.NoError(t, err)
+
+    business, err := registry.Business(calendar.ID, time.UTC)
+    assert.NoError(t, err)
+
+    holiday := time.Date(2026, 12, 25, 12, 0, 0, 0, time.UTC)
+    assert.False(t, business.IsBusinessDay(holiday))
+    assert.True(t, business.IsBusinessDay(holiday.AddDate(0, 0, -1)))
+
+    _, err = registry.Business(uuid.New(), time.UTC)
+    assert.ErrorIs(t, err, core.ErrCalendarNotFound)
+}
+
+// TestScheduleReferencesCalendar tests that calendar schedules resolve
+// shared calendars by ID and reject dangling references
+func TestScheduleReferencesCalendar(t *testing.T) {
+    ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+    defer cancel()
+
+    registry := core.NewCalendarRegistry()
+    calendar, err := registry.Create("ops", "UTC", []string{"2026-12-25"})
+    assert.NoError(t, err)
+
+    scheduler := core.NewScheduler(newTestExecutor(&recordingExecutor{}), core.SchedulerConfig{})
+    scheduler.SetCalendarRegistry(registry)
+    defer scheduler.Stop()
+
+    workflow := createTestWorkflow(uuid.New(), 2)
+    err = scheduler.ScheduleWorkflow(ctx, workflow, map[string]interface{}{
+        "type":        "calendar",
+        "time":        "09:00",
+        "calendar_id": calendar.ID.String(),
+    })
+    assert.NoError(t, err)
+
+    dangling := createTestWorkflow(uuid.New(), 2)
+    err = scheduler.ScheduleWorkflow(ctx, dangling, map[string]interface{}{
+        "type":        "calendar",
+        "time":        "09:00",
+        "calendar_id": uuid.New().String(),
+    })
+    assert.ErrorIs(t, err, core.ErrInvalidSchedule)
+}
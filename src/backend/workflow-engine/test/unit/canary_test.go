@@ -0,0 +1,110 @@
+package unit
+
+import (
+    "context"
+    "testing"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// newTestCanary starts a canary for a fresh workflow and returns the
+// controller, the stable workflow and its ID. The stable workflow runs
+// version 1, the canary version 2.
+func newTestCanary(t *testing.T, config core.CanaryConfig) (*core.CanaryController, uuid.UUID) {
+    controller := core.NewCanaryController()
+    workflow := createTestWorkflow(uuid.New(), 2)
+    workflow.Version = 1
+
+    assert.NoError(t, controller.StartCanary(context.Background(), workflow, 2, config))
+    return controller, workflow.ID
+}
+
+// TestCanarySplitsTraffic tests that PickVersion routes some executions to
+// each track while a canary is active
+func TestCanarySplitsTraffic(t *testing.T) {
+    controller, workflowID := newTestCanary(t, core.CanaryConfig{Percentage: 50})
+
+    picked := make(map[int]int)
+    for i := 0; i < 200; i++ {
+        version, canary := controller.PickVersion(workflowID, 2)
+        assert.Equal(t, canary, version == 2)
+        picked[version]++
+    }
+
+    assert.Positive(t, picked[1], "some traffic stays on the stable version")
+    assert.Positive(t, picked[2], "some traffic reaches the canary version")
+}
+
+// TestCanaryAutoRollback tests that a failing canary is rolled back once the
+// error rate exceeds the threshold, pinning traffic to the stable version
+// until a newer revision is published
+func TestCanaryAutoRollback(t *testing.T) {
+    controller, workflowID := newTestCanary(t, core.CanaryConfig{
+        Percentage:         50,
+        ErrorRateThreshold: 0.2,
+        MinSamples:         5,
+    })
+
+    for i := 0; i < 5; i++ {
+        controller.RecordResult(workflowID, true, assert.AnError)
+    }
+
+    status, err := controller.GetCanaryStatus(workflowID)
+    assert.NoError(t, err)
+    assert.Equal(t, "rolled_back", status["status"])
+
+    // The canary revision is still the latest, so routing stays pinned
+    for i := 0; i < 20; i++ {
+        version, canary := controller.PickVersion(workflowID, 2)
+        assert.Equal(t, 1, version)
+        assert.False(t, canary)
+    }
+
+    // A newer publish supersedes the rollback pin
+    version, canary := controller.PickVersion(workflowID, 3)
+    assert.Equal(t, 3, version)
+    assert.False(t, canary)
+}
+
+// TestCanaryPromote tests that promotion ends the split and that a finished
+// canary cannot be promoted or rolled back again
+func TestCanaryPromote(t *testing.T) {
+    controller, workflowID := newTestCanary(t, core.CanaryConfig{Percentage: 50})
+
+    version, err := controller.PromoteCanary(workflowID)
+    assert.NoError(t, err)
+    assert.Equal(t, 2, version)
+
+    picked, canary := controller.PickVersion(workflowID, 2)
+    assert.Equal(t, 2, picked)
+    assert.False(t, canary)
+
+    _, err = controller.PromoteCanary(workflowID)
+    assert.ErrorIs(t, err, core.ErrCanaryNotActive)
+    _, err = controller.RollbackCanary(workflowID)
+    assert.ErrorIs(t, err, core.ErrCanaryNotActive)
+}
+
+// TestCanaryObservesInterceptedOutcomes tests that execution outcomes
+// reported through the interceptor chain are attributed to the right track
+func TestCanaryObservesInterceptedOutcomes(t *testing.T) {
+    controller, workflowID := newTestCanary(t, core.CanaryConfig{Percentage: 50, MinSamples: 100})
+
+    ctx := context.Background()
+    canaryRun := createTestWorkflow(workflowID, 2)
+    canaryRun.Version = 2
+    stableRun := createTestWorkflow(workflowID, 2)
+    stableRun.Version = 1
+
+    controller.OnComplete(ctx, canaryRun, uuid.New(), 0)
+    controller.OnError(ctx, canaryRun, nil, assert.AnError)
+    controller.OnComplete(ctx, stableRun, uuid.New(), 0)
+
+    status, err := controller.GetCanaryStatus(workflowID)
+    assert.NoError(t, err)
+    assert.Equal(t, 2, status["samples"], "only canary-track runs count toward the sample window")
+    assert.Equal(t, 0.5, status["error_rate"])
+}
@@ -0,0 +1,32 @@
+package unit
+
+import (
+    "testing"
+
+    "github.com/organization/workflow-engine/internal/core"
+    enginetest "github.com/organization/workflow-engine/pkg/testing"
+)
+
+// TestExecutorHonorsContract runs the enginetest executor contract suite
+// against the default executor implementation
+func TestExecutorHonorsContract(t *testing.T) {
+    enginetest.ExecutorContract(t, func() core.WorkflowExecutor {
+        return core.NewExecutor(nil, nil)
+    })
+}
+
+// TestSchedulerHonorsContract runs the enginetest scheduler contract
+// suite against the default scheduler implementation
+func TestSchedulerHonorsContract(t *testing.T) {
+    enginetest.SchedulerContract(t, func() core.WorkflowScheduler {
+        return core.NewScheduler(core.NewExecutor(nil, nil), core.SchedulerConfig{})
+    })
+}
+
+// TestFakeSchedulerHonorsContract keeps the official fake honest: tests
+// substituting it for the real scheduler rely on matching behavior
+func TestFakeSchedulerHonorsContract(t *testing.T) {
+    enginetest.SchedulerContract(t, func() core.WorkflowScheduler {
+        return enginetest.NewFakeScheduler()
+    })
+}
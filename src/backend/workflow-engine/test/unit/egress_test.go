@@ -0,0 +1,144 @@
+package unit
+
+import (
+    "context"
+    "testing"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// newEgressEnforcer builds an enforcer with the given tenant policies,
+// capturing violations for assertions
+func newEgressEnforcer(t *testing.T, policies map[string]core.EgressPolicy) (*core.EgressEnforcer, *[]core.EgressViolation) {
+    t.Helper()
+
+    var violations []core.EgressViolation
+    enforcer, err := core.NewEgressEnforcer(policies, func(violation core.EgressViolation) {
+        violations = append(violations, violation)
+    })
+    assert.NoError(t, err)
+    return enforcer, &violations
+}
+
+// TestEgressBlocksInternalNetworks tests that outbound calls into
+// loopback, private, and metadata networks are denied even without any
+// tenant policy configured
+func TestEgressBlocksInternalNetworks(t *testing.T) {
+    enforcer, violations := newEgressEnforcer(t, nil)
+
+    for _, destination := range []string{
+        "http://127.0.0.1:8080/hook",
+        "http://10.1.2.3/",
+        "https://169.254.169.254/latest/meta-data/",
+        "http://[::1]:9000/",
+    } {
+        err := enforcer.Check(context.Background(), "acme", uuid.New(), destination)
+        assert.ErrorIs(t, err, core.ErrEgressDenied, "destination %s should be blocked", destination)
+    }
+    assert.Len(t, *violations, 4)
+    assert.Equal(t, "blocked_network", (*violations)[0].Reason)
+}
+
+// TestEgressAllowsPublicWithoutPolicy tests that tenants without a policy
+// may reach any public destination
+func TestEgressAllowsPublicWithoutPolicy(t *testing.T) {
+    enforcer, _ := newEgressEnforcer(t, nil)
+
+    assert.NoError(t, enforcer.Check(context.Background(), "acme", uuid.New(), "https://93.184.216.34/api"))
+}
+
+// TestEgressRejectsSchemes tests that non-HTTP schemes and unparsable
+// URLs are denied outright
+func TestEgressRejectsSchemes(t *testing.T) {
+    enforcer, violations := newEgressEnforcer(t, nil)
+
+    err := enforcer.Check(context.Background(), "acme", uuid.New(), "ftp://93.184.216.34/file")
+    assert.ErrorIs(t, err, core.ErrEgressDenied)
+    assert.Equal(t, "scheme", (*violations)[0].Reason)
+
+    err = enforcer.Check(context.Background(), "acme", uuid.New(), "http://bad url with spaces/")
+    assert.ErrorIs(t, err, core.ErrEgressDenied)
+}
+
+// TestEgressCIDRAllowlistPrecedence tests that an allowlisted CIDR lets
+// a tenant reach its own private integration while the blocked networks
+// stay closed outside that range
+func TestEgressCIDRAllowlistPrecedence(t *testing.T) {
+    enforcer, _ := newEgressEnforcer(t, map[string]core.EgressPolicy{
+        "acme": {AllowedCIDRs: []string{"10.1.0.0/16"}},
+    })
+
+    assert.NoError(t, enforcer.Check(context.Background(), "acme", uuid.New(), "http://10.1.2.3/internal"))
+    assert.ErrorIs(t, enforcer.Check(context.Background(), "acme", uuid.New(), "http://10.2.0.1/"),
+        core.ErrEgressDenied, "allowlisting one range should not open the rest of the network")
+}
+
+// TestEgressPoliciesAreTenantScoped tests that one tenant's allowlist
+// does not apply to another tenant
+func TestEgressPoliciesAreTenantScoped(t *testing.T) {
+    enforcer, _ := newEgressEnforcer(t, map[string]core.EgressPolicy{
+        "acme": {AllowedCIDRs: []string{"10.1.0.0/16"}},
+    })
+
+    assert.ErrorIs(t, enforcer.Check(context.Background(), "globex", uuid.New(), "http://10.1.2.3/"),
+        core.ErrEgressDenied)
+}
+
+// TestEgressDomainAllowlistRestrictsDestinations tests that a tenant with
+// a domain allowlist is denied destinations outside it, and that domain
+// entries do not unblock the internal networks
+func TestEgressDomainAllowlistRestrictsDestinations(t *testing.T) {
+    enforcer, violations := newEgressEnforcer(t, map[string]core.EgressPolicy{
+        "acme": {AllowedDomains: []string{"example.com"}},
+    })
+
+    err := enforcer.Check(context.Background(), "acme", uuid.New(), "https://93.184.216.34/api")
+    assert.ErrorIs(t, err, core.ErrEgressDenied)
+    assert.Equal(t, "not_allowlisted", (*violations)[0].Reason)
+
+    // localhost matches no allowed domain and resolves into loopback; a
+    // domain allowlist never overrides the blocked networks
+    err = enforcer.Check(context.Background(), "acme", uuid.New(), "http://localhost:8080/")
+    assert.ErrorIs(t, err, core.ErrEgressDenied)
+}
+
+// TestEgressViolationDetails tests that the violation callback reports
+// the tenant, node, host, and reason of a denied call
+func TestEgressViolationDetails(t *testing.T) {
+    enforcer, violations := newEgressEnforcer(t, nil)
+
+    nodeID := uuid.New()
+    err := enforcer.Check(context.Background(), "acme", nodeID, "http://127.0.0.1/hook")
+    assert.ErrorIs(t, err, core.ErrEgressDenied)
+
+    assert.Len(t, *violations, 1)
+    violation := (*violations)[0]
+    assert.Equal(t, "acme", violation.Tenant)
+    assert.Equal(t, nodeID, violation.NodeID)
+    assert.Equal(t, "127.0.0.1", violation.Host)
+    assert.Equal(t, "blocked_network", violation.Reason)
+}
+
+// TestEgressCheckNode tests that only action nodes with an outbound URL
+// are checked; other nodes always pass
+func TestEgressCheckNode(t *testing.T) {
+    enforcer, _ := newEgressEnforcer(t, nil)
+
+    blocked := createTestNode(uuid.New(), models.ActionNode, map[string]interface{}{
+        "action_type": "http",
+        "url":         "http://127.0.0.1/hook",
+    })
+    assert.ErrorIs(t, enforcer.CheckNode(context.Background(), "acme", blocked), core.ErrEgressDenied)
+
+    assert.NoError(t, enforcer.CheckNode(context.Background(), "acme",
+        createTestNode(uuid.New(), models.ActionNode, nil)))
+    assert.NoError(t, enforcer.CheckNode(context.Background(), "acme",
+        createTestNode(uuid.New(), models.ConditionNode, map[string]interface{}{
+            "condition": "true",
+            "url":       "http://127.0.0.1/hook",
+        })))
+}
@@ -0,0 +1,177 @@
+package unit
+
+import (
+    "bytes"
+    "context"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/middleware"
+    "github.com/organization/workflow-engine/pkg/encryption"
+)
+
+// newTestEncryptor builds an envelope encryptor over a key manager with
+// a fixed master key
+func newTestEncryptor(t *testing.T) *encryption.EnvelopeEncryptor {
+    t.Helper()
+    keys, err := encryption.NewKeyManager(bytes.Repeat([]byte{0x42}, 32))
+    assert.NoError(t, err)
+    encryptor, err := encryption.NewEnvelopeEncryptor(keys)
+    assert.NoError(t, err)
+    return encryptor
+}
+
+// TestEnvelopeRoundTrip tests that a payload encrypted for a tenant
+// decrypts back to the original content
+func TestEnvelopeRoundTrip(t *testing.T) {
+    encryptor := newTestEncryptor(t)
+
+    payload := map[string]interface{}{"node_output": "sensitive", "tenant_id": "acme"}
+    envelope, err := encryptor.Encrypt("acme", payload)
+    assert.NoError(t, err)
+    assert.NotContains(t, envelope.Ciphertext, "sensitive")
+
+    decrypted, err := encryptor.Decrypt(envelope)
+    assert.NoError(t, err)
+    assert.Equal(t, payload, decrypted)
+}
+
+// TestEnvelopeRejectsCrossTenant tests that an envelope rebound to a
+// different tenant does not decrypt
+func TestEnvelopeRejectsCrossTenant(t *testing.T) {
+    encryptor := newTestEncryptor(t)
+
+    envelope, err := encryptor.Encrypt("acme", map[string]interface{}{"secret": "value"})
+    assert.NoError(t, err)
+
+    // Provision the other tenant's key, then try to read acme's payload
+    // with it
+    _, err = encryptor.Encrypt("rival", map[string]interface{}{"noise": "x"})
+    assert.NoError(t, err)
+
+    envelope.TenantID = "rival"
+    _, err = encryptor.Decrypt(envelope)
+    assert.Error(t, err)
+}
+
+// TestEnvelopeSurvivesKEKRotation tests that payloads sealed before a
+// master key rotation remain readable, since rotation re-wraps tenant
+// DEKs without changing them
+func TestEnvelopeSurvivesKEKRotation(t *testing.T) {
+    keys, err := encryption.NewKeyManager(bytes.Repeat([]byte{0x42}, 32))
+    assert.NoError(t, err)
+    encryptor, err := encryption.NewEnvelopeEncryptor(keys)
+    assert.NoError(t, err)
+
+    envelope, err := encryptor.Encrypt("acme", map[string]interface{}{"value": "sk-live-123"})
+    assert.NoError(t, err)
+
+    version, rewrapped, err := keys.RotateKEK(nil)
+    assert.NoError(t, err)
+    assert.Equal(t, 2, version)
+    assert.Equal(t, 1, rewrapped)
+
+    decrypted, err := encryptor.Decrypt(envelope)
+    assert.NoError(t, err)
+    assert.Equal(t, "sk-live-123", decrypted["value"])
+}
+
+// fakeKeyStore keeps wrapped keys in memory so tests can simulate a
+// process restart by loading a fresh key manager from the same store
+type fakeKeyStore struct {
+    keks       []encryption.StoredKEK
+    tenantKeys map[string]encryption.StoredTenantKey
+}
+
+func newFakeKeyStore() *fakeKeyStore {
+    return &fakeKeyStore{tenantKeys: make(map[string]encryption.StoredTenantKey)}
+}
+
+func (s *fakeKeyStore) SaveTenantKey(ctx context.Context, key encryption.StoredTenantKey) error {
+    s.tenantKeys[key.TenantID] = key
+    return nil
+}
+
+func (s *fakeKeyStore) SaveKEK(ctx context.Context, kek encryption.StoredKEK) error {
+    s.keks = append(s.keks, kek)
+    return nil
+}
+
+func (s *fakeKeyStore) LoadKEKs(ctx context.Context) ([]encryption.StoredKEK, error) {
+    return s.keks, nil
+}
+
+func (s *fakeKeyStore) LoadTenantKeys(ctx context.Context) ([]encryption.StoredTenantKey, error) {
+    keys := make([]encryption.StoredTenantKey, 0, len(s.tenantKeys))
+    for _, key := range s.tenantKeys {
+        keys = append(keys, key)
+    }
+    return keys, nil
+}
+
+// newStoredEncryptor builds an envelope encryptor whose key manager
+// persists wrapped keys to the given store
+func newStoredEncryptor(t *testing.T, store *fakeKeyStore) *encryption.EnvelopeEncryptor {
+    t.Helper()
+    keys, err := encryption.NewKeyManager(bytes.Repeat([]byte{0x42}, 32))
+    assert.NoError(t, err)
+    assert.NoError(t, keys.SetStore(context.Background(), store))
+    encryptor, err := encryption.NewEnvelopeEncryptor(keys)
+    assert.NoError(t, err)
+    return encryptor
+}
+
+// TestEnvelopeSurvivesRestart tests that envelopes written by one
+// process decrypt in a fresh key manager loaded from the same store
+func TestEnvelopeSurvivesRestart(t *testing.T) {
+    store := newFakeKeyStore()
+
+    envelope, err := newStoredEncryptor(t, store).Encrypt("acme", map[string]interface{}{"value": "sk-live-123"})
+    assert.NoError(t, err)
+
+    decrypted, err := newStoredEncryptor(t, store).Decrypt(envelope)
+    assert.NoError(t, err)
+    assert.Equal(t, "sk-live-123", decrypted["value"])
+}
+
+// TestKEKRotationSurvivesRestart tests that a rotated KEK and the DEKs
+// re-wrapped under it are persisted, so decryption still works in a
+// fresh process that only holds the original master key
+func TestKEKRotationSurvivesRestart(t *testing.T) {
+    store := newFakeKeyStore()
+
+    keys, err := encryption.NewKeyManager(bytes.Repeat([]byte{0x42}, 32))
+    assert.NoError(t, err)
+    assert.NoError(t, keys.SetStore(context.Background(), store))
+    encryptor, err := encryption.NewEnvelopeEncryptor(keys)
+    assert.NoError(t, err)
+
+    envelope, err := encryptor.Encrypt("acme", map[string]interface{}{"value": "sk-live-123"})
+    assert.NoError(t, err)
+
+    version, rewrapped, err := keys.RotateKEK(nil)
+    assert.NoError(t, err)
+    assert.Equal(t, 2, version)
+    assert.Equal(t, 1, rewrapped)
+
+    restarted, err := encryption.NewKeyManager(bytes.Repeat([]byte{0x42}, 32))
+    assert.NoError(t, err)
+    assert.NoError(t, restarted.SetStore(context.Background(), store))
+    assert.Equal(t, 2, restarted.CurrentKEKVersion())
+
+    restartedEncryptor, err := encryption.NewEnvelopeEncryptor(restarted)
+    assert.NoError(t, err)
+    decrypted, err := restartedEncryptor.Decrypt(envelope)
+    assert.NoError(t, err)
+    assert.Equal(t, "sk-live-123", decrypted["value"])
+}
+
+// TestTenancyContext tests the tenant round trip through a request
+// context used for residency routing
+func TestTenancyContext(t *testing.T) {
+    assert.Empty(t, middleware.TenantFromContext(context.Background()))
+
+    ctx := middleware.WithTenant(context.Background(), "acme")
+    assert.Equal(t, "acme", middleware.TenantFromContext(ctx))
+}
@@ -0,0 +1,145 @@
+package unit
+
+import (
+    "context"
+    "errors"
+    "sync"
+    "testing"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// selectiveExecutor is a NodeExecutor that fails designated nodes and
+// records the input each node received
+type selectiveExecutor struct {
+    mu       sync.Mutex
+    failures map[uuid.UUID]error
+    inputs   map[uuid.UUID]map[string]interface{}
+}
+
+func newSelectiveExecutor() *selectiveExecutor {
+    return &selectiveExecutor{
+        failures: make(map[uuid.UUID]error),
+        inputs:   make(map[uuid.UUID]map[string]interface{}),
+    }
+}
+
+func (s *selectiveExecutor) Execute(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    s.mu.Lock()
+    s.inputs[node.ID] = input
+    failure := s.failures[node.ID]
+    s.mu.Unlock()
+    if failure != nil {
+        return nil, failure
+    }
+    return input, nil
+}
+
+func (s *selectiveExecutor) Validate(node *models.Node) error { return nil }
+
+func (s *selectiveExecutor) ran(nodeID uuid.UUID) bool {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    _, exists := s.inputs[nodeID]
+    return exists
+}
+
+func (s *selectiveExecutor) inputFor(nodeID uuid.UUID) map[string]interface{} {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.inputs[nodeID]
+}
+
+// addRoutingNode creates a node of the given type and attaches it to the
+// workflow
+func addRoutingNode(t *testing.T, workflow *models.Workflow, nodeType models.NodeType) *models.Node {
+    t.Helper()
+    node := createTestNode(workflow.ID, nodeType, nil)
+    assert.NoError(t, workflow.AddNode(node))
+    return node
+}
+
+// newErrorRoutingWorkflow builds trigger -> action -> downstream with a
+// fallback node attached to the action's on-error output
+func newErrorRoutingWorkflow(t *testing.T) (*models.Workflow, *models.Node, *models.Node, *models.Node) {
+    t.Helper()
+
+    workflow, err := models.NewWorkflow(uuid.New(), "error-routing", "on-error branch routing")
+    assert.NoError(t, err)
+
+    trigger := addRoutingNode(t, workflow, models.TriggerNode)
+    action := addRoutingNode(t, workflow, models.ActionNode)
+    downstream := addRoutingNode(t, workflow, models.ActionNode)
+    fallback := addRoutingNode(t, workflow, models.ActionNode)
+
+    assert.NoError(t, workflow.Connect(trigger.ID, action.ID))
+    assert.NoError(t, workflow.Connect(action.ID, downstream.ID))
+    assert.NoError(t, workflow.ConnectOnError(action.ID, fallback.ID))
+
+    return workflow, action, downstream, fallback
+}
+
+// newErrorRoutingExecutor registers the selective stub for the node types
+// the routing tests use
+func newErrorRoutingExecutor(stub *selectiveExecutor) *core.Executor {
+    executor := core.NewExecutor(nil, nil)
+    executor.RegisterNodeExecutor(models.TriggerNode, stub)
+    executor.RegisterNodeExecutor(models.ActionNode, stub)
+    return executor
+}
+
+// TestErrorRoutingRunsFallback tests that a failing node with an on-error
+// branch routes to the fallback instead of aborting the run
+func TestErrorRoutingRunsFallback(t *testing.T) {
+    workflow, action, downstream, fallback := newErrorRoutingWorkflow(t)
+
+    stub := newSelectiveExecutor()
+    stub.failures[action.ID] = errors.New("upstream service unavailable")
+    executor := newErrorRoutingExecutor(stub)
+
+    assert.NoError(t, executor.ExecuteWorkflow(context.Background(), workflow))
+
+    assert.True(t, stub.ran(fallback.ID), "fallback should run when its source fails")
+    assert.False(t, stub.ran(downstream.ID), "abandoned success branch must not run")
+
+    input := stub.inputFor(fallback.ID)
+    assert.Equal(t, "upstream service unavailable", input["error"])
+    assert.Equal(t, action.ID.String(), input["failed_node_id"])
+}
+
+// TestErrorBranchSkippedOnSuccess tests that the fallback never executes
+// when its source succeeds
+func TestErrorBranchSkippedOnSuccess(t *testing.T) {
+    workflow, _, downstream, fallback := newErrorRoutingWorkflow(t)
+
+    stub := newSelectiveExecutor()
+    executor := newErrorRoutingExecutor(stub)
+
+    assert.NoError(t, executor.ExecuteWorkflow(context.Background(), workflow))
+
+    assert.True(t, stub.ran(downstream.ID), "success branch should run")
+    assert.False(t, stub.ran(fallback.ID), "fallback must not run on success")
+}
+
+// TestUnhandledFailureStillAborts tests that a failure without an on-error
+// branch keeps the original abort behavior
+func TestUnhandledFailureStillAborts(t *testing.T) {
+    workflow, err := models.NewWorkflow(uuid.New(), "unhandled", "no error branch")
+    assert.NoError(t, err)
+
+    trigger := addRoutingNode(t, workflow, models.TriggerNode)
+    action := addRoutingNode(t, workflow, models.ActionNode)
+    assert.NoError(t, workflow.Connect(trigger.ID, action.ID))
+
+    stub := newSelectiveExecutor()
+    failure := errors.New("upstream service unavailable")
+    stub.failures[action.ID] = failure
+    executor := newErrorRoutingExecutor(stub)
+
+    err = executor.ExecuteWorkflow(context.Background(), workflow)
+    assert.ErrorIs(t, err, failure)
+}
@@ -0,0 +1,78 @@
+package unit
+
+import (
+    "context"
+    "fmt"
+    "testing"
+
+    "github.com/google/uuid"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// BenchmarkExecuteWorkflow measures end-to-end execution dispatch across
+// workflow sizes, with node work stubbed out so the numbers reflect the
+// executor's graph scheduling overhead
+func BenchmarkExecuteWorkflow(b *testing.B) {
+    for _, nodeCount := range []int{2, 5, 10, 20} {
+        b.Run(fmt.Sprintf("nodes-%d", nodeCount), func(b *testing.B) {
+            executor := newTestExecutor(&recordingExecutor{})
+            workflow := createTestWorkflow(uuid.New(), nodeCount)
+            ctx := context.Background()
+
+            b.ReportAllocs()
+            b.ResetTimer()
+            for i := 0; i < b.N; i++ {
+                if err := executor.ExecuteWorkflow(ctx, workflow); err != nil {
+                    b.Fatalf("execution failed: %v", err)
+                }
+            }
+        })
+    }
+}
+
+// BenchmarkExecuteWorkflowParallel measures scheduling overhead under
+// concurrent executions of distinct workflows, the server's steady state
+func BenchmarkExecuteWorkflowParallel(b *testing.B) {
+    executor := newTestExecutor(&recordingExecutor{})
+
+    b.ReportAllocs()
+    b.RunParallel(func(pb *testing.PB) {
+        workflow := createTestWorkflow(uuid.New(), defaultNodeCount)
+        ctx := context.Background()
+        for pb.Next() {
+            if err := executor.ExecuteWorkflow(ctx, workflow); err != nil {
+                b.Fatalf("execution failed: %v", err)
+            }
+        }
+    })
+}
+
+// BenchmarkScheduleWorkflow measures schedule install and removal, the
+// operations the schedule CRUD endpoints sit on
+func BenchmarkScheduleWorkflow(b *testing.B) {
+    scheduler := core.NewScheduler(newTestExecutor(&recordingExecutor{}), core.SchedulerConfig{})
+    defer scheduler.Stop()
+
+    workflow, err := models.NewWorkflow(uuid.New(), "bench", "schedule benchmark")
+    if err != nil {
+        b.Fatalf("failed to create workflow: %v", err)
+    }
+    config := map[string]interface{}{
+        "type":     "interval",
+        "interval": float64(3600),
+    }
+    ctx := context.Background()
+
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        if err := scheduler.ScheduleWorkflow(ctx, workflow, config); err != nil {
+            b.Fatalf("failed to schedule workflow: %v", err)
+        }
+        if err := scheduler.UnscheduleWorkflow(ctx, workflow.ID); err != nil {
+            b.Fatalf("failed to unschedule workflow: %v", err)
+        }
+    }
+}
@@ -0,0 +1,123 @@
+package unit
+
+import (
+    "context"
+    "sync"
+    "testing"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// guardedAIStub emits a bad output until retry feedback arrives, then a
+// corrected one, recording the feedback it was given
+type guardedAIStub struct {
+    mu        sync.Mutex
+    bad       string
+    good      string
+    calls     int
+    feedbacks []string
+}
+
+func (g *guardedAIStub) Execute(ctx context.Context, node *models.Node, input map[string]interface{}) (map[string]interface{}, error) {
+    feedback, _ := input["guardrail_feedback"].(string)
+
+    g.mu.Lock()
+    g.calls++
+    if feedback != "" {
+        g.feedbacks = append(g.feedbacks, feedback)
+    }
+    g.mu.Unlock()
+
+    if feedback == "" {
+        return map[string]interface{}{"output": g.bad}, nil
+    }
+    return map[string]interface{}{"output": g.good}, nil
+}
+
+func (g *guardedAIStub) Validate(node *models.Node) error { return nil }
+
+func (g *guardedAIStub) callCount() int {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    return g.calls
+}
+
+// createGuardedWorkflow builds a trigger -> AI node workflow whose AI
+// node carries the given guardrails block
+func createGuardedWorkflow(guardrails map[string]interface{}) *models.Workflow {
+    workflow, _ := models.NewWorkflow(uuid.New(), "Guarded", "guardrail test")
+
+    trigger := createTestNode(workflow.ID, models.TriggerNode, nil)
+    workflow.AddNode(trigger)
+
+    aiNode := createTestNode(workflow.ID, models.AITaskNode, map[string]interface{}{
+        "ai_model":   "gpt-4",
+        "task":       "analyze",
+        "guardrails": guardrails,
+    })
+    workflow.AddNode(aiNode)
+    workflow.Connect(trigger.ID, aiNode.ID)
+
+    return workflow
+}
+
+// newGuardedExecutor registers the AI stub alongside the recording stub
+// the trigger node needs
+func newGuardedExecutor(stub *guardedAIStub) *core.Executor {
+    executor := core.NewExecutor(nil, nil)
+    executor.RegisterNodeExecutor(models.TriggerNode, &recordingExecutor{})
+    executor.RegisterNodeExecutor(models.AITaskNode, stub)
+    return executor
+}
+
+// TestGuardrailRetryWithFeedback tests that a rejected output is retried
+// with feedback describing the violation and the corrected output passes
+func TestGuardrailRetryWithFeedback(t *testing.T) {
+    stub := &guardedAIStub{bad: "the forbidden answer", good: "a clean answer"}
+    executor := newGuardedExecutor(stub)
+
+    workflow := createGuardedWorkflow(map[string]interface{}{
+        "deny_patterns": []interface{}{"forbidden"},
+    })
+
+    err := executor.ExecuteWorkflow(context.Background(), workflow)
+    assert.NoError(t, err)
+    assert.Equal(t, 2, stub.callCount(), "the rejected attempt should be retried once")
+    assert.Contains(t, stub.feedbacks[0], "denied pattern", "feedback should name the violation")
+}
+
+// TestGuardrailRejectFail tests that output still failing after the
+// retry budget fails the run by default
+func TestGuardrailRejectFail(t *testing.T) {
+    stub := &guardedAIStub{bad: "forbidden", good: "still forbidden"}
+    executor := newGuardedExecutor(stub)
+
+    workflow := createGuardedWorkflow(map[string]interface{}{
+        "deny_patterns": []interface{}{"forbidden"},
+        "max_retries":   float64(1),
+    })
+
+    err := executor.ExecuteWorkflow(context.Background(), workflow)
+    assert.ErrorIs(t, err, core.ErrGuardrailRejected)
+    assert.Equal(t, 2, stub.callCount(), "one retry before rejecting")
+}
+
+// TestGuardrailRejectFlag tests that the flag behavior passes rejected
+// output downstream instead of failing the run
+func TestGuardrailRejectFlag(t *testing.T) {
+    stub := &guardedAIStub{bad: "forbidden", good: "still forbidden"}
+    executor := newGuardedExecutor(stub)
+
+    workflow := createGuardedWorkflow(map[string]interface{}{
+        "deny_patterns": []interface{}{"forbidden"},
+        "max_retries":   float64(0),
+        "on_reject":     "flag",
+    })
+
+    assert.NoError(t, executor.ExecuteWorkflow(context.Background(), workflow))
+    assert.Equal(t, 1, stub.callCount(), "no retry budget, flagged on the first attempt")
+}
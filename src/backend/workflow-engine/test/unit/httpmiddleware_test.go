@@ -0,0 +1,221 @@
+package unit
+
+import (
+    "net/http"
+    "net/http/httptest"
+    "testing"
+    "time"
+
+    "github.com/gofiber/fiber/v2"
+    "github.com/google/uuid"
+    "github.com/opentracing/opentracing-go"
+    "github.com/opentracing/opentracing-go/mocktracer"
+    "github.com/prometheus/client_golang/prometheus"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/pkg/httpmiddleware"
+)
+
+// newMiddlewareApp builds a fiber app with the given middleware and a
+// single echo route
+func newMiddlewareApp(middleware ...fiber.Handler) *fiber.App {
+    app := fiber.New()
+    for _, handler := range middleware {
+        app.Use(handler)
+    }
+    app.Get("/echo", func(c *fiber.Ctx) error {
+        return c.SendString("ok")
+    })
+    return app
+}
+
+// TestRequestIDGenerated tests that a request without an ID gets one
+// generated and echoed back
+func TestRequestIDGenerated(t *testing.T) {
+    app := newMiddlewareApp(httpmiddleware.RequestID(httpmiddleware.RequestIDConfig{}))
+
+    resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+
+    requestID := resp.Header.Get(httpmiddleware.RequestIDHeader)
+    assert.NotEmpty(t, requestID)
+    _, err = uuid.Parse(requestID)
+    assert.NoError(t, err, "generated request IDs should be UUIDs")
+}
+
+// TestRequestIDHonored tests that an incoming request ID is preserved
+func TestRequestIDHonored(t *testing.T) {
+    app := newMiddlewareApp(httpmiddleware.RequestID(httpmiddleware.RequestIDConfig{}))
+
+    req := httptest.NewRequest(http.MethodGet, "/echo", nil)
+    req.Header.Set(httpmiddleware.RequestIDHeader, "client-supplied")
+    resp, err := app.Test(req)
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+
+    assert.Equal(t, "client-supplied", resp.Header.Get(httpmiddleware.RequestIDHeader))
+}
+
+// TestTracingOpensServerSpan tests that each request gets a finished
+// span tagged with the method and status
+func TestTracingOpensServerSpan(t *testing.T) {
+    tracer := mocktracer.New()
+    app := newMiddlewareApp(httpmiddleware.Tracing(httpmiddleware.TracingConfig{Tracer: tracer}))
+
+    resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+
+    spans := tracer.FinishedSpans()
+    assert.Len(t, spans, 1)
+    assert.Equal(t, "GET /echo", spans[0].OperationName)
+    assert.Equal(t, "GET", spans[0].Tag("http.method"))
+    assert.EqualValues(t, http.StatusOK, spans[0].Tag("http.status_code"))
+}
+
+// TestTracingContinuesPropagatedTrace tests that a trace carried in the
+// request headers is continued rather than restarted
+func TestTracingContinuesPropagatedTrace(t *testing.T) {
+    tracer := mocktracer.New()
+    app := newMiddlewareApp(httpmiddleware.Tracing(httpmiddleware.TracingConfig{Tracer: tracer}))
+
+    parent := tracer.StartSpan("client")
+    req := httptest.NewRequest(http.MethodGet, "/echo", nil)
+    carrier := opentracing.HTTPHeadersCarrier(req.Header)
+    assert.NoError(t, tracer.Inject(parent.Context(), opentracing.HTTPHeaders, carrier))
+
+    resp, err := app.Test(req)
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+
+    spans := tracer.FinishedSpans()
+    assert.Len(t, spans, 1)
+    assert.Equal(t, parent.Context().(mocktracer.MockSpanContext).TraceID,
+        spans[0].SpanContext.TraceID, "server span should join the client trace")
+}
+
+// TestMetricsRecordsSeries tests that requests show up in the duration
+// and payload histograms labeled by route
+func TestMetricsRecordsSeries(t *testing.T) {
+    app := newMiddlewareApp(httpmiddleware.Metrics(httpmiddleware.MetricsConfig{Service: "middleware-test"}))
+
+    resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+
+    families, err := prometheus.DefaultGatherer.Gather()
+    assert.NoError(t, err)
+
+    recorded := make(map[string]bool)
+    for _, family := range families {
+        for _, metric := range family.GetMetric() {
+            for _, label := range metric.GetLabel() {
+                if label.GetName() == "service" && label.GetValue() == "middleware-test" {
+                    recorded[family.GetName()] = true
+                }
+            }
+        }
+    }
+    assert.True(t, recorded["http_request_duration_seconds"])
+    assert.True(t, recorded["http_response_payload_bytes"])
+}
+
+// TestAuthRejectsMissingToken tests the bearer token requirement
+func TestAuthRejectsMissingToken(t *testing.T) {
+    app := newMiddlewareApp(httpmiddleware.Auth(httpmiddleware.AuthConfig{
+        Validate: func(token string) (uuid.UUID, error) { return uuid.New(), nil },
+    }))
+
+    resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+    assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+// TestAuthAcceptsValidToken tests that a validated token exposes the
+// user ID to handlers
+func TestAuthAcceptsValidToken(t *testing.T) {
+    userID := uuid.New()
+    app := fiber.New()
+    app.Use(httpmiddleware.Auth(httpmiddleware.AuthConfig{
+        Validate: func(token string) (uuid.UUID, error) {
+            assert.Equal(t, "valid-token", token)
+            return userID, nil
+        },
+    }))
+    app.Get("/echo", func(c *fiber.Ctx) error {
+        assert.Equal(t, userID, c.Locals("userID"))
+        return c.SendString("ok")
+    })
+
+    req := httptest.NewRequest(http.MethodGet, "/echo", nil)
+    req.Header.Set(fiber.HeaderAuthorization, "Bearer valid-token")
+    resp, err := app.Test(req)
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+    assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// TestAuthExemptPaths tests that exempt prefixes are served without a
+// token
+func TestAuthExemptPaths(t *testing.T) {
+    app := newMiddlewareApp(httpmiddleware.Auth(httpmiddleware.AuthConfig{
+        Validate:    func(token string) (uuid.UUID, error) { return uuid.Nil, nil },
+        ExemptPaths: []string{"/echo"},
+    }))
+
+    resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+    assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// TestRateLimitEnforcesWindow tests that excess requests in a window are
+// rejected with 429 and a Retry-After header
+func TestRateLimitEnforcesWindow(t *testing.T) {
+    app := newMiddlewareApp(httpmiddleware.RateLimit(httpmiddleware.RateLimitConfig{
+        Max:    2,
+        Window: time.Minute,
+        KeyFunc: func(c *fiber.Ctx) string { return "fixed-key" },
+    }))
+
+    for i := 0; i < 2; i++ {
+        resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+        assert.NoError(t, err)
+        resp.Body.Close()
+        assert.Equal(t, http.StatusOK, resp.StatusCode)
+    }
+
+    resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+    assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+    assert.NotEmpty(t, resp.Header.Get(fiber.HeaderRetryAfter))
+}
+
+// TestRateLimitKeysAreIndependent tests that one key exhausting its
+// budget does not affect another
+func TestRateLimitKeysAreIndependent(t *testing.T) {
+    key := "first"
+    app := newMiddlewareApp(httpmiddleware.RateLimit(httpmiddleware.RateLimitConfig{
+        Max:    1,
+        Window: time.Minute,
+        KeyFunc: func(c *fiber.Ctx) string { return key },
+    }))
+
+    resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    resp.Body.Close()
+
+    resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    resp.Body.Close()
+    assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+    key = "second"
+    resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/echo", nil))
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+    assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,115 @@
+package unit
+
+import (
+    "context"
+    "encoding/json"
+    "net/http"
+    "net/http/httptest"
+    "testing"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+    "github.com/organization/workflow-engine/pkg/safehttp"
+)
+
+// newLoopbackClient builds a hardened client that may reach the local
+// test server; loopback is blocked by default
+func newLoopbackClient(t *testing.T) *safehttp.Client {
+    t.Helper()
+    client, err := safehttp.NewClient(safehttp.Config{Allowlist: []string{"127.0.0.0/8"}})
+    assert.NoError(t, err)
+    return client
+}
+
+// TestHTTPActionExecutor tests that outbound action nodes call their
+// destination through the hardened client and surface the response
+func TestHTTPActionExecutor(t *testing.T) {
+    ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+    defer cancel()
+
+    var received map[string]interface{}
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        _ = json.NewDecoder(r.Body).Decode(&received)
+        w.WriteHeader(http.StatusOK)
+        _, _ = w.Write([]byte(`{"ok":true}`))
+    }))
+    defer server.Close()
+
+    executor := core.NewHTTPActionExecutor(newLoopbackClient(t))
+    node := createTestNode(uuid.New(), models.ActionNode, map[string]interface{}{
+        "action_type": "http",
+        "url":         server.URL,
+        "method":      "POST",
+    })
+    assert.NoError(t, executor.Validate(node))
+
+    output, err := executor.Execute(ctx, node, map[string]interface{}{"key": "value"})
+    assert.NoError(t, err)
+    assert.Equal(t, http.StatusOK, output["status_code"])
+    assert.Contains(t, output["body"], `"ok":true`)
+    assert.Equal(t, "value", received["key"], "input should be forwarded as the request body")
+}
+
+// TestHTTPActionExecutorBlocksPrivate tests that without an allowlist
+// the hardened client refuses loopback destinations at dial time
+func TestHTTPActionExecutorBlocksPrivate(t *testing.T) {
+    ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+    defer cancel()
+
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.WriteHeader(http.StatusOK)
+    }))
+    defer server.Close()
+
+    client, err := safehttp.NewClient(safehttp.Config{})
+    assert.NoError(t, err)
+    executor := core.NewHTTPActionExecutor(client)
+
+    node := createTestNode(uuid.New(), models.ActionNode, map[string]interface{}{
+        "action_type": "http",
+        "url":         server.URL,
+    })
+    _, err = executor.Execute(ctx, node, nil)
+    assert.Error(t, err)
+    assert.Contains(t, err.Error(), "private")
+}
+
+// TestHTTPActionExecutorPassthrough tests that action nodes without an
+// outbound destination pass their input through unchanged
+func TestHTTPActionExecutorPassthrough(t *testing.T) {
+    ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+    defer cancel()
+
+    executor := core.NewHTTPActionExecutor(newLoopbackClient(t))
+    node := createTestNode(uuid.New(), models.ActionNode, nil)
+
+    input := map[string]interface{}{"message": "test"}
+    output, err := executor.Execute(ctx, node, input)
+    assert.NoError(t, err)
+    assert.Equal(t, input, output)
+}
+
+// TestHTTPActionExecutorErrorStatus tests that error statuses fail the
+// node so on-error branches can route them
+func TestHTTPActionExecutorErrorStatus(t *testing.T) {
+    ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+    defer cancel()
+
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.WriteHeader(http.StatusBadGateway)
+    }))
+    defer server.Close()
+
+    executor := core.NewHTTPActionExecutor(newLoopbackClient(t))
+    node := createTestNode(uuid.New(), models.ActionNode, map[string]interface{}{
+        "action_type": "http",
+        "url":         server.URL,
+    })
+
+    _, err := executor.Execute(ctx, node, nil)
+    assert.Error(t, err)
+    assert.Contains(t, err.Error(), "status 502")
+}
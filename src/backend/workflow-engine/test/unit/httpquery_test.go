@@ -0,0 +1,155 @@
+package unit
+
+import (
+    "net/url"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/pkg/httpquery"
+)
+
+// listOptions are the bounds used across the query parsing tests
+func listOptions() httpquery.Options {
+    return httpquery.Options{
+        AllowedSort:   map[string]string{"created_at": "created_at", "name": "name"},
+        AllowedFilter: map[string]string{"status": "status", "name": "name"},
+    }
+}
+
+// TestParseDefaults tests that an empty query yields the default page
+func TestParseDefaults(t *testing.T) {
+    params, err := httpquery.Parse(url.Values{}, listOptions())
+    assert.NoError(t, err)
+    assert.Equal(t, 20, params.Limit)
+    assert.Equal(t, 0, params.Offset)
+    assert.Empty(t, params.Sort)
+    assert.Empty(t, params.Filter)
+}
+
+// TestParseLimitBounds tests that limits are validated and capped
+func TestParseLimitBounds(t *testing.T) {
+    params, err := httpquery.Parse(url.Values{"limit": {"500"}}, listOptions())
+    assert.NoError(t, err)
+    assert.Equal(t, 100, params.Limit, "limit should be capped at the maximum")
+
+    _, err = httpquery.Parse(url.Values{"limit": {"0"}}, listOptions())
+    assert.Error(t, err)
+    _, err = httpquery.Parse(url.Values{"limit": {"abc"}}, listOptions())
+    assert.Error(t, err)
+}
+
+// TestParseCursor tests that cursors round-trip through the query and
+// invalid ones are rejected
+func TestParseCursor(t *testing.T) {
+    cursor := httpquery.EncodeCursor(40)
+    params, err := httpquery.Parse(url.Values{"cursor": {cursor}}, listOptions())
+    assert.NoError(t, err)
+    assert.Equal(t, 40, params.Offset)
+    assert.Equal(t, cursor, params.Cursor)
+
+    _, err = httpquery.Parse(url.Values{"cursor": {"not base64!"}}, listOptions())
+    assert.ErrorIs(t, err, httpquery.ErrInvalidCursor)
+}
+
+// TestParseSort tests sort expressions with mixed directions and the
+// allowlist rejection of unknown fields
+func TestParseSort(t *testing.T) {
+    params, err := httpquery.Parse(url.Values{"sort": {"-created_at, name"}}, listOptions())
+    assert.NoError(t, err)
+    assert.Equal(t, []httpquery.SortField{
+        {Field: "created_at", Descending: true},
+        {Field: "name"},
+    }, params.Sort)
+
+    _, err = httpquery.Parse(url.Values{"sort": {"secret_column"}}, listOptions())
+    assert.ErrorIs(t, err, httpquery.ErrInvalidSort)
+}
+
+// TestParseFilter tests repeated filter expressions and the rejection of
+// malformed forms, unknown fields, and unknown operators
+func TestParseFilter(t *testing.T) {
+    query := url.Values{"filter": {"status:eq:active", "name:like:demo%"}}
+    params, err := httpquery.Parse(query, listOptions())
+    assert.NoError(t, err)
+    assert.Equal(t, []httpquery.Filter{
+        {Field: "status", Operator: "eq", Value: "active"},
+        {Field: "name", Operator: "like", Value: "demo%"},
+    }, params.Filter)
+
+    _, err = httpquery.Parse(url.Values{"filter": {"status:active"}}, listOptions())
+    assert.ErrorIs(t, err, httpquery.ErrInvalidFilter)
+    _, err = httpquery.Parse(url.Values{"filter": {"owner:eq:bob"}}, listOptions())
+    assert.ErrorIs(t, err, httpquery.ErrInvalidFilter)
+    _, err = httpquery.Parse(url.Values{"filter": {"status:between:a"}}, listOptions())
+    assert.ErrorIs(t, err, httpquery.ErrInvalidFilter)
+}
+
+// TestCursorNavigation tests next and prev cursor derivation at the
+// edges of a listing
+func TestCursorNavigation(t *testing.T) {
+    params := httpquery.Params{Limit: 20, Offset: 20}
+    assert.Equal(t, httpquery.EncodeCursor(40), params.NextCursor(100))
+    assert.Equal(t, httpquery.EncodeCursor(0), params.PrevCursor())
+
+    first := httpquery.Params{Limit: 20}
+    assert.Empty(t, first.PrevCursor())
+
+    last := httpquery.Params{Limit: 20, Offset: 80}
+    assert.Empty(t, last.NextCursor(100), "exhausted listings have no next page")
+}
+
+// TestLinkHeader tests the RFC 5988 Link header for a middle page
+func TestLinkHeader(t *testing.T) {
+    params := httpquery.Params{Limit: 20, Offset: 20}
+    header := params.LinkHeader("/api/v1/workflows", 100)
+    assert.Contains(t, header, `rel="next"`)
+    assert.Contains(t, header, `rel="prev"`)
+    assert.Contains(t, header, "cursor="+httpquery.EncodeCursor(40))
+
+    assert.Empty(t, httpquery.Params{Limit: 20}.LinkHeader("/api/v1/workflows", 10))
+}
+
+// TestWhereClause tests SQL translation of filters with parameter
+// placeholders starting at the caller's offset
+func TestWhereClause(t *testing.T) {
+    filters := []httpquery.Filter{
+        {Field: "status", Operator: "eq", Value: "active"},
+        {Field: "name", Operator: "like", Value: "demo%"},
+    }
+    columns := map[string]string{"status": "w.status", "name": "w.name"}
+
+    clause, args, err := httpquery.WhereClause(filters, columns, 2)
+    assert.NoError(t, err)
+    assert.Equal(t, "w.status = $2 AND w.name LIKE $3", clause)
+    assert.Equal(t, []interface{}{"active", "demo%"}, args)
+
+    clause, args, err = httpquery.WhereClause(nil, columns, 1)
+    assert.NoError(t, err)
+    assert.Empty(t, clause)
+    assert.Empty(t, args)
+
+    _, _, err = httpquery.WhereClause([]httpquery.Filter{{Field: "owner", Operator: "eq"}}, columns, 1)
+    assert.ErrorIs(t, err, httpquery.ErrInvalidFilter)
+}
+
+// TestOrderClause tests SQL translation of sort fields through the
+// column allowlist
+func TestOrderClause(t *testing.T) {
+    sort := []httpquery.SortField{
+        {Field: "created_at", Descending: true},
+        {Field: "name"},
+    }
+    columns := map[string]string{"created_at": "w.created_at", "name": "w.name"}
+
+    clause, err := httpquery.OrderClause(sort, columns)
+    assert.NoError(t, err)
+    assert.Equal(t, "w.created_at DESC, w.name ASC", clause)
+
+    clause, err = httpquery.OrderClause(nil, columns)
+    assert.NoError(t, err)
+    assert.Empty(t, clause)
+
+    _, err = httpquery.OrderClause([]httpquery.SortField{{Field: "secret"}}, columns)
+    assert.ErrorIs(t, err, httpquery.ErrInvalidSort)
+}
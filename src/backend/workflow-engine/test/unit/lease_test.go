@@ -0,0 +1,75 @@
+package unit
+
+import (
+    "context"
+    "errors"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// flakyLeaseStore is a LeaseStore whose renewals can be failed on demand
+type flakyLeaseStore struct {
+    mu      sync.Mutex
+    failing bool
+}
+
+func (s *flakyLeaseStore) TryAcquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if s.failing {
+        return false, errors.New("lease store unavailable")
+    }
+    return true, nil
+}
+
+func (s *flakyLeaseStore) Release(ctx context.Context, name, holder string) error {
+    return nil
+}
+
+func (s *flakyLeaseStore) setFailing(failing bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.failing = failing
+}
+
+// TestLeaseDemotesAfterTTLWithoutRenewal tests that a leader survives a
+// transient renewal failure but steps down once the TTL elapses without a
+// successful renewal
+func TestLeaseDemotesAfterTTLWithoutRenewal(t *testing.T) {
+    store := &flakyLeaseStore{}
+    executor := newTestExecutor(&recordingExecutor{})
+    scheduler := core.NewScheduler(executor, core.SchedulerConfig{})
+    defer scheduler.Stop()
+    engine := core.NewEngine(executor, scheduler, core.EngineConfig{})
+    registry := core.NewInstanceRegistry(nil, engine, core.InstanceRegistryConfig{})
+
+    manager := core.NewSchedulerLeaseManager(store, engine, registry, core.SchedulerLeaseConfig{
+        Holder:        "replica-a",
+        TTL:           80 * time.Millisecond,
+        RenewInterval: 10 * time.Millisecond,
+    })
+    manager.Start()
+    defer manager.Stop()
+
+    assert.Eventually(t, manager.IsLeader, time.Second, 5*time.Millisecond,
+        "replica should acquire the uncontested lease")
+
+    // While renewals fail the replica keeps leadership inside the TTL
+    store.setFailing(true)
+    time.Sleep(30 * time.Millisecond)
+    assert.True(t, manager.IsLeader(), "a failed renew inside the TTL must not bounce leadership")
+
+    // Once the TTL elapses without a successful renewal it must step down
+    assert.Eventually(t, func() bool { return !manager.IsLeader() }, time.Second, 5*time.Millisecond,
+        "leadership must lapse once the TTL passes without renewal")
+
+    // A recovered store lets the replica take the lease back
+    store.setFailing(false)
+    assert.Eventually(t, manager.IsLeader, time.Second, 5*time.Millisecond,
+        "replica should reacquire the lease once the store recovers")
+}
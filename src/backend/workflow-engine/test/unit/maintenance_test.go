@@ -0,0 +1,61 @@
+package unit
+
+import (
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// TestMaintenanceManualPause tests that the operator pause suspends
+// scheduling until resumed
+func TestMaintenanceManualPause(t *testing.T) {
+    manager := core.NewMaintenanceManager()
+
+    paused, _ := manager.InMaintenance()
+    assert.False(t, paused)
+
+    manager.Pause("database upgrade")
+    paused, reason := manager.InMaintenance()
+    assert.True(t, paused)
+    assert.Equal(t, "database upgrade", reason)
+
+    manager.Resume()
+    paused, _ = manager.InMaintenance()
+    assert.False(t, paused)
+}
+
+// TestMaintenanceWindowActivates tests that scheduling is paused while a
+// declared window is in effect
+func TestMaintenanceWindowActivates(t *testing.T) {
+    manager := core.NewMaintenanceManager()
+
+    now := time.Now().UTC()
+    assert.NoError(t, manager.AddWindow(core.MaintenanceWindow{
+        Start:  now.Add(-time.Minute),
+        End:    now.Add(time.Minute),
+        Reason: "planned window",
+    }))
+
+    paused, reason := manager.InMaintenance()
+    assert.True(t, paused)
+    assert.Equal(t, "planned window", reason)
+    assert.Len(t, manager.Windows(), 1)
+}
+
+// TestMaintenanceRejectsInvalidWindows tests window validation
+func TestMaintenanceRejectsInvalidWindows(t *testing.T) {
+    manager := core.NewMaintenanceManager()
+    now := time.Now().UTC()
+
+    err := manager.AddWindow(core.MaintenanceWindow{Start: now, End: now})
+    assert.ErrorIs(t, err, core.ErrInvalidWindow)
+
+    err = manager.AddWindow(core.MaintenanceWindow{
+        Start: now.Add(-2 * time.Hour),
+        End:   now.Add(-time.Hour),
+    })
+    assert.ErrorIs(t, err, core.ErrInvalidWindow)
+}
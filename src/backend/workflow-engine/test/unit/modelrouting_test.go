@@ -0,0 +1,97 @@
+package unit
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    aipb "github.com/organization/workflow-engine/api/proto/ai/v1"
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// usageInvoker answers every batched request with a fixed token usage
+type usageInvoker struct {
+    usage *aipb.TokenUsage
+}
+
+func (i *usageInvoker) invoke(ctx context.Context, requests []*aipb.TaskRequest) ([]*aipb.TaskResponse, error) {
+    responses := make([]*aipb.TaskResponse, len(requests))
+    for n, request := range requests {
+        responses[n] = &aipb.TaskResponse{TaskId: request.TaskId, Content: "ok", Usage: i.usage}
+    }
+    return responses, nil
+}
+
+// recordedUsage captures what the router reported to the usage recorder
+type recordedUsage struct {
+    mu         sync.Mutex
+    workflowID uuid.UUID
+    model      string
+    usage      *aipb.TokenUsage
+    costPer1K  float64
+    calls      int
+}
+
+func (r *recordedUsage) record(workflowID uuid.UUID, model string, usage *aipb.TokenUsage, costPer1K float64) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.workflowID = workflowID
+    r.model = model
+    r.usage = usage
+    r.costPer1K = costPer1K
+    r.calls++
+}
+
+// newUsageRouter builds a router whose calls run through a stub batcher
+// reporting the given usage, avoiding a live AI service connection
+func newUsageRouter(usage *aipb.TokenUsage) *core.ModelRouter {
+    router := core.NewModelRouter(nil, 0)
+    invoker := &usageInvoker{usage: usage}
+    router.SetBatcher(core.NewAIBatcher(invoker.invoke, core.AIBatcherConfig{
+        LatencyBudget: 5 * time.Millisecond,
+    }))
+    return router
+}
+
+// TestModelRouterRecordsTokenUsage tests that a completed task's token
+// counts are attributed to the execution with the serving model's price
+func TestModelRouterRecordsTokenUsage(t *testing.T) {
+    router := newUsageRouter(&aipb.TokenUsage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15})
+    recorder := &recordedUsage{}
+    router.SetTokenUsageRecorder(recorder.record)
+
+    workflowID := uuid.New()
+    request := &aipb.TaskRequest{
+        TaskId:   "task-1",
+        Metadata: map[string]string{"workflow_id": workflowID.String()},
+    }
+    policy := core.ModelRoutingPolicy{
+        Primary: "gpt-4",
+        Pricing: map[string]float64{"gpt-4": 0.03},
+    }
+
+    _, model, err := router.Execute(context.Background(), request, policy)
+    assert.NoError(t, err)
+    assert.Equal(t, "gpt-4", model)
+    assert.Equal(t, 1, recorder.calls)
+    assert.Equal(t, workflowID, recorder.workflowID)
+    assert.Equal(t, "gpt-4", recorder.model)
+    assert.Equal(t, int64(15), recorder.usage.TotalTokens)
+    assert.Equal(t, 0.03, recorder.costPer1K)
+}
+
+// TestModelRouterSkipsUnattributedUsage tests that usage without a
+// workflow in the request metadata is not recorded
+func TestModelRouterSkipsUnattributedUsage(t *testing.T) {
+    router := newUsageRouter(&aipb.TokenUsage{TotalTokens: 7})
+    recorder := &recordedUsage{}
+    router.SetTokenUsageRecorder(recorder.record)
+
+    _, _, err := router.Execute(context.Background(), &aipb.TaskRequest{TaskId: "task-2"}, core.ModelRoutingPolicy{Primary: "gpt-4"})
+    assert.NoError(t, err)
+    assert.Equal(t, 0, recorder.calls)
+}
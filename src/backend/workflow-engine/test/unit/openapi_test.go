@@ -0,0 +1,154 @@
+package unit
+
+import (
+    "encoding/json"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+
+    "github.com/gofiber/fiber/v2"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/middleware"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// validationResponse is the error body the validator returns on failure
+type validationResponse struct {
+    Error   string                  `json:"error"`
+    Details []middleware.FieldError `json:"details"`
+}
+
+// newOpenAPIApp builds a fiber app with the preloaded validator in front
+// of accepting write handlers
+func newOpenAPIApp(validator *middleware.OpenAPIValidator) *fiber.App {
+    app := fiber.New()
+    app.Use(validator.Handler())
+    accept := func(c *fiber.Ctx) error { return c.SendStatus(http.StatusCreated) }
+    app.Post("/api/v1/workflows", accept)
+    app.Post("/api/v1/workflows/:id/nodes", accept)
+    app.Post("/api/v1/workflows/:id/connections", accept)
+    return app
+}
+
+// postJSON sends a JSON body and decodes the validation response, which
+// is empty on success
+func postJSON(t *testing.T, app *fiber.App, path, body string) (int, validationResponse) {
+    t.Helper()
+
+    req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+    req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+    resp, err := app.Test(req)
+    assert.NoError(t, err)
+    defer resp.Body.Close()
+
+    var decoded validationResponse
+    _ = json.NewDecoder(resp.Body).Decode(&decoded)
+    return resp.StatusCode, decoded
+}
+
+// pointers collects the JSON pointers of the reported failures
+func pointers(details []middleware.FieldError) []string {
+    collected := make([]string, 0, len(details))
+    for _, detail := range details {
+        collected = append(collected, detail.Pointer)
+    }
+    return collected
+}
+
+// TestOpenAPIValidPayloadPasses tests that a conforming payload reaches
+// the handler, unknown keys included
+func TestOpenAPIValidPayloadPasses(t *testing.T) {
+    app := newOpenAPIApp(middleware.NewOpenAPIValidator())
+
+    status, _ := postJSON(t, app, "/api/v1/workflows",
+        `{"name": "demo", "description": "d", "future_field": 1}`)
+    assert.Equal(t, http.StatusCreated, status)
+}
+
+// TestOpenAPIMissingRequired tests that absent required properties are
+// reported with their pointer
+func TestOpenAPIMissingRequired(t *testing.T) {
+    app := newOpenAPIApp(middleware.NewOpenAPIValidator())
+
+    status, response := postJSON(t, app, "/api/v1/workflows", `{"description": "d"}`)
+    assert.Equal(t, http.StatusBadRequest, status)
+    assert.Equal(t, "request validation failed", response.Error)
+    assert.Contains(t, pointers(response.Details), "/name")
+}
+
+// TestOpenAPICollectsAllFailures tests that every violation is reported
+// rather than stopping at the first
+func TestOpenAPICollectsAllFailures(t *testing.T) {
+    app := newOpenAPIApp(middleware.NewOpenAPIValidator())
+
+    status, response := postJSON(t, app, "/api/v1/workflows",
+        `{"name": 7, "metadata": "not-an-object"}`)
+    assert.Equal(t, http.StatusBadRequest, status)
+    assert.ElementsMatch(t, []string{"/name", "/metadata"}, pointers(response.Details))
+}
+
+// TestOpenAPINestedArrayPointers tests that failures inside array items
+// are located with index-extended pointers
+func TestOpenAPINestedArrayPointers(t *testing.T) {
+    app := newOpenAPIApp(middleware.NewOpenAPIValidator())
+
+    status, response := postJSON(t, app, "/api/v1/workflows",
+        `{"name": "demo", "nodes": [{"type": "trigger", "name": "ok"}, {"type": "unknown", "name": 3}]}`)
+    assert.Equal(t, http.StatusBadRequest, status)
+    assert.ElementsMatch(t, []string{"/nodes/1/type", "/nodes/1/name"}, pointers(response.Details))
+}
+
+// TestOpenAPIEnumViolation tests that values outside an enum are
+// rejected with a message naming the offending value
+func TestOpenAPIEnumViolation(t *testing.T) {
+    app := newOpenAPIApp(middleware.NewOpenAPIValidator())
+
+    status, response := postJSON(t, app, "/api/v1/workflows/abc/nodes",
+        `{"type": "webhook", "name": "n"}`)
+    assert.Equal(t, http.StatusBadRequest, status)
+    assert.Len(t, response.Details, 1)
+    assert.Equal(t, "/type", response.Details[0].Pointer)
+    assert.Contains(t, response.Details[0].Message, "allowed values")
+}
+
+// TestOpenAPIInvalidJSON tests that malformed bodies are rejected before
+// schema validation
+func TestOpenAPIInvalidJSON(t *testing.T) {
+    app := newOpenAPIApp(middleware.NewOpenAPIValidator())
+
+    status, response := postJSON(t, app, "/api/v1/workflows", `{"name": `)
+    assert.Equal(t, http.StatusBadRequest, status)
+    assert.Equal(t, "request body is not valid JSON", response.Error)
+}
+
+// TestOpenAPIUnregisteredOperationPasses tests that operations without a
+// registered schema, and empty bodies, pass through untouched
+func TestOpenAPIUnregisteredOperationPasses(t *testing.T) {
+    validator := middleware.NewOpenAPIValidator()
+    app := newOpenAPIApp(validator)
+    app.Post("/api/v1/other", func(c *fiber.Ctx) error { return c.SendStatus(http.StatusCreated) })
+
+    status, _ := postJSON(t, app, "/api/v1/other", `{"anything": true}`)
+    assert.Equal(t, http.StatusCreated, status)
+
+    status, _ = postJSON(t, app, "/api/v1/workflows", "")
+    assert.Equal(t, http.StatusCreated, status)
+}
+
+// TestOpenAPIRegisterReplacesSchema tests that re-registering an
+// operation swaps in the new schema
+func TestOpenAPIRegisterReplacesSchema(t *testing.T) {
+    validator := middleware.NewOpenAPIValidator()
+    validator.Register(http.MethodPost, "/api/v1/workflows", &models.ConfigSchema{
+        Title:      "Stricter Create Workflow Request",
+        Properties: map[string]*models.SchemaProperty{"name": {Type: "string"}},
+        Required:   []string{"name", "description"},
+    })
+    app := newOpenAPIApp(validator)
+
+    status, response := postJSON(t, app, "/api/v1/workflows", `{"name": "demo"}`)
+    assert.Equal(t, http.StatusBadRequest, status)
+    assert.Contains(t, pointers(response.Details), "/description")
+}
@@ -0,0 +1,163 @@
+package unit
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// fakeQueueTarget stands in for the engine so tests control how much
+// execution capacity the dispatch loop sees and what startup returns
+type fakeQueueTarget struct {
+    mu       sync.Mutex
+    max      int
+    started  []uuid.UUID
+    seeds    []*core.Determinism
+    startErr []error
+}
+
+func (f *fakeQueueTarget) ActiveExecutions() int { return 0 }
+
+func (f *fakeQueueTarget) MaxConcurrentExecutions() int {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    return f.max
+}
+
+func (f *fakeQueueTarget) StartWorkflow(ctx context.Context, workflowID uuid.UUID, opts map[string]interface{}) error {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    f.started = append(f.started, workflowID)
+    f.seeds = append(f.seeds, core.DeterminismFromContext(ctx))
+    if len(f.startErr) > 0 {
+        err := f.startErr[0]
+        f.startErr = f.startErr[1:]
+        return err
+    }
+    return nil
+}
+
+func (f *fakeQueueTarget) setMax(max int) {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    f.max = max
+}
+
+func (f *fakeQueueTarget) startedCount() int {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    return len(f.started)
+}
+
+// waitQueueResult reads a queued execution's outcome with a timeout so a
+// broken dispatch loop fails the test instead of hanging it
+func waitQueueResult(t *testing.T, done <-chan error) error {
+    t.Helper()
+    select {
+    case err := <-done:
+        return err
+    case <-time.After(2 * time.Second):
+        t.Fatal("timed out waiting for queued execution result")
+        return nil
+    }
+}
+
+// TestQueuePreemptsLowPriorityWork tests that a full queue drops the
+// lowest-priority queued execution when higher-priority work arrives,
+// and rejects work that does not outrank anything queued
+func TestQueuePreemptsLowPriorityWork(t *testing.T) {
+    target := &fakeQueueTarget{} // no capacity, nothing dispatches
+    queue := core.NewExecutionQueue(target, 2)
+    defer queue.Shutdown()
+
+    low := map[string]interface{}{"priority": float64(core.PriorityLow)}
+    _, err := queue.Enqueue(context.Background(), uuid.New(), low)
+    assert.NoError(t, err)
+    victim, err := queue.Enqueue(context.Background(), uuid.New(), low)
+    assert.NoError(t, err)
+
+    // High-priority work preempts the most recently queued low entry
+    high := map[string]interface{}{"priority": float64(core.PriorityHigh)}
+    _, err = queue.Enqueue(context.Background(), uuid.New(), high)
+    assert.NoError(t, err)
+    assert.ErrorIs(t, waitQueueResult(t, victim), core.ErrPreempted)
+    assert.Equal(t, 2, queue.Depth())
+
+    // Equal-priority work cannot preempt and is rejected outright
+    _, err = queue.Enqueue(context.Background(), uuid.New(), low)
+    assert.ErrorIs(t, err, core.ErrQueueFull)
+}
+
+// TestQueueDispatchesWhenCapacityFrees tests that queued work starts
+// once the engine reports free capacity
+func TestQueueDispatchesWhenCapacityFrees(t *testing.T) {
+    target := &fakeQueueTarget{}
+    queue := core.NewExecutionQueue(target, 10)
+    defer queue.Shutdown()
+
+    done, err := queue.Enqueue(context.Background(), uuid.New(), nil)
+    assert.NoError(t, err)
+    assert.Equal(t, 1, queue.Depth())
+    assert.Equal(t, 0, target.startedCount())
+
+    target.setMax(1)
+    assert.NoError(t, waitQueueResult(t, done))
+    assert.Equal(t, 1, target.startedCount())
+    assert.Equal(t, 0, queue.Depth())
+}
+
+// TestQueueShutdownFailsQueued tests that shutdown fails all queued
+// executions instead of leaving their callers waiting
+func TestQueueShutdownFailsQueued(t *testing.T) {
+    target := &fakeQueueTarget{}
+    queue := core.NewExecutionQueue(target, 10)
+
+    done, err := queue.Enqueue(context.Background(), uuid.New(), nil)
+    assert.NoError(t, err)
+
+    queue.Shutdown()
+    assert.ErrorIs(t, waitQueueResult(t, done), core.ErrQueueClosed)
+}
+
+// TestQueueDispatchCarriesRequestContext tests that a deterministic seed
+// attached to the enqueueing request survives the wait in the queue
+func TestQueueDispatchCarriesRequestContext(t *testing.T) {
+    target := &fakeQueueTarget{}
+    queue := core.NewExecutionQueue(target, 10)
+    defer queue.Shutdown()
+
+    ctx := core.WithDeterministicRun(context.Background(), 42, time.Time{})
+    done, err := queue.Enqueue(ctx, uuid.New(), nil)
+    assert.NoError(t, err)
+
+    target.setMax(1)
+    assert.NoError(t, waitQueueResult(t, done))
+
+    target.mu.Lock()
+    defer target.mu.Unlock()
+    assert.Len(t, target.seeds, 1)
+    if assert.NotNil(t, target.seeds[0], "determinism source should reach the dispatched run") {
+        assert.EqualValues(t, 42, target.seeds[0].Seed())
+    }
+}
+
+// TestQueueRequeuesOnCapacityRace tests that a dispatch losing the
+// capacity race to a directly started run is retried, not failed
+func TestQueueRequeuesOnCapacityRace(t *testing.T) {
+    target := &fakeQueueTarget{startErr: []error{core.ErrMaxConcurrentExecutions}}
+    queue := core.NewExecutionQueue(target, 10)
+    defer queue.Shutdown()
+
+    done, err := queue.Enqueue(context.Background(), uuid.New(), nil)
+    assert.NoError(t, err)
+
+    target.setMax(1)
+    assert.NoError(t, waitQueueResult(t, done))
+    assert.Equal(t, 2, target.startedCount(), "rejected dispatch should be retried")
+}
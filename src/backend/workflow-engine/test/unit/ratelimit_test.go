@@ -0,0 +1,162 @@
+package unit
+
+import (
+    "context"
+    "errors"
+    "net/http"
+    "net/http/httptest"
+    "testing"
+
+    "github.com/gofiber/fiber/v2"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/middleware"
+)
+
+// fakeLimitStore serves per-tenant limit overrides keyed tenant:class
+type fakeLimitStore struct {
+    overrides map[string]middleware.EndpointLimit
+    err       error
+}
+
+func (f *fakeLimitStore) GetRateLimit(ctx context.Context, tenant, class string) (middleware.EndpointLimit, bool, error) {
+    if f.err != nil {
+        return middleware.EndpointLimit{}, false, f.err
+    }
+    limit, found := f.overrides[tenant+":"+class]
+    return limit, found, nil
+}
+
+// newSoftRateLimitApp builds a fiber app with the limiter in front of a
+// cheap route, an expensive route, and an unlimited health check
+func newSoftRateLimitApp(limiter *middleware.SoftRateLimiter) *fiber.App {
+    app := fiber.New()
+    app.Use(limiter.Handler())
+    accept := func(c *fiber.Ctx) error { return c.SendString("ok") }
+    app.Get("/api/v1/workflows", accept)
+    app.Post("/api/v1/workflows/:id/execute", accept)
+    app.Get("/healthz", accept)
+    return app
+}
+
+// limitedRequest issues one request as the given tenant and returns the
+// response for status and header assertions
+func limitedRequest(t *testing.T, app *fiber.App, method, path, tenant string) *http.Response {
+    t.Helper()
+
+    req := httptest.NewRequest(method, path, nil)
+    if tenant != "" {
+        req.Header.Set("X-Tenant-ID", tenant)
+    }
+    resp, err := app.Test(req)
+    assert.NoError(t, err)
+    resp.Body.Close()
+    return resp
+}
+
+// TestSoftRateLimitBurstThenReject tests that a caller spends its burst
+// allowance and is then rejected with a Retry-After hint
+func TestSoftRateLimitBurstThenReject(t *testing.T) {
+    limiter := middleware.NewSoftRateLimiter(nil, middleware.RateLimitConfig{
+        Default: middleware.EndpointLimit{PerMinute: 60, Burst: 3},
+    })
+    app := newSoftRateLimitApp(limiter)
+
+    for i := 0; i < 3; i++ {
+        resp := limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "acme")
+        assert.Equal(t, http.StatusOK, resp.StatusCode, "request %d should fit in the burst", i+1)
+    }
+
+    resp := limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "acme")
+    assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+    assert.Equal(t, "1", resp.Header.Get("Retry-After"))
+}
+
+// TestSoftRateLimitExpensiveClass tests that execute-style endpoints
+// draw from the stricter expensive budget while cheap reads continue
+func TestSoftRateLimitExpensiveClass(t *testing.T) {
+    limiter := middleware.NewSoftRateLimiter(nil, middleware.RateLimitConfig{
+        Default:   middleware.EndpointLimit{PerMinute: 300, Burst: 50},
+        Expensive: middleware.EndpointLimit{PerMinute: 30, Burst: 1},
+    })
+    app := newSoftRateLimitApp(limiter)
+
+    resp := limitedRequest(t, app, http.MethodPost, "/api/v1/workflows/abc/execute", "acme")
+    assert.Equal(t, http.StatusOK, resp.StatusCode)
+    resp = limitedRequest(t, app, http.MethodPost, "/api/v1/workflows/abc/execute", "acme")
+    assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+    // The default-class bucket is untouched by the expensive spend
+    resp = limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "acme")
+    assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// TestSoftRateLimitTenantOverride tests that a stored override replaces
+// the configured default for that tenant only
+func TestSoftRateLimitTenantOverride(t *testing.T) {
+    store := &fakeLimitStore{overrides: map[string]middleware.EndpointLimit{
+        "vip:" + middleware.ClassDefault: {PerMinute: 600, Burst: 5},
+    }}
+    limiter := middleware.NewSoftRateLimiter(store, middleware.RateLimitConfig{
+        Default: middleware.EndpointLimit{PerMinute: 60, Burst: 1},
+    })
+    app := newSoftRateLimitApp(limiter)
+
+    for i := 0; i < 5; i++ {
+        resp := limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "vip")
+        assert.Equal(t, http.StatusOK, resp.StatusCode, "override burst should admit request %d", i+1)
+    }
+
+    resp := limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "standard")
+    assert.Equal(t, http.StatusOK, resp.StatusCode)
+    resp = limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "standard")
+    assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode,
+        "tenants without an override keep the configured default")
+}
+
+// TestSoftRateLimitStoreErrorFallsBack tests that a failing override
+// store degrades to the configured defaults instead of blocking traffic
+func TestSoftRateLimitStoreErrorFallsBack(t *testing.T) {
+    store := &fakeLimitStore{err: errors.New("settings table unavailable")}
+    limiter := middleware.NewSoftRateLimiter(store, middleware.RateLimitConfig{
+        Default: middleware.EndpointLimit{PerMinute: 60, Burst: 2},
+    })
+    app := newSoftRateLimitApp(limiter)
+
+    for i := 0; i < 2; i++ {
+        resp := limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "acme")
+        assert.Equal(t, http.StatusOK, resp.StatusCode)
+    }
+    resp := limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "acme")
+    assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+}
+
+// TestSoftRateLimitTenantsIndependent tests that one tenant exhausting
+// its budget does not affect another
+func TestSoftRateLimitTenantsIndependent(t *testing.T) {
+    limiter := middleware.NewSoftRateLimiter(nil, middleware.RateLimitConfig{
+        Default: middleware.EndpointLimit{PerMinute: 60, Burst: 1},
+    })
+    app := newSoftRateLimitApp(limiter)
+
+    limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "acme")
+    resp := limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "acme")
+    assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+    resp = limitedRequest(t, app, http.MethodGet, "/api/v1/workflows", "globex")
+    assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// TestSoftRateLimitSkipsNonAPIPaths tests that health and metrics style
+// paths bypass the limiter entirely
+func TestSoftRateLimitSkipsNonAPIPaths(t *testing.T) {
+    limiter := middleware.NewSoftRateLimiter(nil, middleware.RateLimitConfig{
+        Default: middleware.EndpointLimit{PerMinute: 60, Burst: 1},
+    })
+    app := newSoftRateLimitApp(limiter)
+
+    for i := 0; i < 5; i++ {
+        resp := limitedRequest(t, app, http.MethodGet, "/healthz", "acme")
+        assert.Equal(t, http.StatusOK, resp.StatusCode)
+    }
+}
@@ -0,0 +1,119 @@
+package unit
+
+import (
+    "context"
+    "sync"
+    "testing"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// memoryExecutionStore is an in-memory ExecutionStore for unit tests
+type memoryExecutionStore struct {
+    mu         sync.Mutex
+    executions []*models.Execution
+    workflows  map[uuid.UUID]*models.Workflow
+}
+
+func newMemoryExecutionStore() *memoryExecutionStore {
+    return &memoryExecutionStore{workflows: make(map[uuid.UUID]*models.Workflow)}
+}
+
+func (m *memoryExecutionStore) ListByStatus(ctx context.Context, status string, limit int) ([]*models.Execution, error) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    matched := make([]*models.Execution, 0)
+    for _, execution := range m.executions {
+        if execution.Status == status && len(matched) < limit {
+            matched = append(matched, execution)
+        }
+    }
+    return matched, nil
+}
+
+func (m *memoryExecutionStore) Update(ctx context.Context, execution *models.Execution) error {
+    return nil
+}
+
+func (m *memoryExecutionStore) GetWorkflow(ctx context.Context, id uuid.UUID) (*models.Workflow, error) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    workflow, exists := m.workflows[id]
+    if !exists {
+        return nil, core.ErrWorkflowNotFound
+    }
+    return workflow, nil
+}
+
+// deadInstanceChecker reports every instance as lost
+type deadInstanceChecker struct{}
+
+func (deadInstanceChecker) IsAlive(ctx context.Context, instanceID string) (bool, error) {
+    return false, nil
+}
+
+// TestRecoverOrphanedExecutions tests that orphaned running executions are
+// re-executed or failed according to checkpoint state and retry budget
+func TestRecoverOrphanedExecutions(t *testing.T) {
+    store := newMemoryExecutionStore()
+    workflow := createTestWorkflow(uuid.New(), 2)
+    store.workflows[workflow.ID] = workflow
+
+    checkpointed, err := models.NewExecution(workflow.ID, "lost-instance")
+    assert.NoError(t, err)
+    checkpointed.MarkRunning("lost-instance")
+    checkpointed.SetCheckpoint(map[string]interface{}{"node": "step-1"})
+
+    retryable, err := models.NewExecution(workflow.ID, "lost-instance")
+    assert.NoError(t, err)
+    retryable.MarkRunning("lost-instance")
+
+    exhausted, err := models.NewExecution(workflow.ID, "lost-instance")
+    assert.NoError(t, err)
+    exhausted.MarkRunning("lost-instance")
+    exhausted.RetryCount = 3
+
+    store.executions = []*models.Execution{checkpointed, retryable, exhausted}
+
+    recorder := &recordingExecutor{}
+    manager := core.NewRecoveryManager(store, deadInstanceChecker{}, newTestExecutor(recorder), core.RecoveryConfig{MaxRetries: 3})
+    assert.NoError(t, manager.RecoverOrphanedExecutions(context.Background()))
+
+    // The checkpointed and retryable executions re-execute the workflow's
+    // two nodes each; the exhausted one is marked failed without running
+    assert.Equal(t, 4, recorder.count())
+    assert.Equal(t, 1, retryable.RetryCount, "retry should consume retry budget")
+    assert.Equal(t, "failed", exhausted.Status)
+    assert.Equal(t, "instance lost, max retries exceeded", exhausted.RecoveryReason)
+}
+
+// TestRecoverSkipsLiveInstances tests that executions owned by healthy
+// instances are left alone
+func TestRecoverSkipsLiveInstances(t *testing.T) {
+    store := newMemoryExecutionStore()
+    workflow := createTestWorkflow(uuid.New(), 2)
+    store.workflows[workflow.ID] = workflow
+
+    running, err := models.NewExecution(workflow.ID, "healthy-instance")
+    assert.NoError(t, err)
+    running.MarkRunning("healthy-instance")
+    store.executions = []*models.Execution{running}
+
+    recorder := &recordingExecutor{}
+    manager := core.NewRecoveryManager(store, liveInstanceChecker{}, newTestExecutor(recorder), core.RecoveryConfig{})
+    assert.NoError(t, manager.RecoverOrphanedExecutions(context.Background()))
+
+    assert.Zero(t, recorder.count(), "executions on live instances must not be re-run")
+    assert.Equal(t, "running", running.Status)
+}
+
+// liveInstanceChecker reports every instance as healthy
+type liveInstanceChecker struct{}
+
+func (liveInstanceChecker) IsAlive(ctx context.Context, instanceID string) (bool, error) {
+    return true, nil
+}
@@ -0,0 +1,135 @@
+package unit
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+    "github.com/organization/workflow-engine/internal/models"
+)
+
+// memoryScheduleStore is an in-memory ScheduleStateStore for unit tests
+type memoryScheduleStore struct {
+    mu        sync.Mutex
+    schedules map[uuid.UUID]core.PersistedSchedule
+}
+
+func newMemoryScheduleStore() *memoryScheduleStore {
+    return &memoryScheduleStore{schedules: make(map[uuid.UUID]core.PersistedSchedule)}
+}
+
+func (m *memoryScheduleStore) SaveSchedule(ctx context.Context, workflowID uuid.UUID, config map[string]interface{}, paused bool) error {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    entry := m.schedules[workflowID]
+    entry.WorkflowID = workflowID
+    entry.Config = config
+    entry.Paused = paused
+    m.schedules[workflowID] = entry
+    return nil
+}
+
+func (m *memoryScheduleStore) DeleteSchedule(ctx context.Context, workflowID uuid.UUID) error {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    delete(m.schedules, workflowID)
+    return nil
+}
+
+func (m *memoryScheduleStore) SaveLastRun(ctx context.Context, workflowID uuid.UUID, lastRun time.Time) error {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    entry := m.schedules[workflowID]
+    entry.WorkflowID = workflowID
+    entry.LastRun = lastRun
+    m.schedules[workflowID] = entry
+    return nil
+}
+
+func (m *memoryScheduleStore) LoadSchedules(ctx context.Context) ([]core.PersistedSchedule, error) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    entries := make([]core.PersistedSchedule, 0, len(m.schedules))
+    for _, entry := range m.schedules {
+        entries = append(entries, entry)
+    }
+    return entries, nil
+}
+
+func (m *memoryScheduleStore) get(workflowID uuid.UUID) (core.PersistedSchedule, bool) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    entry, exists := m.schedules[workflowID]
+    return entry, exists
+}
+
+var intervalScheduleConfig = map[string]interface{}{
+    "type":     "interval",
+    "interval": float64(3600),
+}
+
+// TestSchedulePersistence tests that schedule lifecycle operations are
+// mirrored into the state store
+func TestSchedulePersistence(t *testing.T) {
+    store := newMemoryScheduleStore()
+    scheduler := core.NewScheduler(newTestExecutor(&recordingExecutor{}), core.SchedulerConfig{})
+    defer scheduler.Stop()
+    scheduler.SetStateStore(store)
+
+    workflow, err := models.NewWorkflow(uuid.New(), "persisted", "schedule persistence")
+    assert.NoError(t, err)
+
+    ctx := context.Background()
+    assert.NoError(t, scheduler.ScheduleWorkflow(ctx, workflow, intervalScheduleConfig))
+    entry, exists := store.get(workflow.ID)
+    assert.True(t, exists, "schedule should be persisted on install")
+    assert.False(t, entry.Paused)
+
+    assert.NoError(t, scheduler.PauseSchedule(ctx, workflow.ID))
+    entry, _ = store.get(workflow.ID)
+    assert.True(t, entry.Paused, "pause should be persisted")
+
+    assert.NoError(t, scheduler.ResumeSchedule(ctx, workflow.ID))
+    entry, _ = store.get(workflow.ID)
+    assert.False(t, entry.Paused, "resume should be persisted")
+
+    assert.NoError(t, scheduler.UnscheduleWorkflow(ctx, workflow.ID))
+    _, exists = store.get(workflow.ID)
+    assert.False(t, exists, "unschedule should remove the persisted entry")
+}
+
+// TestRestoreSchedules tests that persisted schedules are reinstalled
+// with their paused flag and last-run time before the catch-up scan
+func TestRestoreSchedules(t *testing.T) {
+    store := newMemoryScheduleStore()
+    workflow := createTestWorkflow(uuid.New(), 2)
+    lastRun := time.Now().Add(-time.Hour).UTC()
+
+    store.SaveSchedule(context.Background(), workflow.ID, intervalScheduleConfig, true)
+    store.SaveLastRun(context.Background(), workflow.ID, lastRun)
+
+    // A schedule whose workflow no longer exists must not block startup
+    store.SaveSchedule(context.Background(), uuid.New(), intervalScheduleConfig, false)
+
+    scheduler := core.NewScheduler(newTestExecutor(&recordingExecutor{}), core.SchedulerConfig{})
+    defer scheduler.Stop()
+    scheduler.SetStateStore(store)
+
+    restored, err := scheduler.RestoreSchedules(context.Background(), func(ctx context.Context, workflowID uuid.UUID) (*models.Workflow, error) {
+        if workflowID == workflow.ID {
+            return workflow, nil
+        }
+        return nil, core.ErrWorkflowNotFound
+    })
+    assert.NoError(t, err)
+    assert.Equal(t, 1, restored)
+
+    paused, err := scheduler.IsSchedulePaused(workflow.ID)
+    assert.NoError(t, err)
+    assert.True(t, paused, "restored schedule should keep its paused flag")
+}
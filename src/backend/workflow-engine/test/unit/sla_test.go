@@ -0,0 +1,73 @@
+package unit
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/google/uuid"
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// collectingSink records SLA breaches for assertions
+type collectingSink struct {
+    mu       sync.Mutex
+    breaches []core.SLABreach
+}
+
+func (s *collectingSink) Notify(ctx context.Context, breach core.SLABreach) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.breaches = append(s.breaches, breach)
+    return nil
+}
+
+func (s *collectingSink) collected() []core.SLABreach {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return append([]core.SLABreach(nil), s.breaches...)
+}
+
+// TestSLAMonitorObservesExecutions tests that the monitor, registered as
+// an execution interceptor, raises a duration breach for slow runs
+func TestSLAMonitorObservesExecutions(t *testing.T) {
+    sink := &collectingSink{}
+    monitor := core.NewSLAMonitor(sink)
+
+    workflow := createTestWorkflow(uuid.New(), 2)
+    assert.NoError(t, monitor.SetSLA(workflow.ID, core.SLADefinition{MaxDuration: time.Nanosecond}))
+
+    executor := newTestExecutor(&recordingExecutor{})
+    executor.RegisterInterceptor(monitor)
+
+    assert.NoError(t, executor.ExecuteWorkflow(context.Background(), workflow))
+
+    breaches := sink.collected()
+    assert.Len(t, breaches, 1)
+    assert.Equal(t, core.SLABreachDuration, breaches[0].BreachType)
+    assert.Equal(t, workflow.ID, breaches[0].WorkflowID)
+}
+
+// TestSLAMonitorSuccessRateBreach tests that failures over the rolling
+// window trip the success rate target
+func TestSLAMonitorSuccessRateBreach(t *testing.T) {
+    sink := &collectingSink{}
+    monitor := core.NewSLAMonitor(sink)
+
+    workflowID := uuid.New()
+    assert.NoError(t, monitor.SetSLA(workflowID, core.SLADefinition{MinSuccessRate: 0.9, WindowSize: 4}))
+
+    ctx := context.Background()
+    for i := 0; i < 3; i++ {
+        monitor.RecordExecution(ctx, workflowID, time.Millisecond, nil)
+    }
+    assert.Empty(t, sink.collected(), "no breach before the window fills")
+
+    monitor.RecordExecution(ctx, workflowID, time.Millisecond, assert.AnError)
+    breaches := sink.collected()
+    assert.Len(t, breaches, 1)
+    assert.Equal(t, core.SLABreachSuccessRate, breaches[0].BreachType)
+}
@@ -0,0 +1,37 @@
+package unit
+
+import (
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/organization/workflow-engine/internal/core"
+)
+
+// TestTuningAppliesToExecutor tests that runtime tuning changes are read
+// by the executor without a restart
+func TestTuningAppliesToExecutor(t *testing.T) {
+    executor := newTestExecutor(&recordingExecutor{})
+    tuning := core.NewTuningManager(core.RuntimeSettings{
+        MaxConcurrentExecutions: 5,
+        ExecutionTimeout:        time.Minute,
+    })
+    executor.SetTuningManager(tuning)
+
+    assert.Equal(t, 5, executor.MaxConcurrentExecutions())
+
+    _, err := tuning.Apply(core.RuntimeSettings{MaxConcurrentExecutions: 25})
+    assert.NoError(t, err)
+    assert.Equal(t, 25, executor.MaxConcurrentExecutions(), "executor should see applied tuning changes")
+}
+
+// TestTuningRejectsInvalidValues tests that out-of-range updates leave the
+// effective settings untouched
+func TestTuningRejectsInvalidValues(t *testing.T) {
+    tuning := core.NewTuningManager(core.RuntimeSettings{MaxConcurrentExecutions: 5})
+
+    _, err := tuning.Apply(core.RuntimeSettings{MaxConcurrentExecutions: -1})
+    assert.ErrorIs(t, err, core.ErrInvalidTuningValue)
+    assert.Equal(t, 5, tuning.MaxConcurrentExecutions())
+}